@@ -23,6 +23,7 @@ const (
 	ChatService_SendMessage_FullMethodName                 = "/chat.v1.ChatService/SendMessage"
 	ChatService_GetConversation_FullMethodName             = "/chat.v1.ChatService/GetConversation"
 	ChatService_GetMessagesByConversationId_FullMethodName = "/chat.v1.ChatService/GetMessagesByConversationId"
+	ChatService_ReportMessage_FullMethodName               = "/chat.v1.ChatService/ReportMessage"
 )
 
 // ChatServiceClient is the client API for ChatService service.
@@ -33,6 +34,7 @@ type ChatServiceClient interface {
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
 	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error)
 	GetMessagesByConversationId(ctx context.Context, in *GetMessagesByConversationIdRequest, opts ...grpc.CallOption) (*GetMessagesByConversationIdResponse, error)
+	ReportMessage(ctx context.Context, in *ReportMessageRequest, opts ...grpc.CallOption) (*ReportMessageResponse, error)
 }
 
 type chatServiceClient struct {
@@ -83,6 +85,16 @@ func (c *chatServiceClient) GetMessagesByConversationId(ctx context.Context, in
 	return out, nil
 }
 
+func (c *chatServiceClient) ReportMessage(ctx context.Context, in *ReportMessageRequest, opts ...grpc.CallOption) (*ReportMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportMessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_ReportMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility.
@@ -91,6 +103,7 @@ type ChatServiceServer interface {
 	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
 	GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error)
 	GetMessagesByConversationId(context.Context, *GetMessagesByConversationIdRequest) (*GetMessagesByConversationIdResponse, error)
+	ReportMessage(context.Context, *ReportMessageRequest) (*ReportMessageResponse, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -113,6 +126,9 @@ func (UnimplementedChatServiceServer) GetConversation(context.Context, *GetConve
 func (UnimplementedChatServiceServer) GetMessagesByConversationId(context.Context, *GetMessagesByConversationIdRequest) (*GetMessagesByConversationIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMessagesByConversationId not implemented")
 }
+func (UnimplementedChatServiceServer) ReportMessage(context.Context, *ReportMessageRequest) (*ReportMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMessage not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
 
@@ -206,6 +222,24 @@ func _ChatService_GetMessagesByConversationId_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_ReportMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ReportMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ReportMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ReportMessage(ctx, req.(*ReportMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +263,10 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMessagesByConversationId",
 			Handler:    _ChatService_GetMessagesByConversationId_Handler,
 		},
+		{
+			MethodName: "ReportMessage",
+			Handler:    _ChatService_ReportMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chat/v1/chat.proto",
@@ -28,12 +28,38 @@ const (
 	UserService_ConfirmAdditionalPhotoUpload_FullMethodName = "/user.v1.UserService/ConfirmAdditionalPhotoUpload"
 	UserService_DeleteAdditionalPhoto_FullMethodName        = "/user.v1.UserService/DeleteAdditionalPhoto"
 	UserService_GetAdditionalPhotos_FullMethodName          = "/user.v1.UserService/GetAdditionalPhotos"
+	UserService_ReorderAdditionalPhotos_FullMethodName      = "/user.v1.UserService/ReorderAdditionalPhotos"
+	UserService_SetPrimaryPhoto_FullMethodName              = "/user.v1.UserService/SetPrimaryPhoto"
 	UserService_UpdateUserPartnerPreferences_FullMethodName = "/user.v1.UserService/UpdateUserPartnerPreferences"
 	UserService_GetUserPartnerPreferences_FullMethodName    = "/user.v1.UserService/GetUserPartnerPreferences"
 	UserService_RecordMatchAction_FullMethodName            = "/user.v1.UserService/RecordMatchAction"
+	UserService_UndoLastMatchAction_FullMethodName          = "/user.v1.UserService/UndoLastMatchAction"
 	UserService_GetMatchRecommendations_FullMethodName      = "/user.v1.UserService/GetMatchRecommendations"
 	UserService_GetProfilesByMatchAction_FullMethodName     = "/user.v1.UserService/GetProfilesByMatchAction"
+	UserService_GetReceivedInterests_FullMethodName         = "/user.v1.UserService/GetReceivedInterests"
+	UserService_GetReceivedInterestCounts_FullMethodName    = "/user.v1.UserService/GetReceivedInterestCounts"
+	UserService_RespondToInterest_FullMethodName            = "/user.v1.UserService/RespondToInterest"
 	UserService_GetUserDetailsByProfileID_FullMethodName    = "/user.v1.UserService/GetUserDetailsByProfileID"
+	UserService_BlockOrUnblockProfile_FullMethodName        = "/user.v1.UserService/BlockOrUnblockProfile"
+	UserService_ReportProfile_FullMethodName                = "/user.v1.UserService/ReportProfile"
+	UserService_GetReports_FullMethodName                   = "/user.v1.UserService/GetReports"
+	UserService_GetReport_FullMethodName                    = "/user.v1.UserService/GetReport"
+	UserService_ResolveReport_FullMethodName                = "/user.v1.UserService/ResolveReport"
+	UserService_GetPendingPhotos_FullMethodName             = "/user.v1.UserService/GetPendingPhotos"
+	UserService_ReviewPhoto_FullMethodName                  = "/user.v1.UserService/ReviewPhoto"
+	UserService_GetProfileHistory_FullMethodName            = "/user.v1.UserService/GetProfileHistory"
+	UserService_GetProfileVisitors_FullMethodName           = "/user.v1.UserService/GetProfileVisitors"
+	UserService_AddToShortlist_FullMethodName               = "/user.v1.UserService/AddToShortlist"
+	UserService_RemoveFromShortlist_FullMethodName          = "/user.v1.UserService/RemoveFromShortlist"
+	UserService_GetShortlist_FullMethodName                 = "/user.v1.UserService/GetShortlist"
+	UserService_SearchProfiles_FullMethodName               = "/user.v1.UserService/SearchProfiles"
+	UserService_CreateSavedSearch_FullMethodName            = "/user.v1.UserService/CreateSavedSearch"
+	UserService_GetSavedSearches_FullMethodName             = "/user.v1.UserService/GetSavedSearches"
+	UserService_DeleteSavedSearch_FullMethodName            = "/user.v1.UserService/DeleteSavedSearch"
+	UserService_GetReferenceOptions_FullMethodName          = "/user.v1.UserService/GetReferenceOptions"
+	UserService_CreateReferenceOption_FullMethodName        = "/user.v1.UserService/CreateReferenceOption"
+	UserService_UpdateReferenceOption_FullMethodName        = "/user.v1.UserService/UpdateReferenceOption"
+	UserService_DeleteReferenceOption_FullMethodName        = "/user.v1.UserService/DeleteReferenceOption"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -51,15 +77,47 @@ type UserServiceClient interface {
 	ConfirmAdditionalPhotoUpload(ctx context.Context, in *ConfirmAdditionalPhotoUploadRequest, opts ...grpc.CallOption) (*ConfirmAdditionalPhotoUploadResponse, error)
 	DeleteAdditionalPhoto(ctx context.Context, in *DeleteAdditionalPhotoRequest, opts ...grpc.CallOption) (*DeleteAdditionalPhotoResponse, error)
 	GetAdditionalPhotos(ctx context.Context, in *GetAdditionalPhotosRequest, opts ...grpc.CallOption) (*GetAdditionalPhotosResponse, error)
+	ReorderAdditionalPhotos(ctx context.Context, in *ReorderAdditionalPhotosRequest, opts ...grpc.CallOption) (*ReorderAdditionalPhotosResponse, error)
+	SetPrimaryPhoto(ctx context.Context, in *SetPrimaryPhotoRequest, opts ...grpc.CallOption) (*SetPrimaryPhotoResponse, error)
 	// Partner preferences management
 	UpdateUserPartnerPreferences(ctx context.Context, in *UpdateUserPartnerPreferencesRequest, opts ...grpc.CallOption) (*UpdateUserPartnerPreferencesResponse, error)
 	GetUserPartnerPreferences(ctx context.Context, in *GetUserPartnerPreferencesRequest, opts ...grpc.CallOption) (*GetUserPartnerPreferencesResponse, error)
 	// Match making
 	RecordMatchAction(ctx context.Context, in *RecordMatchActionRequest, opts ...grpc.CallOption) (*RecordMatchActionResponse, error)
+	UndoLastMatchAction(ctx context.Context, in *UndoLastMatchActionRequest, opts ...grpc.CallOption) (*UndoLastMatchActionResponse, error)
 	GetMatchRecommendations(ctx context.Context, in *GetMatchRecommendationsRequest, opts ...grpc.CallOption) (*GetMatchRecommendationsResponse, error)
 	GetProfilesByMatchAction(ctx context.Context, in *GetProfilesByMatchActionRequest, opts ...grpc.CallOption) (*GetProfilesByMatchActionResponse, error)
+	GetReceivedInterests(ctx context.Context, in *GetReceivedInterestsRequest, opts ...grpc.CallOption) (*GetReceivedInterestsResponse, error)
+	GetReceivedInterestCounts(ctx context.Context, in *GetReceivedInterestCountsRequest, opts ...grpc.CallOption) (*GetReceivedInterestCountsResponse, error)
+	RespondToInterest(ctx context.Context, in *RespondToInterestRequest, opts ...grpc.CallOption) (*RespondToInterestResponse, error)
 	// User details management
 	GetUserDetailsByProfileID(ctx context.Context, in *GetUserDetailsByProfileIDRequest, opts ...grpc.CallOption) (*GetUserDetailsByProfileIDResponse, error)
+	// User blocking
+	BlockOrUnblockProfile(ctx context.Context, in *BlockOrUnblockProfileRequest, opts ...grpc.CallOption) (*BlockOrUnblockProfileResponse, error)
+	// Reports and moderation
+	ReportProfile(ctx context.Context, in *ReportProfileRequest, opts ...grpc.CallOption) (*ReportProfileResponse, error)
+	GetReports(ctx context.Context, in *GetReportsRequest, opts ...grpc.CallOption) (*GetReportsResponse, error)
+	GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportResponse, error)
+	ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportResponse, error)
+	GetPendingPhotos(ctx context.Context, in *GetPendingPhotosRequest, opts ...grpc.CallOption) (*GetPendingPhotosResponse, error)
+	ReviewPhoto(ctx context.Context, in *ReviewPhotoRequest, opts ...grpc.CallOption) (*ReviewPhotoResponse, error)
+	GetProfileHistory(ctx context.Context, in *GetProfileHistoryRequest, opts ...grpc.CallOption) (*GetProfileHistoryResponse, error)
+	// Profile views
+	GetProfileVisitors(ctx context.Context, in *GetProfileVisitorsRequest, opts ...grpc.CallOption) (*GetProfileVisitorsResponse, error)
+	// Shortlist
+	AddToShortlist(ctx context.Context, in *AddToShortlistRequest, opts ...grpc.CallOption) (*AddToShortlistResponse, error)
+	RemoveFromShortlist(ctx context.Context, in *RemoveFromShortlistRequest, opts ...grpc.CallOption) (*RemoveFromShortlistResponse, error)
+	GetShortlist(ctx context.Context, in *GetShortlistRequest, opts ...grpc.CallOption) (*GetShortlistResponse, error)
+	// Search and saved searches
+	SearchProfiles(ctx context.Context, in *SearchProfilesRequest, opts ...grpc.CallOption) (*SearchProfilesResponse, error)
+	CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*CreateSavedSearchResponse, error)
+	GetSavedSearches(ctx context.Context, in *GetSavedSearchesRequest, opts ...grpc.CallOption) (*GetSavedSearchesResponse, error)
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error)
+	// Reference data (admin-managed profile options)
+	GetReferenceOptions(ctx context.Context, in *GetReferenceOptionsRequest, opts ...grpc.CallOption) (*GetReferenceOptionsResponse, error)
+	CreateReferenceOption(ctx context.Context, in *CreateReferenceOptionRequest, opts ...grpc.CallOption) (*CreateReferenceOptionResponse, error)
+	UpdateReferenceOption(ctx context.Context, in *UpdateReferenceOptionRequest, opts ...grpc.CallOption) (*UpdateReferenceOptionResponse, error)
+	DeleteReferenceOption(ctx context.Context, in *DeleteReferenceOptionRequest, opts ...grpc.CallOption) (*DeleteReferenceOptionResponse, error)
 }
 
 type userServiceClient struct {
@@ -160,6 +218,26 @@ func (c *userServiceClient) GetAdditionalPhotos(ctx context.Context, in *GetAddi
 	return out, nil
 }
 
+func (c *userServiceClient) ReorderAdditionalPhotos(ctx context.Context, in *ReorderAdditionalPhotosRequest, opts ...grpc.CallOption) (*ReorderAdditionalPhotosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderAdditionalPhotosResponse)
+	err := c.cc.Invoke(ctx, UserService_ReorderAdditionalPhotos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetPrimaryPhoto(ctx context.Context, in *SetPrimaryPhotoRequest, opts ...grpc.CallOption) (*SetPrimaryPhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPrimaryPhotoResponse)
+	err := c.cc.Invoke(ctx, UserService_SetPrimaryPhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) UpdateUserPartnerPreferences(ctx context.Context, in *UpdateUserPartnerPreferencesRequest, opts ...grpc.CallOption) (*UpdateUserPartnerPreferencesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateUserPartnerPreferencesResponse)
@@ -190,6 +268,16 @@ func (c *userServiceClient) RecordMatchAction(ctx context.Context, in *RecordMat
 	return out, nil
 }
 
+func (c *userServiceClient) UndoLastMatchAction(ctx context.Context, in *UndoLastMatchActionRequest, opts ...grpc.CallOption) (*UndoLastMatchActionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UndoLastMatchActionResponse)
+	err := c.cc.Invoke(ctx, UserService_UndoLastMatchAction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetMatchRecommendations(ctx context.Context, in *GetMatchRecommendationsRequest, opts ...grpc.CallOption) (*GetMatchRecommendationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMatchRecommendationsResponse)
@@ -210,6 +298,36 @@ func (c *userServiceClient) GetProfilesByMatchAction(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *userServiceClient) GetReceivedInterests(ctx context.Context, in *GetReceivedInterestsRequest, opts ...grpc.CallOption) (*GetReceivedInterestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceivedInterestsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetReceivedInterests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetReceivedInterestCounts(ctx context.Context, in *GetReceivedInterestCountsRequest, opts ...grpc.CallOption) (*GetReceivedInterestCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceivedInterestCountsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetReceivedInterestCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RespondToInterest(ctx context.Context, in *RespondToInterestRequest, opts ...grpc.CallOption) (*RespondToInterestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespondToInterestResponse)
+	err := c.cc.Invoke(ctx, UserService_RespondToInterest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUserDetailsByProfileID(ctx context.Context, in *GetUserDetailsByProfileIDRequest, opts ...grpc.CallOption) (*GetUserDetailsByProfileIDResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUserDetailsByProfileIDResponse)
@@ -220,6 +338,206 @@ func (c *userServiceClient) GetUserDetailsByProfileID(ctx context.Context, in *G
 	return out, nil
 }
 
+func (c *userServiceClient) BlockOrUnblockProfile(ctx context.Context, in *BlockOrUnblockProfileRequest, opts ...grpc.CallOption) (*BlockOrUnblockProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockOrUnblockProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_BlockOrUnblockProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReportProfile(ctx context.Context, in *ReportProfileRequest, opts ...grpc.CallOption) (*ReportProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_ReportProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetReports(ctx context.Context, in *GetReportsRequest, opts ...grpc.CallOption) (*GetReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportResponse)
+	err := c.cc.Invoke(ctx, UserService_GetReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResolveReport(ctx context.Context, in *ResolveReportRequest, opts ...grpc.CallOption) (*ResolveReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReportResponse)
+	err := c.cc.Invoke(ctx, UserService_ResolveReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetPendingPhotos(ctx context.Context, in *GetPendingPhotosRequest, opts ...grpc.CallOption) (*GetPendingPhotosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPendingPhotosResponse)
+	err := c.cc.Invoke(ctx, UserService_GetPendingPhotos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReviewPhoto(ctx context.Context, in *ReviewPhotoRequest, opts ...grpc.CallOption) (*ReviewPhotoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewPhotoResponse)
+	err := c.cc.Invoke(ctx, UserService_ReviewPhoto_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetProfileHistory(ctx context.Context, in *GetProfileHistoryRequest, opts ...grpc.CallOption) (*GetProfileHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileHistoryResponse)
+	err := c.cc.Invoke(ctx, UserService_GetProfileHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetProfileVisitors(ctx context.Context, in *GetProfileVisitorsRequest, opts ...grpc.CallOption) (*GetProfileVisitorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfileVisitorsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetProfileVisitors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddToShortlist(ctx context.Context, in *AddToShortlistRequest, opts ...grpc.CallOption) (*AddToShortlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddToShortlistResponse)
+	err := c.cc.Invoke(ctx, UserService_AddToShortlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveFromShortlist(ctx context.Context, in *RemoveFromShortlistRequest, opts ...grpc.CallOption) (*RemoveFromShortlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFromShortlistResponse)
+	err := c.cc.Invoke(ctx, UserService_RemoveFromShortlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetShortlist(ctx context.Context, in *GetShortlistRequest, opts ...grpc.CallOption) (*GetShortlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShortlistResponse)
+	err := c.cc.Invoke(ctx, UserService_GetShortlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SearchProfiles(ctx context.Context, in *SearchProfilesRequest, opts ...grpc.CallOption) (*SearchProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchProfilesResponse)
+	err := c.cc.Invoke(ctx, UserService_SearchProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateSavedSearch(ctx context.Context, in *CreateSavedSearchRequest, opts ...grpc.CallOption) (*CreateSavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSavedSearchResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetSavedSearches(ctx context.Context, in *GetSavedSearchesRequest, opts ...grpc.CallOption) (*GetSavedSearchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, UserService_GetSavedSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSavedSearchResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetReferenceOptions(ctx context.Context, in *GetReferenceOptionsRequest, opts ...grpc.CallOption) (*GetReferenceOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReferenceOptionsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetReferenceOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateReferenceOption(ctx context.Context, in *CreateReferenceOptionRequest, opts ...grpc.CallOption) (*CreateReferenceOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReferenceOptionResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateReferenceOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateReferenceOption(ctx context.Context, in *UpdateReferenceOptionRequest, opts ...grpc.CallOption) (*UpdateReferenceOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateReferenceOptionResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateReferenceOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteReferenceOption(ctx context.Context, in *DeleteReferenceOptionRequest, opts ...grpc.CallOption) (*DeleteReferenceOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReferenceOptionResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteReferenceOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -235,15 +553,47 @@ type UserServiceServer interface {
 	ConfirmAdditionalPhotoUpload(context.Context, *ConfirmAdditionalPhotoUploadRequest) (*ConfirmAdditionalPhotoUploadResponse, error)
 	DeleteAdditionalPhoto(context.Context, *DeleteAdditionalPhotoRequest) (*DeleteAdditionalPhotoResponse, error)
 	GetAdditionalPhotos(context.Context, *GetAdditionalPhotosRequest) (*GetAdditionalPhotosResponse, error)
+	ReorderAdditionalPhotos(context.Context, *ReorderAdditionalPhotosRequest) (*ReorderAdditionalPhotosResponse, error)
+	SetPrimaryPhoto(context.Context, *SetPrimaryPhotoRequest) (*SetPrimaryPhotoResponse, error)
 	// Partner preferences management
 	UpdateUserPartnerPreferences(context.Context, *UpdateUserPartnerPreferencesRequest) (*UpdateUserPartnerPreferencesResponse, error)
 	GetUserPartnerPreferences(context.Context, *GetUserPartnerPreferencesRequest) (*GetUserPartnerPreferencesResponse, error)
 	// Match making
 	RecordMatchAction(context.Context, *RecordMatchActionRequest) (*RecordMatchActionResponse, error)
+	UndoLastMatchAction(context.Context, *UndoLastMatchActionRequest) (*UndoLastMatchActionResponse, error)
 	GetMatchRecommendations(context.Context, *GetMatchRecommendationsRequest) (*GetMatchRecommendationsResponse, error)
 	GetProfilesByMatchAction(context.Context, *GetProfilesByMatchActionRequest) (*GetProfilesByMatchActionResponse, error)
+	GetReceivedInterests(context.Context, *GetReceivedInterestsRequest) (*GetReceivedInterestsResponse, error)
+	GetReceivedInterestCounts(context.Context, *GetReceivedInterestCountsRequest) (*GetReceivedInterestCountsResponse, error)
+	RespondToInterest(context.Context, *RespondToInterestRequest) (*RespondToInterestResponse, error)
 	// User details management
 	GetUserDetailsByProfileID(context.Context, *GetUserDetailsByProfileIDRequest) (*GetUserDetailsByProfileIDResponse, error)
+	// User blocking
+	BlockOrUnblockProfile(context.Context, *BlockOrUnblockProfileRequest) (*BlockOrUnblockProfileResponse, error)
+	// Reports and moderation
+	ReportProfile(context.Context, *ReportProfileRequest) (*ReportProfileResponse, error)
+	GetReports(context.Context, *GetReportsRequest) (*GetReportsResponse, error)
+	GetReport(context.Context, *GetReportRequest) (*GetReportResponse, error)
+	ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportResponse, error)
+	GetPendingPhotos(context.Context, *GetPendingPhotosRequest) (*GetPendingPhotosResponse, error)
+	ReviewPhoto(context.Context, *ReviewPhotoRequest) (*ReviewPhotoResponse, error)
+	GetProfileHistory(context.Context, *GetProfileHistoryRequest) (*GetProfileHistoryResponse, error)
+	// Profile views
+	GetProfileVisitors(context.Context, *GetProfileVisitorsRequest) (*GetProfileVisitorsResponse, error)
+	// Shortlist
+	AddToShortlist(context.Context, *AddToShortlistRequest) (*AddToShortlistResponse, error)
+	RemoveFromShortlist(context.Context, *RemoveFromShortlistRequest) (*RemoveFromShortlistResponse, error)
+	GetShortlist(context.Context, *GetShortlistRequest) (*GetShortlistResponse, error)
+	// Search and saved searches
+	SearchProfiles(context.Context, *SearchProfilesRequest) (*SearchProfilesResponse, error)
+	CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*CreateSavedSearchResponse, error)
+	GetSavedSearches(context.Context, *GetSavedSearchesRequest) (*GetSavedSearchesResponse, error)
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error)
+	// Reference data (admin-managed profile options)
+	GetReferenceOptions(context.Context, *GetReferenceOptionsRequest) (*GetReferenceOptionsResponse, error)
+	CreateReferenceOption(context.Context, *CreateReferenceOptionRequest) (*CreateReferenceOptionResponse, error)
+	UpdateReferenceOption(context.Context, *UpdateReferenceOptionRequest) (*UpdateReferenceOptionResponse, error)
+	DeleteReferenceOption(context.Context, *DeleteReferenceOptionRequest) (*DeleteReferenceOptionResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -281,6 +631,12 @@ func (UnimplementedUserServiceServer) DeleteAdditionalPhoto(context.Context, *De
 func (UnimplementedUserServiceServer) GetAdditionalPhotos(context.Context, *GetAdditionalPhotosRequest) (*GetAdditionalPhotosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAdditionalPhotos not implemented")
 }
+func (UnimplementedUserServiceServer) ReorderAdditionalPhotos(context.Context, *ReorderAdditionalPhotosRequest) (*ReorderAdditionalPhotosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderAdditionalPhotos not implemented")
+}
+func (UnimplementedUserServiceServer) SetPrimaryPhoto(context.Context, *SetPrimaryPhotoRequest) (*SetPrimaryPhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryPhoto not implemented")
+}
 func (UnimplementedUserServiceServer) UpdateUserPartnerPreferences(context.Context, *UpdateUserPartnerPreferencesRequest) (*UpdateUserPartnerPreferencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPartnerPreferences not implemented")
 }
@@ -290,15 +646,87 @@ func (UnimplementedUserServiceServer) GetUserPartnerPreferences(context.Context,
 func (UnimplementedUserServiceServer) RecordMatchAction(context.Context, *RecordMatchActionRequest) (*RecordMatchActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordMatchAction not implemented")
 }
+func (UnimplementedUserServiceServer) UndoLastMatchAction(context.Context, *UndoLastMatchActionRequest) (*UndoLastMatchActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoLastMatchAction not implemented")
+}
 func (UnimplementedUserServiceServer) GetMatchRecommendations(context.Context, *GetMatchRecommendationsRequest) (*GetMatchRecommendationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMatchRecommendations not implemented")
 }
 func (UnimplementedUserServiceServer) GetProfilesByMatchAction(context.Context, *GetProfilesByMatchActionRequest) (*GetProfilesByMatchActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfilesByMatchAction not implemented")
 }
+func (UnimplementedUserServiceServer) GetReceivedInterests(context.Context, *GetReceivedInterestsRequest) (*GetReceivedInterestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceivedInterests not implemented")
+}
+func (UnimplementedUserServiceServer) GetReceivedInterestCounts(context.Context, *GetReceivedInterestCountsRequest) (*GetReceivedInterestCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceivedInterestCounts not implemented")
+}
+func (UnimplementedUserServiceServer) RespondToInterest(context.Context, *RespondToInterestRequest) (*RespondToInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToInterest not implemented")
+}
 func (UnimplementedUserServiceServer) GetUserDetailsByProfileID(context.Context, *GetUserDetailsByProfileIDRequest) (*GetUserDetailsByProfileIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserDetailsByProfileID not implemented")
 }
+func (UnimplementedUserServiceServer) BlockOrUnblockProfile(context.Context, *BlockOrUnblockProfileRequest) (*BlockOrUnblockProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockOrUnblockProfile not implemented")
+}
+func (UnimplementedUserServiceServer) ReportProfile(context.Context, *ReportProfileRequest) (*ReportProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportProfile not implemented")
+}
+func (UnimplementedUserServiceServer) GetReports(context.Context, *GetReportsRequest) (*GetReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReports not implemented")
+}
+func (UnimplementedUserServiceServer) GetReport(context.Context, *GetReportRequest) (*GetReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReport not implemented")
+}
+func (UnimplementedUserServiceServer) ResolveReport(context.Context, *ResolveReportRequest) (*ResolveReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReport not implemented")
+}
+func (UnimplementedUserServiceServer) GetPendingPhotos(context.Context, *GetPendingPhotosRequest) (*GetPendingPhotosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPhotos not implemented")
+}
+func (UnimplementedUserServiceServer) ReviewPhoto(context.Context, *ReviewPhotoRequest) (*ReviewPhotoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewPhoto not implemented")
+}
+func (UnimplementedUserServiceServer) GetProfileHistory(context.Context, *GetProfileHistoryRequest) (*GetProfileHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileHistory not implemented")
+}
+func (UnimplementedUserServiceServer) GetProfileVisitors(context.Context, *GetProfileVisitorsRequest) (*GetProfileVisitorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfileVisitors not implemented")
+}
+func (UnimplementedUserServiceServer) AddToShortlist(context.Context, *AddToShortlistRequest) (*AddToShortlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToShortlist not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveFromShortlist(context.Context, *RemoveFromShortlistRequest) (*RemoveFromShortlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromShortlist not implemented")
+}
+func (UnimplementedUserServiceServer) GetShortlist(context.Context, *GetShortlistRequest) (*GetShortlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShortlist not implemented")
+}
+func (UnimplementedUserServiceServer) SearchProfiles(context.Context, *SearchProfilesRequest) (*SearchProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProfiles not implemented")
+}
+func (UnimplementedUserServiceServer) CreateSavedSearch(context.Context, *CreateSavedSearchRequest) (*CreateSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSavedSearch not implemented")
+}
+func (UnimplementedUserServiceServer) GetSavedSearches(context.Context, *GetSavedSearchesRequest) (*GetSavedSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedSearches not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedUserServiceServer) GetReferenceOptions(context.Context, *GetReferenceOptionsRequest) (*GetReferenceOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReferenceOptions not implemented")
+}
+func (UnimplementedUserServiceServer) CreateReferenceOption(context.Context, *CreateReferenceOptionRequest) (*CreateReferenceOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReferenceOption not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateReferenceOption(context.Context, *UpdateReferenceOptionRequest) (*UpdateReferenceOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReferenceOption not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteReferenceOption(context.Context, *DeleteReferenceOptionRequest) (*DeleteReferenceOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReferenceOption not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -482,6 +910,42 @@ func _UserService_GetAdditionalPhotos_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ReorderAdditionalPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderAdditionalPhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReorderAdditionalPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReorderAdditionalPhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReorderAdditionalPhotos(ctx, req.(*ReorderAdditionalPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetPrimaryPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryPhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetPrimaryPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetPrimaryPhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetPrimaryPhoto(ctx, req.(*SetPrimaryPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_UpdateUserPartnerPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUserPartnerPreferencesRequest)
 	if err := dec(in); err != nil {
@@ -536,6 +1000,24 @@ func _UserService_RecordMatchAction_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UndoLastMatchAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoLastMatchActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UndoLastMatchAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UndoLastMatchAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UndoLastMatchAction(ctx, req.(*UndoLastMatchActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetMatchRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMatchRecommendationsRequest)
 	if err := dec(in); err != nil {
@@ -572,28 +1054,442 @@ func _UserService_GetProfilesByMatchAction_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserDetailsByProfileID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserDetailsByProfileIDRequest)
+func _UserService_GetReceivedInterests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceivedInterestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserDetailsByProfileID(ctx, in)
+		return srv.(UserServiceServer).GetReceivedInterests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUserDetailsByProfileID_FullMethodName,
+		FullMethod: UserService_GetReceivedInterests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserDetailsByProfileID(ctx, req.(*GetUserDetailsByProfileIDRequest))
+		return srv.(UserServiceServer).GetReceivedInterests(ctx, req.(*GetReceivedInterestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var UserService_ServiceDesc = grpc.ServiceDesc{
+func _UserService_GetReceivedInterestCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceivedInterestCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetReceivedInterestCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetReceivedInterestCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetReceivedInterestCounts(ctx, req.(*GetReceivedInterestCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RespondToInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToInterestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RespondToInterest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RespondToInterest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RespondToInterest(ctx, req.(*RespondToInterestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserDetailsByProfileID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDetailsByProfileIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserDetailsByProfileID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserDetailsByProfileID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserDetailsByProfileID(ctx, req.(*GetUserDetailsByProfileIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_BlockOrUnblockProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockOrUnblockProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).BlockOrUnblockProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_BlockOrUnblockProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).BlockOrUnblockProfile(ctx, req.(*BlockOrUnblockProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReportProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReportProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReportProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReportProfile(ctx, req.(*ReportProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetReports(ctx, req.(*GetReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetReport(ctx, req.(*GetReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResolveReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResolveReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResolveReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResolveReport(ctx, req.(*ResolveReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPendingPhotos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingPhotosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPendingPhotos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPendingPhotos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPendingPhotos(ctx, req.(*GetPendingPhotosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReviewPhoto_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewPhotoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReviewPhoto(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReviewPhoto_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReviewPhoto(ctx, req.(*ReviewPhotoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetProfileHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetProfileHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetProfileHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetProfileHistory(ctx, req.(*GetProfileHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetProfileVisitors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfileVisitorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetProfileVisitors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetProfileVisitors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetProfileVisitors(ctx, req.(*GetProfileVisitorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddToShortlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToShortlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddToShortlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddToShortlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddToShortlist(ctx, req.(*AddToShortlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveFromShortlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromShortlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveFromShortlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveFromShortlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveFromShortlist(ctx, req.(*RemoveFromShortlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetShortlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShortlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetShortlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetShortlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetShortlist(ctx, req.(*GetShortlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SearchProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchProfiles(ctx, req.(*SearchProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateSavedSearch(ctx, req.(*CreateSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetSavedSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetSavedSearches(ctx, req.(*GetSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetReferenceOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReferenceOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetReferenceOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetReferenceOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetReferenceOptions(ctx, req.(*GetReferenceOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateReferenceOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReferenceOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateReferenceOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateReferenceOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateReferenceOption(ctx, req.(*CreateReferenceOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateReferenceOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReferenceOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateReferenceOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateReferenceOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateReferenceOption(ctx, req.(*UpdateReferenceOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteReferenceOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReferenceOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteReferenceOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteReferenceOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteReferenceOption(ctx, req.(*DeleteReferenceOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "user.v1.UserService",
 	HandlerType: (*UserServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -633,6 +1529,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAdditionalPhotos",
 			Handler:    _UserService_GetAdditionalPhotos_Handler,
 		},
+		{
+			MethodName: "ReorderAdditionalPhotos",
+			Handler:    _UserService_ReorderAdditionalPhotos_Handler,
+		},
+		{
+			MethodName: "SetPrimaryPhoto",
+			Handler:    _UserService_SetPrimaryPhoto_Handler,
+		},
 		{
 			MethodName: "UpdateUserPartnerPreferences",
 			Handler:    _UserService_UpdateUserPartnerPreferences_Handler,
@@ -645,6 +1549,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecordMatchAction",
 			Handler:    _UserService_RecordMatchAction_Handler,
 		},
+		{
+			MethodName: "UndoLastMatchAction",
+			Handler:    _UserService_UndoLastMatchAction_Handler,
+		},
 		{
 			MethodName: "GetMatchRecommendations",
 			Handler:    _UserService_GetMatchRecommendations_Handler,
@@ -653,10 +1561,102 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProfilesByMatchAction",
 			Handler:    _UserService_GetProfilesByMatchAction_Handler,
 		},
+		{
+			MethodName: "GetReceivedInterests",
+			Handler:    _UserService_GetReceivedInterests_Handler,
+		},
+		{
+			MethodName: "GetReceivedInterestCounts",
+			Handler:    _UserService_GetReceivedInterestCounts_Handler,
+		},
+		{
+			MethodName: "RespondToInterest",
+			Handler:    _UserService_RespondToInterest_Handler,
+		},
 		{
 			MethodName: "GetUserDetailsByProfileID",
 			Handler:    _UserService_GetUserDetailsByProfileID_Handler,
 		},
+		{
+			MethodName: "BlockOrUnblockProfile",
+			Handler:    _UserService_BlockOrUnblockProfile_Handler,
+		},
+		{
+			MethodName: "ReportProfile",
+			Handler:    _UserService_ReportProfile_Handler,
+		},
+		{
+			MethodName: "GetReports",
+			Handler:    _UserService_GetReports_Handler,
+		},
+		{
+			MethodName: "GetReport",
+			Handler:    _UserService_GetReport_Handler,
+		},
+		{
+			MethodName: "ResolveReport",
+			Handler:    _UserService_ResolveReport_Handler,
+		},
+		{
+			MethodName: "GetPendingPhotos",
+			Handler:    _UserService_GetPendingPhotos_Handler,
+		},
+		{
+			MethodName: "ReviewPhoto",
+			Handler:    _UserService_ReviewPhoto_Handler,
+		},
+		{
+			MethodName: "GetProfileHistory",
+			Handler:    _UserService_GetProfileHistory_Handler,
+		},
+		{
+			MethodName: "GetProfileVisitors",
+			Handler:    _UserService_GetProfileVisitors_Handler,
+		},
+		{
+			MethodName: "AddToShortlist",
+			Handler:    _UserService_AddToShortlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromShortlist",
+			Handler:    _UserService_RemoveFromShortlist_Handler,
+		},
+		{
+			MethodName: "GetShortlist",
+			Handler:    _UserService_GetShortlist_Handler,
+		},
+		{
+			MethodName: "SearchProfiles",
+			Handler:    _UserService_SearchProfiles_Handler,
+		},
+		{
+			MethodName: "CreateSavedSearch",
+			Handler:    _UserService_CreateSavedSearch_Handler,
+		},
+		{
+			MethodName: "GetSavedSearches",
+			Handler:    _UserService_GetSavedSearches_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _UserService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "GetReferenceOptions",
+			Handler:    _UserService_GetReferenceOptions_Handler,
+		},
+		{
+			MethodName: "CreateReferenceOption",
+			Handler:    _UserService_CreateReferenceOption_Handler,
+		},
+		{
+			MethodName: "UpdateReferenceOption",
+			Handler:    _UserService_UpdateReferenceOption_Handler,
+		},
+		{
+			MethodName: "DeleteReferenceOption",
+			Handler:    _UserService_DeleteReferenceOption_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/v1/user.proto",
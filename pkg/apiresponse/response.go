@@ -9,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/status"
 )
@@ -38,7 +39,11 @@ func Success(c *gin.Context, msg string, data interface{}) {
 	})
 }
 
+// Error responds with the public message of err in the locale of the
+// request, see RequestLocale.
 func Error(c *gin.Context, err error, details map[string]string) {
+	locale := RequestLocale(c)
+
 	// Check if the error is a gRPC error
 	if st, ok := status.FromError(err); ok {
 		if errorInfo := extractErrorInfo(st); errorInfo != nil {
@@ -55,6 +60,11 @@ func Error(c *gin.Context, err error, details map[string]string) {
 			if userMessage == "" {
 				userMessage = st.Message()
 			}
+			messageKey := errorInfo.Metadata[constants.MessageKey]
+			if messageKey == "" {
+				messageKey = errorInfo.Reason
+			}
+			userMessage = i18n.TextOr(locale, messageKey, userMessage)
 
 			respond(c, httpStatus, &ErrorInfo{
 				Code:    errorInfo.Reason,
@@ -70,7 +80,7 @@ func Error(c *gin.Context, err error, details map[string]string) {
 	if errors.As(err, &ae) {
 		respond(c, ae.HTTPStatusCode, &ErrorInfo{
 			Code:    ae.Code,
-			Message: ae.PublicMsg,
+			Message: i18n.TextOr(locale, ae.Key(), ae.PublicMsg),
 			Details: details,
 		})
 		return
@@ -79,7 +89,7 @@ func Error(c *gin.Context, err error, details map[string]string) {
 	// Fallback for unexpected or unknown errors.
 	respond(c, http.StatusInternalServerError, &ErrorInfo{
 		Code:    "INTERNAL_SERVER_ERROR",
-		Message: constants.InteralServerErrorMessage,
+		Message: i18n.TextOr(locale, "INTERNAL_SERVER_ERROR", constants.InteralServerErrorMessage),
 		Details: nil,
 	})
 }
@@ -93,10 +103,30 @@ func extractErrorInfo(st *status.Status) *errdetails.ErrorInfo {
 	return nil
 }
 
+// RequestLocale is the locale the gateway resolved for the request, or else
+// the one asked for in Accept-Language, or else the language saved on the
+// signed in user's profile, or else the default locale.
+func RequestLocale(c *gin.Context) i18n.Locale {
+	if locale, ok := i18n.ParseLocale(c.GetString(constants.ContextKeyLocale)); ok {
+		return locale
+	}
+	if locale, ok := i18n.FromAcceptLanguage(c.GetHeader(constants.HeaderAcceptLanguage)); ok {
+		return locale
+	}
+	if lookup, ok := c.Get(constants.ContextKeyProfileLocale); ok {
+		if profileLocale, ok := lookup.(func() string); ok {
+			if locale, ok := i18n.ParseLocale(profileLocale()); ok {
+				return locale
+			}
+		}
+	}
+	return i18n.DefaultLocale
+}
+
 func respond(c *gin.Context, httpCode int, ei *ErrorInfo) {
 	c.JSON(httpCode, StandardResponse{
 		Success:   false,
-		Message:   "Request failed",
+		Message:   i18n.Text(RequestLocale(c), "response.request_failed"),
 		Error:     ei,
 		Timestamp: time.Now().UTC().Format(time.RFC3339),
 		RequestID: c.GetString(constants.ContextKeyRequestID),
@@ -13,9 +13,18 @@ type AppError struct {
 	HTTPStatusCode int
 	GRPCStatusCode codes.Code
 	PublicMsg      string // User Friendly msg
+	MessageKey     string // Message catalog key of PublicMsg, defaults to Code
 }
 
 func (e *AppError) Error() string { return e.Err.Error() }
+
+// Key returns the key used to look up PublicMsg in other languages.
+func (e *AppError) Key() string {
+	if e.MessageKey != "" {
+		return e.MessageKey
+	}
+	return e.Code
+}
 func (e *AppError) Unwrap() error { return e.Err }
 
 func IsAppError(err error) bool {
@@ -29,4 +29,32 @@ var (
 		GRPCStatusCode: codes.PermissionDenied,
 		PublicMsg:      "You are not a participant in this conversation. Please create the conversation again and try again.",
 	}
-)
\ No newline at end of file
+	ErrConversationBlocked = &AppError{
+		Err:            errors.New("conversation blocked between participants"),
+		Code:           "CONVERSATION_BLOCKED",
+		HTTPStatusCode: http.StatusForbidden,
+		GRPCStatusCode: codes.PermissionDenied,
+		PublicMsg:      "You cannot send messages to this user.",
+	}
+	ErrConversationArchived = &AppError{
+		Err:            errors.New("conversation archived"),
+		Code:           "CONVERSATION_ARCHIVED",
+		HTTPStatusCode: http.StatusForbidden,
+		GRPCStatusCode: codes.PermissionDenied,
+		PublicMsg:      "This match has ended, the conversation is read-only.",
+	}
+	ErrMessageNotFound = &AppError{
+		Err:            errors.New("message not found"),
+		Code:           "MESSAGE_NOT_FOUND",
+		HTTPStatusCode: http.StatusNotFound,
+		GRPCStatusCode: codes.NotFound,
+		PublicMsg:      "Message not found.",
+	}
+	ErrCannotReportOwnMessage = &AppError{
+		Err:            errors.New("cannot report own message"),
+		Code:           "CANNOT_REPORT_OWN_MESSAGE",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "You cannot report your own message.",
+	}
+)
@@ -15,6 +15,7 @@ var (
 		HTTPStatusCode: http.StatusInternalServerError,
 		GRPCStatusCode: codes.Internal,
 		PublicMsg:      "Something went wrong. Please try again later.",
+		MessageKey:     "INTERNAL_SERVER_ERROR",
 	}
 	ErrRequestIDContextMissing = &AppError{
 		Err:            errors.New("request ID context missing"),
@@ -71,6 +72,13 @@ var (
 		GRPCStatusCode: codes.InvalidArgument,
 		PublicMsg:      "Invalid pagination limit.",
 	}
+	ErrInvalidPaginationCursor = &AppError{
+		Err:            errors.New("invalid pagination cursor"),
+		Code:           "INVALID_PAGINATION_CURSOR",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Invalid pagination cursor. Please start again from the first page.",
+	}
 	ErrMissingRequiredFields = &AppError{
 		Err:            errors.New("missing required fields"),
 		Code:           "MISSING_REQUIRED_FIELDS",
@@ -35,6 +35,13 @@ var (
 		GRPCStatusCode: codes.InvalidArgument,
 		PublicMsg: "The image file size is not supported. The maximum size is 5MB",
 	}
+	ErrImageDimensionsTooLarge = &AppError{
+		Err: errors.New("image dimensions too large"),
+		Code: "IMAGE_DIMENSIONS_TOO_LARGE",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg: "The image resolution is too large. The maximum is 25 megapixels",
+	}
 	ErrProfilePhotoNotFound = &AppError{
 		Err: errors.New("profile photo not found"),
 		Code: "PROFILE_PHOTO_NOT_FOUND",
@@ -61,6 +68,62 @@ var (
 		Code: "INVALID_DISPLAY_ORDER",
 		HTTPStatusCode: http.StatusBadRequest,
 		GRPCStatusCode: codes.InvalidArgument,
-		PublicMsg: "The requested display order is not valid. The display order must be between 1 and 3.",
+		PublicMsg: "The requested display order is not valid. The display order must be between 1 and 9.",
+	}
+	ErrPhotoNotPendingReview = &AppError{
+		Err: errors.New("photo not pending review"),
+		Code: "PHOTO_NOT_PENDING_REVIEW",
+		HTTPStatusCode: http.StatusConflict,
+		GRPCStatusCode: codes.FailedPrecondition,
+		PublicMsg: "This photo has already been reviewed.",
+	}
+	ErrReviewedPhotoChanged = &AppError{
+		Err: errors.New("reviewed photo changed"),
+		Code: "REVIEWED_PHOTO_CHANGED",
+		HTTPStatusCode: http.StatusConflict,
+		GRPCStatusCode: codes.FailedPrecondition,
+		PublicMsg: "This photo was replaced or moved after the review queue was loaded. Please reload the queue.",
+	}
+	ErrPhotoRejectionReasonRequired = &AppError{
+		Err: errors.New("photo rejection reason required"),
+		Code: "PHOTO_REJECTION_REASON_REQUIRED",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg: "A reason is required to reject a photo. It is shared with the user.",
+	}
+	ErrPhotoRejectionReasonTooLong = &AppError{
+		Err: errors.New("photo rejection reason too long"),
+		Code: "PHOTO_REJECTION_REASON_TOO_LONG",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg: "The rejection reason is too long. Please shorten it and try again.",
+	}
+	ErrPhotoSlotNotAvailable = &AppError{
+		Err: errors.New("photo slot not available on plan"),
+		Code: "PHOTO_SLOT_NOT_AVAILABLE",
+		HTTPStatusCode: http.StatusForbidden,
+		GRPCStatusCode: codes.PermissionDenied,
+		PublicMsg: "This photo slot is not available on your current plan. Upgrade to premium to add more photos.",
+	}
+	ErrInvalidPhotoOrdering = &AppError{
+		Err: errors.New("invalid photo ordering"),
+		Code: "INVALID_PHOTO_ORDERING",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg: "The new order must list each of your current additional photos exactly once.",
+	}
+	ErrPhotoNotOwned = &AppError{
+		Err: errors.New("photo object key does not belong to user"),
+		Code: "PHOTO_NOT_OWNED",
+		HTTPStatusCode: http.StatusForbidden,
+		GRPCStatusCode: codes.PermissionDenied,
+		PublicMsg: "The uploaded photo does not belong to your account.",
+	}
+	ErrUploadedPhotoNotFound = &AppError{
+		Err: errors.New("uploaded photo not found in storage"),
+		Code: "UPLOADED_PHOTO_NOT_FOUND",
+		HTTPStatusCode: http.StatusNotFound,
+		GRPCStatusCode: codes.NotFound,
+		PublicMsg: "The uploaded photo was not found. Please upload the photo again before confirming.",
 	}
 )
\ No newline at end of file
@@ -99,6 +99,60 @@ var (
 		HTTPStatusCode: http.StatusForbidden,
 		GRPCStatusCode: codes.PermissionDenied,
 		PublicMsg:      "You cannot access the profile."}
+	ErrInvalidNakshatra = &AppError{
+		Err:            errors.New("invalid nakshatra"),
+		Code:           "INVALID_NAKSHATRA",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "The selected birth star (nakshatra) is not recognized."}
+	ErrInvalidRasi = &AppError{
+		Err:            errors.New("invalid rasi"),
+		Code:           "INVALID_RASI",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "The selected rasi is not recognized."}
+	ErrNakshatraRasiMismatch = &AppError{
+		Err:            errors.New("nakshatra does not fall in rasi"),
+		Code:           "NAKSHATRA_RASI_MISMATCH",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "The selected birth star does not fall in the selected rasi. Please check your horoscope."}
+	ErrInvalidBirthTime = &AppError{
+		Err:            errors.New("invalid birth time"),
+		Code:           "INVALID_BIRTH_TIME",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Birth time must be in 24 hour HH:MM format."}
+	ErrInvalidBirthPlace = &AppError{
+		Err:            errors.New("invalid birth place"),
+		Code:           "INVALID_BIRTH_PLACE",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Birth place must be at most 200 characters."}
+	ErrInvalidLocale = &AppError{
+		Err:            errors.New("invalid locale"),
+		Code:           "INVALID_LOCALE",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Preferred language must be en or ml."}
+	ErrInvalidDigestFrequency = &AppError{
+		Err:            errors.New("invalid digest frequency"),
+		Code:           "INVALID_DIGEST_FREQUENCY",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Digest frequency must be daily, weekly or off."}
+	ErrInvalidCountry = &AppError{
+		Err:            errors.New("invalid country"),
+		Code:           "INVALID_COUNTRY",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Country must be a two letter ISO country code."}
+	ErrInvalidLocation = &AppError{
+		Err:            errors.New("invalid location"),
+		Code:           "INVALID_LOCATION",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Location is not valid. A state needs a country, a district needs a state, and a Kerala district must be one of the 14 districts."}
 )
 
 // Partner Preferences errors
@@ -115,6 +169,52 @@ var (
 		HTTPStatusCode: http.StatusBadRequest,
 		GRPCStatusCode: codes.AlreadyExists,
 		PublicMsg:      "Partner preferences already exists. Please try to update your partner preferences instead."}
+	ErrInvalidMinPoruthamScore = &AppError{
+		Err:            errors.New("invalid minimum porutham score"),
+		Code:           "INVALID_MIN_PORUTHAM_SCORE",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Minimum porutham score must be between 0 and 10."}
+	ErrInvalidMatchCriterion = &AppError{
+		Err:            errors.New("invalid match criterion"),
+		Code:           "INVALID_MATCH_CRITERION",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Must-have criteria must be from age, height, physically_challenged, community, marital_status, profession, profession_type, education_level, home_district, native_country, residence_country, porutham or dosham."}
+)
+
+// Search errors
+var (
+	ErrInvalidSearchSort = &AppError{
+		Err:            errors.New("invalid search sort"),
+		Code:           "INVALID_SEARCH_SORT",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Sort must be one of recently_active, newest, age_asc, age_desc, height_asc or height_desc."}
+	ErrInvalidActiveWithinDays = &AppError{
+		Err:            errors.New("invalid active within days"),
+		Code:           "INVALID_ACTIVE_WITHIN_DAYS",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Recently active must be between 0 and 365 days."}
+	ErrInvalidSavedSearchName = &AppError{
+		Err:            errors.New("invalid saved search name"),
+		Code:           "INVALID_SAVED_SEARCH_NAME",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Please give the search a name of up to 100 characters."}
+	ErrSavedSearchLimitReached = &AppError{
+		Err:            errors.New("saved search limit reached"),
+		Code:           "SAVED_SEARCH_LIMIT_REACHED",
+		HTTPStatusCode: http.StatusConflict,
+		GRPCStatusCode: codes.FailedPrecondition,
+		PublicMsg:      "You can save up to 10 searches. Please delete one to save a new search."}
+	ErrSavedSearchNotFound = &AppError{
+		Err:            errors.New("saved search not found"),
+		Code:           "SAVED_SEARCH_NOT_FOUND",
+		HTTPStatusCode: http.StatusNotFound,
+		GRPCStatusCode: codes.NotFound,
+		PublicMsg:      "Saved search not found."}
 )
 
 
@@ -132,4 +232,151 @@ var (
 		HTTPStatusCode: http.StatusBadRequest,
 		GRPCStatusCode: codes.InvalidArgument,
 		PublicMsg:      "The selected target profile ID does not exist. Please try again."}
-)
\ No newline at end of file
+	ErrNoMatchActionToUndo = &AppError{
+		Err:            errors.New("no match action to undo"),
+		Code:           "NO_MATCH_ACTION_TO_UNDO",
+		HTTPStatusCode: http.StatusNotFound,
+		GRPCStatusCode: codes.NotFound,
+		PublicMsg:      "There is no recent like or pass to undo."}
+	ErrInterestNotFound = &AppError{
+		Err:            errors.New("interest not found"),
+		Code:           "INTEREST_NOT_FOUND",
+		HTTPStatusCode: http.StatusNotFound,
+		GRPCStatusCode: codes.NotFound,
+		PublicMsg:      "This interest is no longer pending."}
+)
+// User Block errors
+var (
+	ErrProfileBlocked = &AppError{
+		Err:            errors.New("profile blocked"),
+		Code:           "PROFILE_BLOCKED",
+		HTTPStatusCode: http.StatusForbidden,
+		GRPCStatusCode: codes.PermissionDenied,
+		PublicMsg:      "You cannot interact with this profile."}
+	ErrCannotBlockSelf = &AppError{
+		Err:            errors.New("cannot block own profile"),
+		Code:           "CANNOT_BLOCK_SELF",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "You cannot block your own profile."}
+)
+
+// Shortlist errors
+var (
+	ErrCannotShortlistSelf = &AppError{
+		Err:            errors.New("cannot shortlist own profile"),
+		Code:           "CANNOT_SHORTLIST_SELF",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "You cannot shortlist your own profile."}
+	ErrShortlistNoteTooLong = &AppError{
+		Err:            errors.New("shortlist note too long"),
+		Code:           "SHORTLIST_NOTE_TOO_LONG",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "The note is too long. Please shorten it and try again."}
+	ErrShortlistEntryNotFound = &AppError{
+		Err:            errors.New("shortlist entry not found"),
+		Code:           "SHORTLIST_ENTRY_NOT_FOUND",
+		HTTPStatusCode: http.StatusNotFound,
+		GRPCStatusCode: codes.NotFound,
+		PublicMsg:      "This profile is not in your shortlist."}
+)
+
+// Report and Moderation errors
+var (
+	ErrInvalidReportCategory = &AppError{
+		Err:            errors.New("invalid report category"),
+		Code:           "INVALID_REPORT_CATEGORY",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "The selected report category is not recognized. Please try again."}
+	ErrInvalidReportTarget = &AppError{
+		Err:            errors.New("invalid report target"),
+		Code:           "INVALID_REPORT_TARGET",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "The reported item is not valid. Please try again."}
+	ErrReportDescriptionTooLong = &AppError{
+		Err:            errors.New("report description too long"),
+		Code:           "REPORT_DESCRIPTION_TOO_LONG",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "The report description is too long. Please shorten it and try again."}
+	ErrCannotReportSelf = &AppError{
+		Err:            errors.New("cannot report own profile"),
+		Code:           "CANNOT_REPORT_SELF",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "You cannot report your own profile."}
+	ErrReportNotFound = &AppError{
+		Err:            errors.New("report not found"),
+		Code:           "REPORT_NOT_FOUND",
+		HTTPStatusCode: http.StatusNotFound,
+		GRPCStatusCode: codes.NotFound,
+		PublicMsg:      "Report not found."}
+	ErrReportAlreadyResolved = &AppError{
+		Err:            errors.New("report already resolved"),
+		Code:           "REPORT_ALREADY_RESOLVED",
+		HTTPStatusCode: http.StatusConflict,
+		GRPCStatusCode: codes.FailedPrecondition,
+		PublicMsg:      "This report has already been resolved."}
+	ErrReportedPhotoChanged = &AppError{
+		Err:            errors.New("reported photo changed"),
+		Code:           "REPORTED_PHOTO_CHANGED",
+		HTTPStatusCode: http.StatusConflict,
+		GRPCStatusCode: codes.FailedPrecondition,
+		PublicMsg:      "The reported photo has since been replaced or removed."}
+	ErrInvalidModerationAction = &AppError{
+		Err:            errors.New("invalid moderation action"),
+		Code:           "INVALID_MODERATION_ACTION",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "The selected moderation action is not valid for this report."}
+	ErrInvalidReportStatus = &AppError{
+		Err:            errors.New("invalid report status"),
+		Code:           "INVALID_REPORT_STATUS",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "The selected report status is not recognized. Please try again."}
+	ErrInvalidProfileChangeSection = &AppError{
+		Err:            errors.New("invalid profile change section"),
+		Code:           "INVALID_PROFILE_CHANGE_SECTION",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Section must be profile or partner_preferences."}
+)
+
+// Reference Data errors
+var (
+	ErrInvalidTaxonomy = &AppError{
+		Err:            errors.New("invalid taxonomy"),
+		Code:           "INVALID_TAXONOMY",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Taxonomy must be one of community, marital_status, profession, profession_type or education_level."}
+	ErrInvalidReferenceOptionValue = &AppError{
+		Err:            errors.New("invalid reference option value"),
+		Code:           "INVALID_REFERENCE_OPTION_VALUE",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Value must be lowercase letters, digits and underscores, up to 100 characters, and cannot be 'any'."}
+	ErrInvalidReferenceOptionLabel = &AppError{
+		Err:            errors.New("invalid reference option label"),
+		Code:           "INVALID_REFERENCE_OPTION_LABEL",
+		HTTPStatusCode: http.StatusBadRequest,
+		GRPCStatusCode: codes.InvalidArgument,
+		PublicMsg:      "Label is required and must be at most 200 characters."}
+	ErrReferenceOptionNotFound = &AppError{
+		Err:            errors.New("reference option not found"),
+		Code:           "REFERENCE_OPTION_NOT_FOUND",
+		HTTPStatusCode: http.StatusNotFound,
+		GRPCStatusCode: codes.NotFound,
+		PublicMsg:      "Reference option not found."}
+	ErrReferenceOptionAlreadyExists = &AppError{
+		Err:            errors.New("reference option already exists"),
+		Code:           "REFERENCE_OPTION_ALREADY_EXISTS",
+		HTTPStatusCode: http.StatusConflict,
+		GRPCStatusCode: codes.AlreadyExists,
+		PublicMsg:      "An option with this value already exists in the taxonomy."}
+)
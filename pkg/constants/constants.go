@@ -21,6 +21,7 @@ const (
 	Unknown         = "unknown"
 	HTTPStatusCode  = "http_status_code"
 	UserFriendlyMessage     = "user_message"
+	MessageKey      = "message_key"
 
 	InteralServerErrorMessage = "Something went wrong. Please try again later."
 
@@ -36,6 +37,10 @@ const (
 	ContextKeyRole        = "role"
 	ContextKeyRequestID   = "request_id"
 	ContextKeyAccessToken = "access_token"
+	ContextKeyLocale      = "locale"
+	// Lazily looks up the language saved on the signed in user's profile
+	ContextKeyProfileLocale = "profile_locale"
+	HeaderAcceptLanguage  = "Accept-Language"
 	HeaderAuthorization   = "Authorization"
 	HeaderRefreshToken    = "Refresh-Token"
 	BearerTokenPrefix     = "Bearer "
@@ -84,9 +89,45 @@ const (
 	DefaultPaginationLimit = 10
 	MaxPaginationLimit = 50
 
+	// Shortlist
+	MaxShortlistNoteLength = 500
+
+	// Saved searches
+	MaxSavedSearchesPerUser   = 10
+	MaxSavedSearchNameLength  = 100
+	MaxSearchActiveWithinDays = 365
+
+	// Match digest emails
+	MaxMatchDigestProfiles = 5
+
+	// Additional photo slots, the number available to a user depends on the
+	// plan; this is the upper bound across all plans.
+	MaxAdditionalPhotoSlots = 9
+
 	// Image file
 	ImageFileMaxSize = 5 * 1024 * 1024
 
+	// Processed photo sizes, the longest side is scaled down to the given pixels.
+	// Full size is stored under the original object key, other sizes under derived keys.
+	ImageSizeThumbnail = "thumbnail"
+	ImageSizeMedium    = "medium"
+	ImageSizeFull      = "full"
+
+	ImageThumbnailMaxDimension = 200
+	ImageMediumMaxDimension    = 600
+	ImageFullMaxDimension      = 1600
+	ImageJPEGQuality           = 85
+	ProcessedImageContentType  = "image/jpeg"
+
+	// Decoding holds every pixel in memory, 4 bytes each, so a small file
+	// declaring huge dimensions is refused before it is decoded.
+	ImageMaxPixels = 25_000_000
+
+	// Media storage providers
+	MediaStorageProviderGCS   = "gcs"
+	MediaStorageProviderLocal = "local"
+	MediaStorageProviderS3    = "s3"
+
 	// gcs storage
 	ProfilePhotoStorageDirectory = "profile-photos"
 	AdditionalPhotoStorageDirectory = "additional-photos"
@@ -10,4 +10,11 @@ const (
 	EventAdminBlockedUser    = "admin.blocked.user"
 	EventUserInterestSent    = "user.interest.sent"
 	EventMutualMatchCreated  = "mutual.match.created"
+	EventMutualMatchRemoved  = "mutual.match.removed"
+	EventUserBlockUpdated    = "user.block.updated"
+	EventChatMessageReported = "chat.message.reported"
+	EventUserWarned          = "user.warned"
+	EventUserPhotoRejected   = "user.photo.rejected"
+	EventSavedSearchMatched  = "user.saved_search.matched"
+	EventMatchDigest         = "user.match_digest"
 )
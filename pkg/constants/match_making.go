@@ -15,6 +15,43 @@ const (
 	MatchMakingOptionMutual string = "mutual"
 )
 
+const (
+	// Partner preference dimensions a recommendation is scored on, each can be
+	// marked as a must-have to turn it into a hard filter
+	MatchCriterionAge                  string = "age"
+	MatchCriterionHeight               string = "height"
+	MatchCriterionPhysicallyChallenged string = "physically_challenged"
+	MatchCriterionCommunity            string = "community"
+	MatchCriterionMaritalStatus        string = "marital_status"
+	MatchCriterionProfession           string = "profession"
+	MatchCriterionProfessionType       string = "profession_type"
+	MatchCriterionEducationLevel       string = "education_level"
+	MatchCriterionHomeDistrict         string = "home_district"
+	MatchCriterionNativeCountry        string = "native_country"
+	MatchCriterionResidenceCountry     string = "residence_country"
+	MatchCriterionPorutham             string = "porutham"
+	MatchCriterionDosham               string = "dosham"
+)
 
+const (
+	// Activity badges of a profile, by the time since its last login
+	ActivityStatusActiveToday    string = "active_today"
+	ActivityStatusActiveThisWeek string = "active_this_week"
+)
 
+const (
+	// How often a user gets the match digest email
+	DigestFrequencyDaily  string = "daily"
+	DigestFrequencyWeekly string = "weekly"
+	DigestFrequencyOff    string = "off"
+)
 
+const (
+	// Sort orders of the profile search
+	SearchSortRecentlyActive string = "recently_active"
+	SearchSortNewest         string = "newest"
+	SearchSortAgeAsc         string = "age_asc"
+	SearchSortAgeDesc        string = "age_desc"
+	SearchSortHeightAsc      string = "height_asc"
+	SearchSortHeightDesc     string = "height_desc"
+)
@@ -0,0 +1,45 @@
+package constants
+
+const (
+	// Report targets
+	ReportTargetProfile string = "profile"
+	ReportTargetPhoto   string = "photo"
+	ReportTargetMessage string = "message"
+)
+
+const (
+	// Report status
+	ReportStatusPending   string = "pending"
+	ReportStatusDismissed string = "dismissed"
+	ReportStatusActioned  string = "actioned"
+)
+
+const (
+	// Moderation actions an admin can take on a report
+	ModerationActionDismiss     string = "dismiss"
+	ModerationActionWarn        string = "warn"
+	ModerationActionRemovePhoto string = "remove_photo"
+	ModerationActionSuspend     string = "suspend"
+)
+
+const (
+	// Display order 0 refers to the profile photo, 1..MaxAdditionalPhotoSlots
+	// to additional photos
+	ReportProfilePhotoDisplayOrder = 0
+	MaxReportDescriptionLength     = 1000
+)
+
+const (
+	// Photo review status, new uploads stay hidden from other users until approved
+	PhotoStatusPendingReview string = "pending_review"
+	PhotoStatusApproved      string = "approved"
+	PhotoStatusRejected      string = "rejected"
+
+	MaxPhotoRejectionReasonLength = 500
+)
+
+const (
+	// Sections of the profile change history
+	ProfileChangeSectionProfile            string = "profile"
+	ProfileChangeSectionPartnerPreferences string = "partner_preferences"
+)
@@ -8,6 +8,7 @@ type UserOTPRequestedEvent struct {
 	Email         string `json:"email"`
 	OTP           string `json:"otp"`
 	ExpiryMinutes int    `json:"expiry_minutes"`
+	Locale        string `json:"locale,omitempty"` // language of the registration request, see pkg/i18n
 }
 
 type UserLoginSuccessEvent struct {
@@ -0,0 +1,17 @@
+package chatevents
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MessageReportedEvent struct {
+	ReporterUserID uuid.UUID `json:"reporter_user_id"`
+	SenderUserID   uuid.UUID `json:"sender_user_id"`
+	MessageID      string    `json:"message_id"`
+	MessageContent string    `json:"message_content"`
+	Category       string    `json:"category"`
+	Description    string    `json:"description"`
+	ReportedAt     time.Time `json:"reported_at"`
+}
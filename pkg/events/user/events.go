@@ -20,6 +20,7 @@ type UserInterestSentEvent struct {
 	ReceiverEmail   string `json:"receiver_user_id"`
 	SenderProfileID int64  `json:"sender_profile_id"`
 	SenderName      string `json:"sender_name"`
+	ReceiverLocale  string `json:"receiver_locale,omitempty"` // see pkg/i18n
 }
 
 type MutualMatchCreatedEvent struct {
@@ -29,4 +30,81 @@ type MutualMatchCreatedEvent struct {
 	User2Email     string `json:"user2_email"`
 	User2ProfileID int64  `json:"user2_profile_id"`
 	User2FullName  string `json:"user2_full_name"`
+	User1Locale    string `json:"user1_locale,omitempty"`
+	User2Locale    string `json:"user2_locale,omitempty"`
+
+	// The chat service reopens the users' conversation on a re-match,
+	// MatchedAt orders the event against a MutualMatchRemovedEvent
+	User1ID   uuid.UUID `json:"user1_id"`
+	User2ID   uuid.UUID `json:"user2_id"`
+	MatchedAt time.Time `json:"matched_at"`
+}
+
+// MutualMatchRemovedEvent is published when a mutual match ends, by a pass
+// or by undoing the like that created it. Only the matched user, the one
+// who did not end it, is notified.
+type MutualMatchRemovedEvent struct {
+	RemovedByUserID     uuid.UUID `json:"removed_by_user_id"`
+	RemovedByFullName   string    `json:"removed_by_full_name"`
+	MatchedUserID       uuid.UUID `json:"matched_user_id"`
+	MatchedUserEmail    string    `json:"matched_user_email"`
+	MatchedUserFullName string    `json:"matched_user_full_name"`
+	MatchedUserLocale   string    `json:"matched_user_locale,omitempty"`
+	RemovedAt           time.Time `json:"removed_at"`
+}
+
+type UserBlockUpdatedEvent struct {
+	BlockerUserID uuid.UUID `json:"blocker_user_id"`
+	BlockedUserID uuid.UUID `json:"blocked_user_id"`
+	IsBlocked     bool      `json:"is_blocked"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+type UserWarnedEvent struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Email    string    `json:"email"`
+	FullName string    `json:"full_name"`
+	Category string    `json:"category"`
+	Locale   string    `json:"locale,omitempty"`
+}
+
+// SavedSearchMatchedEvent is published when profiles that joined since the
+// last check match a saved search with alerts on.
+type SavedSearchMatchedEvent struct {
+	UserID     uuid.UUID `json:"user_id"`
+	Email      string    `json:"email"`
+	FullName   string    `json:"full_name"`
+	SearchName string    `json:"search_name"`
+	MatchCount int64     `json:"match_count"`
+	Locale     string    `json:"locale,omitempty"`
+}
+
+// MatchDigestEvent carries the top new recommendations for a user since
+// their last digest, at most constants.MaxMatchDigestProfiles of them.
+type MatchDigestEvent struct {
+	UserID    uuid.UUID            `json:"user_id"`
+	Email     string               `json:"email"`
+	FullName  string               `json:"full_name"`
+	Frequency string               `json:"frequency"` // daily or weekly
+	Profiles  []MatchDigestProfile `json:"profiles"`
+	Locale    string               `json:"locale,omitempty"`
+}
+
+type MatchDigestProfile struct {
+	ProfileID          int64  `json:"profile_id"`
+	FullName           string `json:"full_name"`
+	Age                int32  `json:"age"`
+	HeightCm           int32  `json:"height_cm"`
+	Profession         string `json:"profession"`
+	HomeDistrict       string `json:"home_district"`
+	CompatibilityScore int32  `json:"compatibility_score"`
+}
+
+type UserPhotoRejectedEvent struct {
+	UserID       uuid.UUID `json:"user_id"`
+	Email        string    `json:"email"`
+	FullName     string    `json:"full_name"`
+	DisplayOrder int32     `json:"display_order"` // 0 for profile photo
+	Reason       string    `json:"reason"`
+	Locale       string    `json:"locale,omitempty"`
 }
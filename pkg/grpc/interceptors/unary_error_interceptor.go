@@ -34,6 +34,7 @@ func UnaryErrorInterceptor() grpc.UnaryServerInterceptor {
 					Metadata: map[string]string{
 						constants.HTTPStatusCode: strconv.Itoa(ae.HTTPStatusCode), 
 						constants.UserFriendlyMessage:     ae.PublicMsg,                    
+						constants.MessageKey:              ae.Key(),
 					},
 				})
 			if detErr != nil {
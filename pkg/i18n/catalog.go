@@ -0,0 +1,36 @@
+package i18n
+
+import "fmt"
+
+// Message keys are either an error code from pkg/apperrors, e.g.
+// "INVALID_OTP", or a dotted lower case key, e.g. "email.otp.subject".
+// English error messages are not repeated here, the PublicMsg of the error
+// is the English text.
+var catalogs = map[Locale]map[string]string{
+	LocaleEnglish:   english,
+	LocaleMalayalam: malayalam,
+}
+
+// Text returns the message for key in locale, falling back to English and
+// then to the key itself.
+func Text(locale Locale, key string) string {
+	return TextOr(locale, key, key)
+}
+
+// TextOr returns the message for key in locale, falling back to English and
+// then to fallback.
+func TextOr(locale Locale, key, fallback string) string {
+	if msg, ok := catalogs[locale][key]; ok {
+		return msg
+	}
+	if msg, ok := catalogs[DefaultLocale][key]; ok {
+		return msg
+	}
+	return fallback
+}
+
+// Format formats the message for key with args. Messages use explicit
+// argument indexes, e.g. %[1]s, so translations can reorder them.
+func Format(locale Locale, key string, args ...interface{}) string {
+	return fmt.Sprintf(Text(locale, key), args...)
+}
@@ -0,0 +1,91 @@
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Locale is a language code as used in Accept-Language, e.g. "ml".
+type Locale string
+
+const (
+	LocaleEnglish   Locale = "en"
+	LocaleMalayalam Locale = "ml"
+
+	DefaultLocale = LocaleEnglish
+)
+
+func (l Locale) IsSupported() bool {
+	_, ok := catalogs[l]
+	return ok
+}
+
+// Locales returns every supported locale.
+func Locales() []Locale {
+	return []Locale{LocaleEnglish, LocaleMalayalam}
+}
+
+// ParseLocale reads a language tag such as "ml", "ml-IN" or "EN_us" and
+// reports whether it names a supported locale.
+func ParseLocale(tag string) (Locale, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	locale := Locale(tag)
+	return locale, locale.IsSupported()
+}
+
+// FromAcceptLanguage picks the supported locale the client prefers most from
+// an Accept-Language header, e.g. "ml-IN,ml;q=0.9,en;q=0.8".
+func FromAcceptLanguage(header string) (Locale, bool) {
+	type candidate struct {
+		locale Locale
+		q      float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		locale, ok := ParseLocale(fields[0])
+		if !ok {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			if parsed, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64); err == nil {
+				q = parsed
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{locale: locale, q: q})
+	}
+
+	if len(candidates) == 0 {
+		return "", false
+	}
+	// Stable, so equal weights keep the order the client sent them in.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	return candidates[0].locale, true
+}
+
+// Resolve returns the first supported locale among the given tags, the
+// default locale when none is supported.
+func Resolve(tags ...string) Locale {
+	for _, tag := range tags {
+		if locale, ok := ParseLocale(tag); ok {
+			return locale
+		}
+	}
+	return DefaultLocale
+}
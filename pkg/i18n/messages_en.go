@@ -0,0 +1,195 @@
+package i18n
+
+var english = map[string]string{
+	// API responses
+	"response.request_failed": "Request failed",
+	"INTERNAL_SERVER_ERROR":   "Something went wrong. Please try again later.",
+
+	// Emails
+	"email.otp.subject": "OTP Verification",
+	"email.otp.body": `Hello %[1]s,
+
+Your One-Time Password (OTP) for verifying your account on Qubool Kallyanam is:
+
+	%[2]s
+
+Please enter this OTP to complete your verification. It will expire in %[3]d minutes.
+
+If you didn't request this, you can safely ignore this email.
+
+Regards,
+Team Qubool Kallyanam`,
+
+	"email.account_deletion.subject": "Account Deletion Confirmation",
+	"email.account_deletion.body": `Hello %[1]s,
+
+We are writing to confirm that your account on Qubool Kallyanam has been successfully deleted.
+
+Thank you for having been part of our community. We are sorry to see you go, and we hope our paths cross again in the future. If you have any feedback or questions, feel free to reach out to us at support@quboolkallyanam.xyz.
+
+Wishing you all the best in your journey ahead!
+
+Warm regards,
+Team Qubool Kallyanam`,
+
+	"email.account_status.subject": "Account reviewed by an admin",
+	"email.account_blocked.body": `Hello %[1]s,
+
+We'd like to inform you that your account has been blocked.
+
+Your account on Qubool Kallyanam has been temporarily blocked by our administrators due to a policy violation or unusual activity.
+
+During this time, you will not be able to access your account or use our services.
+
+If you believe this is a mistake or have any questions, please contact our support team at support@quboolkallyanam.xyz.
+
+Thank you for being a part of our community.
+
+Warm regards,
+Team Qubool Kallyanam`,
+	"email.account_unblocked.body": `Hello %[1]s,
+
+We'd like to inform you that your account has been unblocked.
+
+Your account on Qubool Kallyanam has been successfully unblocked by our administrators.
+
+You may now log in and continue using our services as usual.
+
+If you face any issues, please do not hesitate to contact our support team at support@quboolkallyanam.xyz.
+
+Thank you for being a part of our community.
+
+Warm regards,
+Team Qubool Kallyanam`,
+
+	"email.interest_sent.subject": "New interest on Qubool Kallyanam",
+	"email.interest_sent.body": `Hello %[1]s,
+
+You have received a new interest on Qubool Kallyanam!
+
+%[2]s (Profile ID: %[3]d) has expressed interest in your profile.
+
+To view their profile and respond, please log in to your Qubool Kallyanam account.
+
+If you did not expect this or need help, please contact us at support@quboolkallyanam.xyz.
+
+Warm regards,
+Team Qubool Kallyanam`,
+
+	"email.mutual_match.subject": "New mutual match on Qubool Kallyanam",
+	"email.mutual_match.body": `Hello,
+
+Great news — it's a mutual match on Qubool Kallyanam!
+
+%[1]s (Profile ID: %[2]d) and %[3]s (Profile ID: %[4]d) have both shown interest in each other's profiles. Congratulations!
+
+You can view their profiles and start a conversation by logging in to your Qubool Kallyanam account.
+
+A few quick tips:
+- Only share personal contact details when you feel comfortable.
+- Use the platform chat to get to know each other first.
+- If you need any help, contact us at support@quboolkallyanam.xyz.
+
+Wishing you the best,
+Team Qubool Kallyanam`,
+
+	"email.user_warned.subject": "A note from the Qubool Kallyanam moderation team",
+	"email.user_warned.body": `Hello %[1]s,
+
+Our moderation team has reviewed a report about your account on Qubool Kallyanam (reason: %[2]s).
+
+Please make sure your profile, photos and messages follow our community guidelines. Repeated violations may lead to your account being suspended.
+
+If you believe this is a mistake or have any questions, please contact our support team at support@quboolkallyanam.xyz.
+
+Thank you for helping us keep the community safe.
+
+Warm regards,
+Team Qubool Kallyanam`,
+
+	"email.photo_rejected.subject":          "Your photo was not approved",
+	"email.photo_rejected.profile_photo":    "your profile photo",
+	"email.photo_rejected.additional_photo": "additional photo #%[1]d",
+	"email.photo_rejected.body": `Hello %[1]s,
+
+Our moderation team has reviewed %[2]s on Qubool Kallyanam and could not approve it.
+
+Reason: %[3]s
+
+The photo has been removed from your profile. You are welcome to upload a new photo that follows our community guidelines, it will be visible to other members once it is approved.
+
+If you have any questions, please contact our support team at support@quboolkallyanam.xyz.
+
+Warm regards,
+Team Qubool Kallyanam`,
+
+	"email.mutual_match_removed.subject": "An update on one of your matches",
+	"email.mutual_match_removed.body": `Hello %[1]s,
+
+Your mutual match with %[2]s on Qubool Kallyanam is no longer active.
+
+Your conversation has been kept so you can read it, but new messages can no longer be sent. If you match again, the conversation will reopen.
+
+New profiles join every day, log in to your Qubool Kallyanam account to see your latest recommendations.
+
+Warm regards,
+Team Qubool Kallyanam`,
+
+	"email.saved_search_matched.subject": "New profiles match your saved search",
+	"email.saved_search_matched.body": `Hello %[1]s,
+
+%[2]d new profile(s) on Qubool Kallyanam match your saved search "%[3]s".
+
+Log in to your Qubool Kallyanam account and run the search to see them.
+
+You can turn off these alerts or delete the search at any time from your saved searches.
+
+Warm regards,
+Team Qubool Kallyanam`,
+
+	"email.match_digest.subject.daily":  "Today's new matches on Qubool Kallyanam",
+	"email.match_digest.subject.weekly": "This week's new matches on Qubool Kallyanam",
+	"email.match_digest.profile_card": `%[1]s (Profile ID %[2]d)
+%[3]d years, %[4]d cm, %[5]s, %[6]s
+%[7]d%% match with your preferences`,
+	"email.match_digest.body": `Hello %[1]s,
+
+New members have joined Qubool Kallyanam who fit your partner preferences:
+
+%[2]s
+
+Log in to your Qubool Kallyanam account to view their profiles and send an interest.
+
+You can change how often you receive this email, or turn it off, in your profile settings.
+
+Warm regards,
+Team Qubool Kallyanam`,
+
+	// Payment pages
+	"page.payment.title":          "Secure Payment - Qubool Kallyanam",
+	"page.payment.subtitle":       "Secure Payment Gateway",
+	"page.payment.valid_for":      "Valid for %[1]d days",
+	"page.payment.processing":     "Processing payment...",
+	"page.payment.pay_button":     "Pay ₹%[1]s Securely",
+	"page.payment.security_badge": "🔒 256-bit SSL encryption • PCI DSS compliant",
+	"page.payment.cancelled":      "Payment was cancelled. You can try again.",
+	"page.payment.failed_prefix":  "Payment failed: ",
+
+	"page.payment_success.title":           "Payment Successful - Qubool Kallyanam",
+	"page.payment_success.heading":         "Payment Successful!",
+	"page.payment_success.subtitle":        "Congratulations! Your subscription has been activated successfully.",
+	"page.payment_success.subscription_id": "Subscription ID:",
+	"page.payment_success.start_date":      "Start Date:",
+	"page.payment_success.end_date":        "End Date:",
+	"page.payment_success.status":          "Status:",
+	"page.payment_success.footer_note":     "Your subscription details are below. Please save this information for your records.",
+
+	"page.payment_failed.title":     "Payment Failed - Qubool Kallyanam",
+	"page.payment_failed.heading":   "Payment Failed",
+	"page.payment_failed.subtitle":  "We're sorry, but your payment could not be processed at this time.",
+	"page.payment_failed.error":     "Error: %[1]s",
+	"page.payment_failed.generic":   "Payment processing failed",
+	"page.payment_failed.order_id":  "Order ID: %[1]s",
+	"page.payment_failed.try_again": "🔄 Try Again",
+	"page.payment_failed.go_home":   "Go Home",
+}
@@ -0,0 +1,331 @@
+package i18n
+
+var malayalam = map[string]string{
+	// API responses
+	"response.request_failed": "അഭ്യർത്ഥന പരാജയപ്പെട്ടു",
+	"INTERNAL_SERVER_ERROR":   "എന്തോ പിശക് സംഭവിച്ചു. ദയവായി കുറച്ചു കഴിഞ്ഞ് വീണ്ടും ശ്രമിക്കുക.",
+	"BAD_REQUEST":             "അഭ്യർത്ഥനയിലെ വിവരങ്ങൾ സാധുവല്ല.",
+
+	// Auth errors
+	"INVALID_PASSWORD":               "പാസ്‌വേഡ് തെറ്റാണ്. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"INVALID_EMAIL":                  "ഇമെയിൽ വിലാസം സാധുവല്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"INVALID_PHONE_NUMBER":           "ഫോൺ നമ്പർ സാധുവല്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"OTP_NOT_FOUND":                  "OTP കണ്ടെത്തിയില്ല. ദയവായി പുതിയ OTP ആവശ്യപ്പെടുക.",
+	"INVALID_OTP":                    "OTP തെറ്റാണ്. ദയവായി പുതിയ OTP ആവശ്യപ്പെടുക.",
+	"INVALID_CREDENTIALS":            "ലോഗിൻ വിവരങ്ങൾ തെറ്റാണ്. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"PENDING_REGISTRATION_NOT_FOUND": "പൂർത്തിയാകാത്ത രജിസ്ട്രേഷൻ കണ്ടെത്തിയില്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"ADMIN_NOT_FOUND":                "അഡ്മിൻ കണ്ടെത്തിയില്ല. ആദ്യം അഡ്മിൻ അക്കൗണ്ട് സൃഷ്ടിക്കുക.",
+	"ADMIN_ACCOUNT_DISABLED":         "അഡ്മിൻ അക്കൗണ്ട് പ്രവർത്തനരഹിതമാണ്. ദയവായി സപ്പോർട്ടുമായി ബന്ധപ്പെടുക.",
+	"INVALID_ADMIN_CREDENTIALS":      "അഡ്മിൻ ലോഗിൻ വിവരങ്ങൾ തെറ്റാണ്. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"USER_ALREADY_BLOCKED":           "ഈ ഉപയോക്താവിനെ ഇതിനകം ബ്ലോക്ക് ചെയ്തിട്ടുണ്ട്.",
+	"EMAIL_ALREADY_EXISTS":           "ഈ ഇമെയിൽ ഇതിനകം ഉപയോഗത്തിലുണ്ട്. ദയവായി മറ്റൊരു ഇമെയിൽ ഉപയോഗിക്കുക.",
+	"PHONE_ALREADY_EXISTS":           "ഈ ഫോൺ നമ്പർ ഇതിനകം ഉപയോഗത്തിലുണ്ട്. ദയവായി മറ്റൊരു നമ്പർ ഉപയോഗിക്കുക.",
+	"USER_BLOCKED":                   "നിങ്ങളുടെ അക്കൗണ്ട് ബ്ലോക്ക് ചെയ്തിരിക്കുന്നു. ദയവായി സപ്പോർട്ടുമായി ബന്ധപ്പെടുക.",
+	"INVALID_TOKEN":                  "ടോക്കൺ സാധുവല്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"EXPIRED_TOKEN":                  "ടോക്കണിന്റെ കാലാവധി കഴിഞ്ഞു. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"TOKEN_NOT_ACTIVE":               "ടോക്കൺ ഇതുവരെ സജീവമായിട്ടില്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"UNAUTHORIZED":                   "അനുമതിയില്ല. ദയവായി വീണ്ടും ലോഗിൻ ചെയ്യുക.",
+	"FORBIDDEN":                      "ഈ പ്രവർത്തനത്തിന് നിങ്ങൾക്ക് അനുമതിയില്ല.",
+	"ACCESS_TOKEN_NOT_FOUND":         "ആക്സസ് ടോക്കൺ കണ്ടെത്തിയില്ല. സാധുവായ ആക്സസ് ടോക്കണുമായി വീണ്ടും ശ്രമിക്കുക.",
+	"REFRESH_TOKEN_NOT_FOUND":        "റിഫ്രഷ് ടോക്കൺ കണ്ടെത്തിയില്ല. സാധുവായ റിഫ്രഷ് ടോക്കണുമായി വീണ്ടും ശ്രമിക്കുക.",
+
+	// Chat errors
+	"CONVERSATION_NOT_FOUND":    "സംഭാഷണം കണ്ടെത്തിയില്ല. സംഭാഷണം വീണ്ടും ആരംഭിച്ച് ശ്രമിക്കുക.",
+	"INVALID_CONVERSATION_ID":   "സംഭാഷണ ഐഡി സാധുവല്ല.",
+	"USER_NOT_PARTICIPANT":      "നിങ്ങൾ ഈ സംഭാഷണത്തിൽ പങ്കാളിയല്ല. സംഭാഷണം വീണ്ടും ആരംഭിച്ച് ശ്രമിക്കുക.",
+	"CONVERSATION_BLOCKED":      "ഈ ഉപയോക്താവിന് സന്ദേശം അയയ്ക്കാൻ കഴിയില്ല.",
+	"CONVERSATION_ARCHIVED":     "ഈ പൊരുത്തം അവസാനിച്ചു, സംഭാഷണം വായിക്കാൻ മാത്രമേ കഴിയൂ.",
+	"MESSAGE_NOT_FOUND":         "സന്ദേശം കണ്ടെത്തിയില്ല.",
+	"CANNOT_REPORT_OWN_MESSAGE": "സ്വന്തം സന്ദേശം റിപ്പോർട്ട് ചെയ്യാൻ കഴിയില്ല.",
+
+	// Common errors
+	"USER_NOT_FOUND":            "ദയവായി നിങ്ങളുടെ പ്രൊഫൈൽ പൂരിപ്പിച്ച ശേഷം വീണ്ടും ശ്രമിക്കുക.",
+	"PARTNER_USER_NOT_FOUND":    "ഈ ഐഡിയിൽ ഒരു പ്രൊഫൈലും നിലവിലില്ല.",
+	"INVALID_OPERATION_TYPE":    "പ്രവർത്തന തരം സാധുവല്ല.",
+	"INVALID_FIELD":             "മാറ്റാൻ ആവശ്യപ്പെട്ട ഫീൽഡ് നിലവിലില്ല.",
+	"INVALID_PAGINATION_PAGE":   "പേജ് നമ്പർ സാധുവല്ല.",
+	"INVALID_PAGINATION_LIMIT":  "ഒരു പേജിലെ എണ്ണം സാധുവല്ല.",
+	"INVALID_PAGINATION_CURSOR": "പേജ് സ്ഥാനം സാധുവല്ല. ദയവായി ആദ്യ പേജിൽ നിന്ന് വീണ്ടും തുടങ്ങുക.",
+	"MISSING_REQUIRED_FIELDS":   "ആവശ്യമായ ഫീൽഡുകൾ നൽകിയിട്ടില്ല.",
+	"INVALID_INPUT":             "നൽകിയ വിവരങ്ങൾ സാധുവല്ല.",
+
+	// Media errors
+	"FILE_TOO_LARGE":                  "ഫയലിന്റെ വലുപ്പം അനുവദനീയമായതിലും കൂടുതലാണ്.",
+	"INVALID_FILE_TYPE":               "ഈ ഫയൽ തരം പിന്തുണയ്ക്കുന്നില്ല.",
+	"INVALID_IMAGE_TYPE":              "ഈ ചിത്ര തരം പിന്തുണയ്ക്കുന്നില്ല. jpeg, jpg, png എന്നിവ മാത്രമേ അനുവദിക്കൂ.",
+	"INVALID_IMAGE_FILE_SIZE":         "ചിത്രത്തിന്റെ വലുപ്പം അനുവദനീയമല്ല. പരമാവധി 5MB ആണ്.",
+	"IMAGE_DIMENSIONS_TOO_LARGE":      "ചിത്രത്തിന്റെ റെസല്യൂഷൻ വളരെ കൂടുതലാണ്. പരമാവധി 25 മെഗാപിക്സൽ ആണ്.",
+	"PROFILE_PHOTO_NOT_FOUND":         "പ്രൊഫൈൽ ഫോട്ടോ കണ്ടെത്തിയില്ല. ദയവായി പ്രൊഫൈൽ ഫോട്ടോ അപ്‌ലോഡ് ചെയ്യുക.",
+	"IMAGE_DISPLAY_ORDER_OCCUPIED":    "ഈ സ്ഥാനത്ത് ഇതിനകം ഒരു ചിത്രമുണ്ട്. മറ്റൊരു സ്ഥാനം തിരഞ്ഞെടുക്കുക അല്ലെങ്കിൽ നിലവിലെ ചിത്രം നീക്കം ചെയ്ത ശേഷം ശ്രമിക്കുക.",
+	"DISPLAY_ORDER_NOT_OCCUPIED":      "ഈ സ്ഥാനത്ത് ചിത്രങ്ങളൊന്നുമില്ല.",
+	"INVALID_DISPLAY_ORDER":           "ചിത്രത്തിന്റെ സ്ഥാനം സാധുവല്ല. 1 മുതൽ 9 വരെ ആയിരിക്കണം.",
+	"PHOTO_NOT_PENDING_REVIEW":        "ഈ ഫോട്ടോ ഇതിനകം പരിശോധിച്ചു കഴിഞ്ഞു.",
+	"REVIEWED_PHOTO_CHANGED":          "പരിശോധനാ പട്ടിക തുറന്നതിന് ശേഷം ഈ ഫോട്ടോ മാറ്റുകയോ നീക്കുകയോ ചെയ്തു. പട്ടിക വീണ്ടും തുറക്കുക.",
+	"PHOTO_REJECTION_REASON_REQUIRED": "ഫോട്ടോ നിരസിക്കാൻ കാരണം നൽകണം. അത് ഉപയോക്താവിനെ അറിയിക്കും.",
+	"PHOTO_REJECTION_REASON_TOO_LONG": "നിരസിക്കാനുള്ള കാരണം വളരെ നീളമുള്ളതാണ്. ദയവായി ചുരുക്കി വീണ്ടും ശ്രമിക്കുക.",
+	"PHOTO_SLOT_NOT_AVAILABLE":        "നിങ്ങളുടെ നിലവിലെ പ്ലാനിൽ ഈ ഫോട്ടോ സ്ഥാനം ലഭ്യമല്ല. കൂടുതൽ ഫോട്ടോകൾ ചേർക്കാൻ പ്രീമിയത്തിലേക്ക് മാറുക.",
+	"INVALID_PHOTO_ORDERING":          "പുതിയ ക്രമത്തിൽ നിങ്ങളുടെ ഓരോ അധിക ഫോട്ടോയും കൃത്യം ഒരു തവണ ഉണ്ടായിരിക്കണം.",
+	"PHOTO_NOT_OWNED":                 "അപ്‌ലോഡ് ചെയ്ത ഫോട്ടോ നിങ്ങളുടെ അക്കൗണ്ടിന്റേതല്ല.",
+	"UPLOADED_PHOTO_NOT_FOUND":        "അപ്‌ലോഡ് ചെയ്ത ഫോട്ടോ കണ്ടെത്തിയില്ല. സ്ഥിരീകരിക്കുന്നതിന് മുമ്പ് ഫോട്ടോ വീണ്ടും അപ്‌ലോഡ് ചെയ്യുക.",
+
+	// Payment errors
+	"SUBSCRIPTION_PLAN_NOT_FOUND":             "തിരഞ്ഞെടുത്ത സബ്സ്ക്രിപ്ഷൻ പ്ലാൻ നിലവിലില്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"SUBSCRIPTION_PLAN_NOT_ACTIVE":            "തിരഞ്ഞെടുത്ത സബ്സ്ക്രിപ്ഷൻ പ്ലാൻ സജീവമല്ല. മറ്റൊരു പ്ലാൻ തിരഞ്ഞെടുക്കുക.",
+	"INVALID_SUBSCRIPTION_PLAN_ID":            "സബ്സ്ക്രിപ്ഷൻ പ്ലാൻ ഐഡി സാധുവല്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"INVALID_SUBSCRIPTION_PLAN_DURATION_DAYS": "സബ്സ്ക്രിപ്ഷൻ കാലാവധി സാധുവല്ല. സാധുവായ കാലാവധി തിരഞ്ഞെടുക്കുക.",
+	"INVALID_SUBSCRIPTION_PLAN_AMOUNT":        "സബ്സ്ക്രിപ്ഷൻ തുക സാധുവല്ല. സാധുവായ തുക നൽകുക.",
+	"INVALID_SUBSCRIPTION_PLAN_CURRENCY":      "തിരഞ്ഞെടുത്ത കറൻസി പിന്തുണയ്ക്കുന്നില്ല. സാധുവായ കറൻസി തിരഞ്ഞെടുക്കുക.",
+	"ACTIVE_SUBSCRIPTION_NOT_FOUND":           "സജീവമായ സബ്സ്ക്രിപ്ഷൻ കണ്ടെത്തിയില്ല.",
+	"PAYMENT_NOT_FOUND":                       "നൽകിയ പേയ്‌മെന്റ് വിവരങ്ങൾ ഞങ്ങളുടെ രേഖകളുമായി പൊരുത്തപ്പെടുന്നില്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"PAYMENT_ALREADY_COMPLETED":               "ഈ പേയ്‌മെന്റ് ഇതിനകം പൂർത്തിയായി.",
+	"PAYMENT_EXPIRED":                         "പേയ്‌മെന്റിനുള്ള സമയം കഴിഞ്ഞു. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"PAYMENT_SIGNATURE_INVALID":               "സിഗ്നേച്ചർ സാധുവല്ലാത്തതിനാൽ പേയ്‌മെന്റ് സ്ഥിരീകരിക്കാനായില്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"PAYMENT_PROCESSING_FAILED":               "ഇപ്പോൾ പേയ്‌മെന്റ് പൂർത്തിയാക്കാൻ കഴിയുന്നില്ല. കുറച്ചു കഴിഞ്ഞ് വീണ്ടും ശ്രമിക്കുക.",
+
+	// User service errors
+	"USER_PROFILE_NOT_FOUND":             "പ്രൊഫൈൽ കണ്ടെത്തിയില്ല. ആദ്യം നിങ്ങളുടെ പ്രൊഫൈൽ സൃഷ്ടിക്കുക.",
+	"INVALID_FULL_NAME":                  "പേര് 2 മുതൽ 100 വരെ ഇംഗ്ലീഷ് അക്ഷരങ്ങൾ ആയിരിക്കണം.",
+	"INVALID_COMMUNITY":                  "തിരഞ്ഞെടുത്ത സമുദായം തിരിച്ചറിയാനായില്ല.",
+	"INVALID_MARITAL_STATUS":             "തിരഞ്ഞെടുത്ത വൈവാഹിക നില തിരിച്ചറിയാനായില്ല.",
+	"INVALID_PROFESSION":                 "തിരഞ്ഞെടുത്ത തൊഴിൽ തിരിച്ചറിയാനായില്ല.",
+	"INVALID_PROFESSION_TYPE":            "തിരഞ്ഞെടുത്ത തൊഴിൽ വിഭാഗം തിരിച്ചറിയാനായില്ല.",
+	"INVALID_HIGHEST_EDUCATION_LEVEL":    "തിരഞ്ഞെടുത്ത വിദ്യാഭ്യാസ യോഗ്യത തിരിച്ചറിയാനായില്ല.",
+	"INVALID_HOME_DISTRICT":              "നൽകിയ ജില്ല തിരിച്ചറിയാനായില്ല.",
+	"INVALID_DATE_OF_BIRTH":              "ജനനത്തീയതി YYYY-MM-DD രൂപത്തിൽ നൽകുക.",
+	"INVALID_HEIGHT":                     "ഉയരം 130 മുതൽ 220 സെ.മീ. വരെ ആയിരിക്കണം.",
+	"INVALID_AGE":                        "പ്രായം 18 മുതൽ 100 വയസ്സ് വരെ ആയിരിക്കണം.",
+	"INVALID_HEIGHT_RANGE":               "ഉയരം 130 മുതൽ 220 സെ.മീ. വരെ ആയിരിക്കണം, കൂടിയ ഉയരം കുറഞ്ഞതിനേക്കാൾ കൂടുതലായിരിക്കണം.",
+	"INVALID_AGE_RANGE":                  "പ്രായം 18 മുതൽ 100 വയസ്സ് വരെ ആയിരിക്കണം, കൂടിയ പ്രായം കുറഞ്ഞതിനേക്കാൾ കൂടുതലായിരിക്കണം.",
+	"USER_PROFILE_NOT_COMPLETED":         "പ്രൊഫൈൽ പൂർത്തിയായിട്ടില്ല. ആദ്യം നിങ്ങളുടെ പ്രൊഫൈൽ പൂർത്തിയാക്കുക.",
+	"SAME_GENDER_PROFILE_ACCESS_DENIED":  "ഈ പ്രൊഫൈൽ കാണാൻ കഴിയില്ല.",
+	"INVALID_NAKSHATRA":                  "തിരഞ്ഞെടുത്ത നക്ഷത്രം തിരിച്ചറിയാനായില്ല.",
+	"INVALID_RASI":                       "തിരഞ്ഞെടുത്ത രാശി തിരിച്ചറിയാനായില്ല.",
+	"NAKSHATRA_RASI_MISMATCH":            "തിരഞ്ഞെടുത്ത നക്ഷത്രം ഈ രാശിയിൽ വരുന്നതല്ല. ദയവായി ജാതക വിവരങ്ങൾ പരിശോധിക്കുക.",
+	"INVALID_BIRTH_TIME":                 "ജനന സമയം 24 മണിക്കൂർ HH:MM രൂപത്തിൽ നൽകുക.",
+	"INVALID_BIRTH_PLACE":                "ജനന സ്ഥലം പരമാവധി 200 അക്ഷരങ്ങൾ ആയിരിക്കണം.",
+	"INVALID_COUNTRY":                    "രാജ്യം രണ്ടക്ഷര ISO രാജ്യ കോഡ് ആയിരിക്കണം.",
+	"INVALID_LOCATION":                   "സ്ഥലവിവരം സാധുവല്ല. സംസ്ഥാനത്തിന് രാജ്യവും ജില്ലയ്ക്ക് സംസ്ഥാനവും വേണം, കേരളത്തിലെ ജില്ല 14 ജില്ലകളിൽ ഒന്നായിരിക്കണം.",
+	"PARTNER_PREFERENCES_NOT_FOUND":      "പങ്കാളി മുൻഗണനകൾ കണ്ടെത്തിയില്ല. ആദ്യം നിങ്ങളുടെ പങ്കാളി മുൻഗണനകൾ സൃഷ്ടിക്കുക.",
+	"PARTNER_PREFERENCES_ALREADY_EXISTS": "പങ്കാളി മുൻഗണനകൾ ഇതിനകം നിലവിലുണ്ട്. പകരം അവ പുതുക്കുക.",
+	"INVALID_MIN_PORUTHAM_SCORE":         "കുറഞ്ഞ പൊരുത്തം 0 മുതൽ 10 വരെ ആയിരിക്കണം.",
+	"INVALID_MATCH_CRITERION":            "നിർബന്ധ മാനദണ്ഡങ്ങൾ age, height, physically_challenged, community, marital_status, profession, profession_type, education_level, home_district, native_country, residence_country, porutham, dosham എന്നിവയിൽ നിന്നായിരിക്കണം.",
+	"INVALID_SEARCH_SORT":                "ക്രമം recently_active, newest, age_asc, age_desc, height_asc, height_desc എന്നിവയിൽ ഒന്നായിരിക്കണം.",
+	"INVALID_ACTIVE_WITHIN_DAYS":         "അടുത്തിടെ സജീവം 0 മുതൽ 365 ദിവസം വരെ ആയിരിക്കണം.",
+	"INVALID_SAVED_SEARCH_NAME":          "തിരയലിന് പരമാവധി 100 അക്ഷരങ്ങളുള്ള ഒരു പേര് നൽകുക.",
+	"SAVED_SEARCH_LIMIT_REACHED":         "പരമാവധി 10 തിരയലുകൾ സേവ് ചെയ്യാം. പുതിയത് സേവ് ചെയ്യാൻ ഒന്ന് ഇല്ലാതാക്കുക.",
+	"SAVED_SEARCH_NOT_FOUND":             "സേവ് ചെയ്ത തിരയൽ കണ്ടെത്തിയില്ല.",
+	"INVALID_MATCH_ACTION":               "തിരഞ്ഞെടുത്ത പ്രവർത്തനം തിരിച്ചറിയാനായില്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"INVALID_TARGET_PROFILE_ID":          "തിരഞ്ഞെടുത്ത പ്രൊഫൈൽ നിലവിലില്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"NO_MATCH_ACTION_TO_UNDO":            "പഴയപടിയാക്കാൻ സമീപകാല ലൈക്കോ പാസോ ഇല്ല.",
+	"INTEREST_NOT_FOUND":                 "ഈ താൽപ്പര്യം ഇനി തീർപ്പാക്കാനില്ല.",
+	"PROFILE_BLOCKED":                    "ഈ പ്രൊഫൈലുമായി ഇടപെടാൻ കഴിയില്ല.",
+	"CANNOT_BLOCK_SELF":                  "സ്വന്തം പ്രൊഫൈൽ ബ്ലോക്ക് ചെയ്യാൻ കഴിയില്ല.",
+	"CANNOT_SHORTLIST_SELF":              "സ്വന്തം പ്രൊഫൈൽ ഷോർട്ട്‌ലിസ്റ്റ് ചെയ്യാൻ കഴിയില്ല.",
+	"SHORTLIST_NOTE_TOO_LONG":            "കുറിപ്പ് വളരെ നീളമുള്ളതാണ്. ദയവായി ചുരുക്കി വീണ്ടും ശ്രമിക്കുക.",
+	"SHORTLIST_ENTRY_NOT_FOUND":          "ഈ പ്രൊഫൈൽ നിങ്ങളുടെ ഷോർട്ട്‌ലിസ്റ്റിൽ ഇല്ല.",
+	"INVALID_REPORT_CATEGORY":            "തിരഞ്ഞെടുത്ത റിപ്പോർട്ട് വിഭാഗം തിരിച്ചറിയാനായില്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"INVALID_REPORT_TARGET":              "റിപ്പോർട്ട് ചെയ്ത ഇനം സാധുവല്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"REPORT_DESCRIPTION_TOO_LONG":        "റിപ്പോർട്ടിലെ വിവരണം വളരെ നീളമുള്ളതാണ്. ദയവായി ചുരുക്കി വീണ്ടും ശ്രമിക്കുക.",
+	"CANNOT_REPORT_SELF":                 "സ്വന്തം പ്രൊഫൈൽ റിപ്പോർട്ട് ചെയ്യാൻ കഴിയില്ല.",
+	"REPORT_NOT_FOUND":                   "റിപ്പോർട്ട് കണ്ടെത്തിയില്ല.",
+	"REPORT_ALREADY_RESOLVED":            "ഈ റിപ്പോർട്ടിൽ ഇതിനകം തീരുമാനമെടുത്തു.",
+	"REPORTED_PHOTO_CHANGED":             "റിപ്പോർട്ട് ചെയ്ത ഫോട്ടോ പിന്നീട് മാറ്റുകയോ നീക്കം ചെയ്യുകയോ ചെയ്തു.",
+	"INVALID_MODERATION_ACTION":          "തിരഞ്ഞെടുത്ത നടപടി ഈ റിപ്പോർട്ടിന് സാധുവല്ല.",
+	"INVALID_REPORT_STATUS":              "തിരഞ്ഞെടുത്ത റിപ്പോർട്ട് നില തിരിച്ചറിയാനായില്ല. ദയവായി വീണ്ടും ശ്രമിക്കുക.",
+	"INVALID_PROFILE_CHANGE_SECTION":     "വിഭാഗം profile അല്ലെങ്കിൽ partner_preferences ആയിരിക്കണം.",
+	"INVALID_TAXONOMY":                   "വിഭാഗം community, marital_status, profession, profession_type, education_level എന്നിവയിൽ ഒന്നായിരിക്കണം.",
+	"INVALID_REFERENCE_OPTION_VALUE":     "മൂല്യത്തിൽ ചെറിയ ഇംഗ്ലീഷ് അക്ഷരങ്ങൾ, അക്കങ്ങൾ, അണ്ടർസ്കോർ എന്നിവ മാത്രം, പരമാവധി 100 അക്ഷരം; 'any' അനുവദനീയമല്ല.",
+	"INVALID_REFERENCE_OPTION_LABEL":     "ലേബൽ നിർബന്ധമാണ്, പരമാവധി 200 അക്ഷരങ്ങൾ.",
+	"REFERENCE_OPTION_NOT_FOUND":         "ഓപ്ഷൻ കണ്ടെത്തിയില്ല.",
+	"REFERENCE_OPTION_ALREADY_EXISTS":    "ഈ മൂല്യമുള്ള ഒരു ഓപ്ഷൻ ഈ വിഭാഗത്തിൽ ഇതിനകം നിലവിലുണ്ട്.",
+	"INVALID_LOCALE":                     "ഭാഷ en അല്ലെങ്കിൽ ml ആയിരിക്കണം.",
+	"INVALID_DIGEST_FREQUENCY":           "ഡൈജസ്റ്റ് ഇടവേള daily, weekly, off എന്നിവയിൽ ഒന്നായിരിക്കണം.",
+
+	// Emails
+	"email.otp.subject": "OTP സ്ഥിരീകരണം",
+	"email.otp.body": `നമസ്കാരം %[1]s,
+
+Qubool Kallyanam-ൽ നിങ്ങളുടെ അക്കൗണ്ട് സ്ഥിരീകരിക്കാനുള്ള ഒറ്റത്തവണ പാസ്‌വേഡ് (OTP):
+
+	%[2]s
+
+സ്ഥിരീകരണം പൂർത്തിയാക്കാൻ ഈ OTP നൽകുക. ഇത് %[3]d മിനിറ്റിനുള്ളിൽ കാലഹരണപ്പെടും.
+
+നിങ്ങൾ ഇത് ആവശ്യപ്പെട്ടിട്ടില്ലെങ്കിൽ ഈ ഇമെയിൽ അവഗണിക്കാം.
+
+ആശംസകളോടെ,
+Qubool Kallyanam ടീം`,
+
+	"email.account_deletion.subject": "അക്കൗണ്ട് നീക്കം ചെയ്തതിന്റെ സ്ഥിരീകരണം",
+	"email.account_deletion.body": `നമസ്കാരം %[1]s,
+
+Qubool Kallyanam-ലെ നിങ്ങളുടെ അക്കൗണ്ട് വിജയകരമായി നീക്കം ചെയ്തതായി അറിയിക്കുന്നു.
+
+ഞങ്ങളുടെ കൂട്ടായ്മയുടെ ഭാഗമായിരുന്നതിന് നന്ദി. നിങ്ങൾ പോകുന്നതിൽ ഖേദമുണ്ട്, ഭാവിയിൽ വീണ്ടും കാണാമെന്ന് പ്രതീക്ഷിക്കുന്നു. എന്തെങ്കിലും അഭിപ്രായങ്ങളോ സംശയങ്ങളോ ഉണ്ടെങ്കിൽ support@quboolkallyanam.xyz എന്ന വിലാസത്തിൽ ബന്ധപ്പെടാം.
+
+മുന്നോട്ടുള്ള യാത്രയ്ക്ക് എല്ലാ ആശംസകളും!
+
+സ്നേഹാശംസകളോടെ,
+Qubool Kallyanam ടീം`,
+
+	"email.account_status.subject": "നിങ്ങളുടെ അക്കൗണ്ട് അഡ്മിൻ പരിശോധിച്ചു",
+	"email.account_blocked.body": `നമസ്കാരം %[1]s,
+
+നിങ്ങളുടെ അക്കൗണ്ട് ബ്ലോക്ക് ചെയ്തതായി അറിയിക്കുന്നു.
+
+നയലംഘനമോ അസാധാരണമായ പ്രവർത്തനമോ കാരണം Qubool Kallyanam-ലെ നിങ്ങളുടെ അക്കൗണ്ട് ഞങ്ങളുടെ അഡ്മിനിസ്ട്രേറ്റർമാർ താൽക്കാലികമായി ബ്ലോക്ക് ചെയ്തിരിക്കുന്നു.
+
+ഈ സമയത്ത് നിങ്ങൾക്ക് അക്കൗണ്ടിൽ പ്രവേശിക്കാനോ ഞങ്ങളുടെ സേവനങ്ങൾ ഉപയോഗിക്കാനോ കഴിയില്ല.
+
+ഇതൊരു തെറ്റാണെന്ന് കരുതുന്നുവെങ്കിലോ എന്തെങ്കിലും സംശയങ്ങളുണ്ടെങ്കിലോ support@quboolkallyanam.xyz എന്ന വിലാസത്തിൽ ഞങ്ങളുടെ സപ്പോർട്ട് ടീമുമായി ബന്ധപ്പെടുക.
+
+ഞങ്ങളുടെ കൂട്ടായ്മയുടെ ഭാഗമായതിന് നന്ദി.
+
+സ്നേഹാശംസകളോടെ,
+Qubool Kallyanam ടീം`,
+	"email.account_unblocked.body": `നമസ്കാരം %[1]s,
+
+നിങ്ങളുടെ അക്കൗണ്ടിലെ ബ്ലോക്ക് നീക്കിയതായി അറിയിക്കുന്നു.
+
+Qubool Kallyanam-ലെ നിങ്ങളുടെ അക്കൗണ്ടിലെ ബ്ലോക്ക് ഞങ്ങളുടെ അഡ്മിനിസ്ട്രേറ്റർമാർ നീക്കിയിരിക്കുന്നു.
+
+നിങ്ങൾക്ക് ഇപ്പോൾ ലോഗിൻ ചെയ്ത് പതിവുപോലെ സേവനങ്ങൾ ഉപയോഗിക്കാം.
+
+എന്തെങ്കിലും പ്രശ്നങ്ങളുണ്ടെങ്കിൽ support@quboolkallyanam.xyz എന്ന വിലാസത്തിൽ ഞങ്ങളുടെ സപ്പോർട്ട് ടീമുമായി ബന്ധപ്പെടാൻ മടിക്കരുത്.
+
+ഞങ്ങളുടെ കൂട്ടായ്മയുടെ ഭാഗമായതിന് നന്ദി.
+
+സ്നേഹാശംസകളോടെ,
+Qubool Kallyanam ടീം`,
+
+	"email.interest_sent.subject": "Qubool Kallyanam-ൽ പുതിയ താൽപ്പര്യം",
+	"email.interest_sent.body": `നമസ്കാരം %[1]s,
+
+Qubool Kallyanam-ൽ നിങ്ങൾക്ക് ഒരു പുതിയ താൽപ്പര്യം ലഭിച്ചിരിക്കുന്നു!
+
+%[2]s (പ്രൊഫൈൽ ഐഡി: %[3]d) നിങ്ങളുടെ പ്രൊഫൈലിൽ താൽപ്പര്യം പ്രകടിപ്പിച്ചു.
+
+അവരുടെ പ്രൊഫൈൽ കാണാനും മറുപടി നൽകാനും നിങ്ങളുടെ Qubool Kallyanam അക്കൗണ്ടിൽ ലോഗിൻ ചെയ്യുക.
+
+ഇത് പ്രതീക്ഷിച്ചതല്ലെങ്കിലോ സഹായം ആവശ്യമുണ്ടെങ്കിലോ support@quboolkallyanam.xyz എന്ന വിലാസത്തിൽ ബന്ധപ്പെടുക.
+
+സ്നേഹാശംസകളോടെ,
+Qubool Kallyanam ടീം`,
+
+	"email.mutual_match.subject": "Qubool Kallyanam-ൽ പുതിയ പരസ്പര പൊരുത്തം",
+	"email.mutual_match.body": `നമസ്കാരം,
+
+സന്തോഷവാർത്ത — Qubool Kallyanam-ൽ ഒരു പരസ്പര പൊരുത്തം!
+
+%[1]s (പ്രൊഫൈൽ ഐഡി: %[2]d), %[3]s (പ്രൊഫൈൽ ഐഡി: %[4]d) എന്നിവർ പരസ്പരം പ്രൊഫൈലുകളിൽ താൽപ്പര്യം പ്രകടിപ്പിച്ചു. അഭിനന്ദനങ്ങൾ!
+
+നിങ്ങളുടെ Qubool Kallyanam അക്കൗണ്ടിൽ ലോഗിൻ ചെയ്ത് പ്രൊഫൈലുകൾ കാണാനും സംഭാഷണം ആരംഭിക്കാനും കഴിയും.
+
+ചില നിർദ്ദേശങ്ങൾ:
+- സൗകര്യപ്രദമെന്ന് തോന്നുമ്പോൾ മാത്രം വ്യക്തിഗത ബന്ധപ്പെടൽ വിവരങ്ങൾ പങ്കിടുക.
+- ആദ്യം പരസ്പരം അറിയാൻ പ്ലാറ്റ്‌ഫോമിലെ ചാറ്റ് ഉപയോഗിക്കുക.
+- എന്തെങ്കിലും സഹായം വേണമെങ്കിൽ support@quboolkallyanam.xyz എന്ന വിലാസത്തിൽ ബന്ധപ്പെടുക.
+
+എല്ലാ ആശംസകളും,
+Qubool Kallyanam ടീം`,
+
+	"email.user_warned.subject": "Qubool Kallyanam മോഡറേഷൻ ടീമിൽ നിന്നുള്ള അറിയിപ്പ്",
+	"email.user_warned.body": `നമസ്കാരം %[1]s,
+
+Qubool Kallyanam-ലെ നിങ്ങളുടെ അക്കൗണ്ടിനെക്കുറിച്ചുള്ള ഒരു റിപ്പോർട്ട് ഞങ്ങളുടെ മോഡറേഷൻ ടീം പരിശോധിച്ചു (കാരണം: %[2]s).
+
+നിങ്ങളുടെ പ്രൊഫൈലും ഫോട്ടോകളും സന്ദേശങ്ങളും ഞങ്ങളുടെ കമ്മ്യൂണിറ്റി മാർഗ്ഗനിർദ്ദേശങ്ങൾ പാലിക്കുന്നുവെന്ന് ഉറപ്പാക്കുക. ആവർത്തിച്ചുള്ള ലംഘനങ്ങൾ അക്കൗണ്ട് സസ്പെൻഡ് ചെയ്യുന്നതിന് കാരണമായേക്കാം.
+
+ഇതൊരു തെറ്റാണെന്ന് കരുതുന്നുവെങ്കിലോ എന്തെങ്കിലും സംശയങ്ങളുണ്ടെങ്കിലോ support@quboolkallyanam.xyz എന്ന വിലാസത്തിൽ ഞങ്ങളുടെ സപ്പോർട്ട് ടീമുമായി ബന്ധപ്പെടുക.
+
+കൂട്ടായ്മ സുരക്ഷിതമായി നിലനിർത്താൻ സഹായിക്കുന്നതിന് നന്ദി.
+
+സ്നേഹാശംസകളോടെ,
+Qubool Kallyanam ടീം`,
+
+	"email.photo_rejected.subject":          "നിങ്ങളുടെ ഫോട്ടോ അംഗീകരിച്ചില്ല",
+	"email.photo_rejected.profile_photo":    "നിങ്ങളുടെ പ്രൊഫൈൽ ഫോട്ടോ",
+	"email.photo_rejected.additional_photo": "അധിക ഫോട്ടോ #%[1]d",
+	"email.photo_rejected.body": `നമസ്കാരം %[1]s,
+
+Qubool Kallyanam-ലെ %[2]s ഞങ്ങളുടെ മോഡറേഷൻ ടീം പരിശോധിച്ചു, അത് അംഗീകരിക്കാൻ കഴിഞ്ഞില്ല.
+
+കാരണം: %[3]s
+
+ഫോട്ടോ നിങ്ങളുടെ പ്രൊഫൈലിൽ നിന്ന് നീക്കം ചെയ്തു. കമ്മ്യൂണിറ്റി മാർഗ്ഗനിർദ്ദേശങ്ങൾ പാലിക്കുന്ന പുതിയ ഫോട്ടോ അപ്‌ലോഡ് ചെയ്യാം, അംഗീകരിച്ച ശേഷം അത് മറ്റ് അംഗങ്ങൾക്ക് കാണാനാകും.
+
+എന്തെങ്കിലും സംശയങ്ങളുണ്ടെങ്കിൽ support@quboolkallyanam.xyz എന്ന വിലാസത്തിൽ ഞങ്ങളുടെ സപ്പോർട്ട് ടീമുമായി ബന്ധപ്പെടുക.
+
+സ്നേഹാശംസകളോടെ,
+Qubool Kallyanam ടീം`,
+
+	"email.mutual_match_removed.subject": "നിങ്ങളുടെ ഒരു പൊരുത്തത്തെക്കുറിച്ചുള്ള അറിയിപ്പ്",
+	"email.mutual_match_removed.body": `നമസ്കാരം %[1]s,
+
+Qubool Kallyanam-ൽ %[2]s-മായുള്ള നിങ്ങളുടെ പരസ്പര പൊരുത്തം ഇപ്പോൾ സജീവമല്ല.
+
+നിങ്ങൾക്ക് വായിക്കാനായി സംഭാഷണം സൂക്ഷിച്ചിട്ടുണ്ട്, എന്നാൽ പുതിയ സന്ദേശങ്ങൾ അയയ്ക്കാൻ കഴിയില്ല. വീണ്ടും പൊരുത്തപ്പെട്ടാൽ സംഭാഷണം വീണ്ടും തുറക്കും.
+
+എല്ലാ ദിവസവും പുതിയ പ്രൊഫൈലുകൾ ചേരുന്നു, നിങ്ങളുടെ പുതിയ ശുപാർശകൾ കാണാൻ Qubool Kallyanam അക്കൗണ്ടിൽ ലോഗിൻ ചെയ്യുക.
+
+സ്നേഹാശംസകളോടെ,
+Qubool Kallyanam ടീം`,
+
+	"email.saved_search_matched.subject": "നിങ്ങൾ സേവ് ചെയ്ത തിരയലിന് പുതിയ പ്രൊഫൈലുകൾ",
+	"email.saved_search_matched.body": `നമസ്കാരം %[1]s,
+
+Qubool Kallyanam-ലെ %[2]d പുതിയ പ്രൊഫൈൽ(കൾ) നിങ്ങൾ സേവ് ചെയ്ത "%[3]s" എന്ന തിരയലുമായി പൊരുത്തപ്പെടുന്നു.
+
+അവ കാണാൻ നിങ്ങളുടെ Qubool Kallyanam അക്കൗണ്ടിൽ ലോഗിൻ ചെയ്ത് തിരയൽ വീണ്ടും നടത്തുക.
+
+സേവ് ചെയ്ത തിരയലുകളിൽ നിന്ന് എപ്പോൾ വേണമെങ്കിലും ഈ അറിയിപ്പുകൾ ഓഫ് ചെയ്യാനോ തിരയൽ ഇല്ലാതാക്കാനോ കഴിയും.
+
+സ്നേഹാശംസകളോടെ,
+Qubool Kallyanam ടീം`,
+
+	"email.match_digest.subject.daily":  "Qubool Kallyanam-ൽ ഇന്നത്തെ പുതിയ പൊരുത്തങ്ങൾ",
+	"email.match_digest.subject.weekly": "Qubool Kallyanam-ൽ ഈ ആഴ്ചയിലെ പുതിയ പൊരുത്തങ്ങൾ",
+	"email.match_digest.profile_card": `%[1]s (പ്രൊഫൈൽ ID %[2]d)
+%[3]d വയസ്സ്, %[4]d സെ.മീ, %[5]s, %[6]s
+നിങ്ങളുടെ മുൻഗണനകളുമായി %[7]d%% പൊരുത്തം`,
+	"email.match_digest.body": `നമസ്കാരം %[1]s,
+
+നിങ്ങളുടെ പങ്കാളി മുൻഗണനകൾക്ക് അനുയോജ്യരായ പുതിയ അംഗങ്ങൾ Qubool Kallyanam-ൽ ചേർന്നിട്ടുണ്ട്:
+
+%[2]s
+
+അവരുടെ പ്രൊഫൈലുകൾ കാണാനും താൽപ്പര്യം അയയ്ക്കാനും നിങ്ങളുടെ Qubool Kallyanam അക്കൗണ്ടിൽ ലോഗിൻ ചെയ്യുക.
+
+ഈ ഇമെയിൽ എത്ര തവണ ലഭിക്കണമെന്ന് മാറ്റാനോ ഇത് ഓഫ് ചെയ്യാനോ പ്രൊഫൈൽ ക്രമീകരണങ്ങളിൽ കഴിയും.
+
+സ്നേഹാശംസകളോടെ,
+Qubool Kallyanam ടീം`,
+
+	// Payment pages
+	"page.payment.title":          "സുരക്ഷിത പേയ്‌മെന്റ് - Qubool Kallyanam",
+	"page.payment.subtitle":       "സുരക്ഷിത പേയ്‌മെന്റ് ഗേറ്റ്‌വേ",
+	"page.payment.valid_for":      "%[1]d ദിവസത്തേക്ക് സാധുവാണ്",
+	"page.payment.processing":     "പേയ്‌മെന്റ് പ്രോസസ്സ് ചെയ്യുന്നു...",
+	"page.payment.pay_button":     "₹%[1]s സുരക്ഷിതമായി അടയ്ക്കുക",
+	"page.payment.security_badge": "🔒 256-ബിറ്റ് SSL എൻക്രിപ്ഷൻ • PCI DSS അനുസൃതം",
+	"page.payment.cancelled":      "പേയ്‌മെന്റ് റദ്ദാക്കി. നിങ്ങൾക്ക് വീണ്ടും ശ്രമിക്കാം.",
+	"page.payment.failed_prefix":  "പേയ്‌മെന്റ് പരാജയപ്പെട്ടു: ",
+
+	"page.payment_success.title":           "പേയ്‌മെന്റ് വിജയിച്ചു - Qubool Kallyanam",
+	"page.payment_success.heading":         "പേയ്‌മെന്റ് വിജയിച്ചു!",
+	"page.payment_success.subtitle":        "അഭിനന്ദനങ്ങൾ! നിങ്ങളുടെ സബ്സ്ക്രിപ്ഷൻ വിജയകരമായി സജീവമാക്കി.",
+	"page.payment_success.subscription_id": "സബ്സ്ക്രിപ്ഷൻ ഐഡി:",
+	"page.payment_success.start_date":      "ആരംഭ തീയതി:",
+	"page.payment_success.end_date":        "അവസാന തീയതി:",
+	"page.payment_success.status":          "നില:",
+	"page.payment_success.footer_note":     "നിങ്ങളുടെ സബ്സ്ക്രിപ്ഷൻ വിവരങ്ങൾ മുകളിൽ നൽകിയിരിക്കുന്നു. ദയവായി ഇവ സൂക്ഷിച്ചു വയ്ക്കുക.",
+
+	"page.payment_failed.title":     "പേയ്‌മെന്റ് പരാജയപ്പെട്ടു - Qubool Kallyanam",
+	"page.payment_failed.heading":   "പേയ്‌മെന്റ് പരാജയപ്പെട്ടു",
+	"page.payment_failed.subtitle":  "ക്ഷമിക്കണം, നിങ്ങളുടെ പേയ്‌മെന്റ് ഇപ്പോൾ പൂർത്തിയാക്കാൻ കഴിഞ്ഞില്ല.",
+	"page.payment_failed.error":     "പിശക്: %[1]s",
+	"page.payment_failed.generic":   "പേയ്‌മെന്റ് പ്രോസസ്സിംഗ് പരാജയപ്പെട്ടു",
+	"page.payment_failed.order_id":  "ഓർഡർ ഐഡി: %[1]s",
+	"page.payment_failed.try_again": "🔄 വീണ്ടും ശ്രമിക്കുക",
+	"page.payment_failed.go_home":   "ഹോമിലേക്ക് പോകുക",
+}
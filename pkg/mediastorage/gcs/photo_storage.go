@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"sync"
 	"time"
@@ -11,8 +12,10 @@ import (
 	"cloud.google.com/go/compute/metadata"
 	"cloud.google.com/go/iam/credentials/apiv1"
 	"cloud.google.com/go/storage"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/mediastorage"
 	credentialspb "google.golang.org/genproto/googleapis/iam/credentials/v1"
 	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
 )
 /*
 this code was used in development,  because we were using fake-gcs-server for testing.
@@ -34,6 +37,8 @@ type MediaStorageConfig struct {
 	SignerEmail string        // service account email to sign URLs (recommended)
 }
 
+var _ mediastorage.ObjectStore = (*GCSStore)(nil)
+
 type GCSStore struct {
 	client        *storage.Client
 	bucket        string
@@ -165,6 +170,90 @@ func (s *GCSStore) signedURL(ctx context.Context, key, method string, expiry tim
 	return url, nil
 }
 
+// Stat returns the object attributes, or nil if the object does not exist.
+func (s *GCSStore) Stat(ctx context.Context, key string) (*mediastorage.ObjectInfo, error) {
+	attrs, err := s.client.Bucket(s.bucket).Object(key).Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading attributes of %q: %w", key, err)
+	}
+	return &mediastorage.ObjectInfo{
+		Key:         attrs.Name,
+		ContentType: attrs.ContentType,
+		Size:        attrs.Size,
+		Created:     attrs.Created,
+	}, nil
+}
+
+// List calls fn with the objects whose key starts with prefix, at most
+// pageSize at a time, and stops at the first error fn returns.
+func (s *GCSStore) List(ctx context.Context, prefix string, pageSize int, fn func([]mediastorage.ObjectInfo) error) error {
+	it := s.client.Bucket(s.bucket).Objects(ctx, &storage.Query{Prefix: prefix})
+
+	objects := make([]mediastorage.ObjectInfo, 0, pageSize)
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("listing objects with prefix %q: %w", prefix, err)
+		}
+		objects = append(objects, mediastorage.ObjectInfo{
+			Key:         attrs.Name,
+			ContentType: attrs.ContentType,
+			Size:        attrs.Size,
+			Created:     attrs.Created,
+		})
+		if len(objects) == pageSize {
+			if err := fn(objects); err != nil {
+				return err
+			}
+			objects = objects[:0]
+		}
+	}
+	if len(objects) == 0 {
+		return nil
+	}
+	return fn(objects)
+}
+
+// Download reads the whole object, refusing objects larger than maxSize bytes.
+func (s *GCSStore) Download(ctx context.Context, key string, maxSize int64) ([]byte, error) {
+	reader, err := s.client.Bucket(s.bucket).Object(key).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("opening object %q: %w", key, err)
+	}
+	defer reader.Close()
+
+	if maxSize > 0 && reader.Attrs.Size > maxSize {
+		return nil, fmt.Errorf("object %q is %d bytes, limit is %d", key, reader.Attrs.Size, maxSize)
+	}
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading object %q: %w", key, err)
+	}
+	return data, nil
+}
+
+// Upload writes data to the object, replacing it if it already exists.
+func (s *GCSStore) Upload(ctx context.Context, key, contentType string, data []byte) error {
+	writer := s.client.Bucket(s.bucket).Object(key).NewWriter(ctx)
+	writer.ContentType = contentType
+
+	if _, err := writer.Write(data); err != nil {
+		writer.Close()
+		return fmt.Errorf("writing object %q: %w", key, err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("closing object %q: %w", key, err)
+	}
+	return nil
+}
+
 func (s *GCSStore) Delete(ctx context.Context, key string) error {
 	err := s.client.Bucket(s.bucket).Object(key).Delete(ctx)
 	if err == nil {
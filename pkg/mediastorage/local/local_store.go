@@ -0,0 +1,373 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/mediastorage"
+)
+
+var _ mediastorage.ObjectStore = (*LocalStore)(nil)
+
+// LocalStoreConfig configures a store that keeps objects on local disk.
+// It is meant for local development and CI, where GCS signing credentials
+// are not available.
+type LocalStoreConfig struct {
+	BaseDir       string        // directory objects are written to
+	PublicBaseURL string        // URL Handler is reachable at, e.g. "http://localhost:8090/media"
+	SigningSecret string        // HMAC key for signed URLs
+	URLExpiry     time.Duration // default expiry for signed URLs
+	MaxUploadSize int64         // largest accepted PUT body in bytes, 0 means unlimited
+}
+
+// LocalStore keeps objects as files under BaseDir and serves them through
+// HMAC signed URLs, mirroring how clients talk to GCS directly.
+type LocalStore struct {
+	baseDir       string
+	publicBaseURL string
+	secret        []byte
+	defaultExpiry time.Duration
+	maxUploadSize int64
+}
+
+func NewLocalStore(cfg LocalStoreConfig) (*LocalStore, error) {
+	if cfg.BaseDir == "" {
+		return nil, fmt.Errorf("base dir is required")
+	}
+	if cfg.PublicBaseURL == "" {
+		return nil, fmt.Errorf("public base URL is required")
+	}
+	if cfg.SigningSecret == "" {
+		return nil, fmt.Errorf("signing secret is required")
+	}
+	if cfg.URLExpiry == 0 {
+		cfg.URLExpiry = 15 * time.Minute
+	}
+
+	baseDir, err := filepath.Abs(cfg.BaseDir)
+	if err != nil {
+		return nil, fmt.Errorf("resolving base dir: %w", err)
+	}
+	if err := os.MkdirAll(baseDir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating base dir: %w", err)
+	}
+
+	return &LocalStore{
+		baseDir:       baseDir,
+		publicBaseURL: strings.TrimRight(cfg.PublicBaseURL, "/"),
+		secret:        []byte(cfg.SigningSecret),
+		defaultExpiry: cfg.URLExpiry,
+		maxUploadSize: cfg.MaxUploadSize,
+	}, nil
+}
+
+// GetUploadURL returns a signed PUT URL served by Handler.
+func (s *LocalStore) GetUploadURL(ctx context.Context, key, contentType string, expiry time.Duration) (string, error) {
+	return s.signedURL(key, http.MethodPut, contentType, expiry)
+}
+
+// GetDownloadURL returns a signed GET URL served by Handler.
+func (s *LocalStore) GetDownloadURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	return s.signedURL(key, http.MethodGet, "", expiry)
+}
+
+func (s *LocalStore) signedURL(key, method, contentType string, expiry time.Duration) (string, error) {
+	if _, err := s.objectPath(key); err != nil {
+		return "", err
+	}
+	if expiry < 0 {
+		return "", fmt.Errorf("expiry must be >= 0")
+	}
+	if expiry == 0 {
+		expiry = s.defaultExpiry
+	}
+
+	expires := strconv.FormatInt(time.Now().Add(expiry).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expires)
+	if contentType != "" {
+		query.Set("content_type", contentType)
+	}
+	query.Set("signature", s.sign(method, key, expires, contentType))
+
+	return fmt.Sprintf("%s/%s?%s", s.publicBaseURL, key, query.Encode()), nil
+}
+
+func (s *LocalStore) sign(method, key, expires, contentType string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(strings.Join([]string{method, key, expires, contentType}, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Stat returns the object attributes, or nil if the object does not exist.
+// The content type is sniffed from the stored bytes.
+func (s *LocalStore) Stat(ctx context.Context, key string) (*mediastorage.ObjectInfo, error) {
+	objectPath, err := s.objectPath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(objectPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("stat object %q: %w", key, err)
+	}
+	if info.IsDir() {
+		return nil, nil
+	}
+
+	contentType, err := sniffContentType(objectPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading object %q: %w", key, err)
+	}
+
+	return &mediastorage.ObjectInfo{
+		Key:         key,
+		ContentType: contentType,
+		Size:        info.Size(),
+		Created:     info.ModTime(),
+	}, nil
+}
+
+// List calls fn with the objects whose key starts with prefix, at most
+// pageSize at a time, and stops at the first error fn returns.
+func (s *LocalStore) List(ctx context.Context, prefix string, pageSize int, fn func([]mediastorage.ObjectInfo) error) error {
+	objects := make([]mediastorage.ObjectInfo, 0, pageSize)
+	err := filepath.WalkDir(s.baseDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.HasSuffix(p, tmpSuffix) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.baseDir, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			// fn may delete files of this directory that were not visited yet
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		objects = append(objects, mediastorage.ObjectInfo{
+			Key:     key,
+			Size:    info.Size(),
+			Created: info.ModTime(),
+		})
+		if len(objects) == pageSize {
+			if err := fn(objects); err != nil {
+				return errListCallback{err}
+			}
+			objects = objects[:0]
+		}
+		return nil
+	})
+	if err != nil {
+		var callbackErr errListCallback
+		if errors.As(err, &callbackErr) {
+			return callbackErr.err
+		}
+		return fmt.Errorf("listing objects with prefix %q: %w", prefix, err)
+	}
+	if len(objects) == 0 {
+		return nil
+	}
+	return fn(objects)
+}
+
+// errListCallback carries an error returned by the List callback through
+// WalkDir, so it is returned as is and not as a listing error.
+type errListCallback struct{ err error }
+
+func (e errListCallback) Error() string { return e.err.Error() }
+
+// Download reads the whole object, refusing objects larger than maxSize bytes.
+func (s *LocalStore) Download(ctx context.Context, key string, maxSize int64) ([]byte, error) {
+	objectPath, err := s.objectPath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(objectPath)
+	if err != nil {
+		return nil, fmt.Errorf("opening object %q: %w", key, err)
+	}
+	if maxSize > 0 && info.Size() > maxSize {
+		return nil, fmt.Errorf("object %q is %d bytes, limit is %d", key, info.Size(), maxSize)
+	}
+
+	data, err := os.ReadFile(objectPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading object %q: %w", key, err)
+	}
+	return data, nil
+}
+
+// Upload writes data to the object, replacing it if it already exists.
+func (s *LocalStore) Upload(ctx context.Context, key, contentType string, data []byte) error {
+	objectPath, err := s.objectPath(key)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(objectPath, bytes.NewReader(data))
+}
+
+// Delete removes the object, deleting a missing object is not an error.
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	objectPath, err := s.objectPath(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(objectPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("deleting object %q: %w", key, err)
+	}
+	return nil
+}
+
+func (s *LocalStore) Close() error {
+	return nil
+}
+
+// Handler serves signed GET and PUT requests. The request path, relative to
+// PublicBaseURL, is the object key, so mount it with http.StripPrefix.
+func (s *LocalStore) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/")
+		objectPath, err := s.objectPath(key)
+		if err != nil {
+			http.Error(w, "invalid object key", http.StatusBadRequest)
+			return
+		}
+
+		if r.Method != http.MethodGet && r.Method != http.MethodPut {
+			w.Header().Set("Allow", "GET, PUT")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		expires := query.Get("expires")
+		contentType := query.Get("content_type")
+		expiresUnix, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil || time.Now().Unix() > expiresUnix {
+			http.Error(w, "signed URL expired", http.StatusForbidden)
+			return
+		}
+		expected := s.sign(r.Method, key, expires, contentType)
+		if !hmac.Equal([]byte(expected), []byte(query.Get("signature"))) {
+			http.Error(w, "invalid signature", http.StatusForbidden)
+			return
+		}
+
+		if r.Method == http.MethodGet {
+			f, err := os.Open(objectPath)
+			if err != nil {
+				http.NotFound(w, r)
+				return
+			}
+			defer f.Close()
+			info, err := f.Stat()
+			if err != nil || info.IsDir() {
+				http.NotFound(w, r)
+				return
+			}
+			http.ServeContent(w, r, path.Base(key), info.ModTime(), f)
+			return
+		}
+
+		// Like GCS, the upload must use the content type the URL was signed for
+		if contentType != "" && r.Header.Get("Content-Type") != contentType {
+			http.Error(w, "content type does not match signed URL", http.StatusBadRequest)
+			return
+		}
+		body := io.Reader(r.Body)
+		if s.maxUploadSize > 0 {
+			body = http.MaxBytesReader(w, r.Body, s.maxUploadSize)
+		}
+		if err := writeFileAtomic(objectPath, body); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, "object too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, "failed to store object", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// objectPath maps a key to a file under baseDir, rejecting keys that would
+// escape it.
+func (s *LocalStore) objectPath(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || strings.HasPrefix(key, "../") || key == ".." {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	return filepath.Join(s.baseDir, filepath.FromSlash(key)), nil
+}
+
+const tmpSuffix = ".uploading"
+
+// writeFileAtomic writes to a temporary file first, so readers never see a
+// partially uploaded object.
+func writeFileAtomic(objectPath string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(objectPath), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(objectPath), filepath.Base(objectPath)+"-*"+tmpSuffix)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), objectPath)
+}
+
+func sniffContentType(objectPath string) (string, error) {
+	f, err := os.Open(objectPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+	return http.DetectContentType(buf[:n]), nil
+}
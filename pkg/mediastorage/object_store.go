@@ -0,0 +1,36 @@
+package mediastorage
+
+import (
+	"context"
+	"time"
+)
+
+// ObjectInfo is the subset of object attributes callers need to validate uploads.
+type ObjectInfo struct {
+	Key         string
+	ContentType string
+	Size        int64
+	Created     time.Time
+}
+
+// ObjectStore is implemented by every storage backend (gcs, local, s3).
+// Clients upload and download directly through signed URLs, the service
+// itself only reads objects back for validation and processing.
+type ObjectStore interface {
+	// GetUploadURL returns a signed PUT URL. expiry 0 uses the store default.
+	GetUploadURL(ctx context.Context, key, contentType string, expiry time.Duration) (string, error)
+	// GetDownloadURL returns a signed GET URL. expiry 0 uses the store default.
+	GetDownloadURL(ctx context.Context, key string, expiry time.Duration) (string, error)
+	// Stat returns the object attributes, or nil if the object does not exist.
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// List calls fn with the objects whose key starts with prefix, at most
+	// pageSize at a time, and stops at the first error fn returns.
+	List(ctx context.Context, prefix string, pageSize int, fn func([]ObjectInfo) error) error
+	// Download reads the whole object, refusing objects larger than maxSize bytes.
+	Download(ctx context.Context, key string, maxSize int64) ([]byte, error)
+	// Upload writes data to the object, replacing it if it already exists.
+	Upload(ctx context.Context, key, contentType string, data []byte) error
+	// Delete removes the object, deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+	Close() error
+}
@@ -0,0 +1,215 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/mediastorage"
+)
+
+var _ mediastorage.ObjectStore = (*S3Store)(nil)
+
+// S3StoreConfig configures a store for any S3-compatible service
+// (AWS S3, MinIO, Cloudflare R2, ...).
+type S3StoreConfig struct {
+	Endpoint        string        // host[:port] without scheme, e.g. "localhost:9000" or "s3.amazonaws.com"
+	Region          string        // optional for MinIO
+	Bucket          string        // bucket name (required)
+	AccessKeyID     string
+	SecretAccessKey string
+	UseSSL          bool
+	URLExpiry       time.Duration // default expiry for signed URLs
+}
+
+type S3Store struct {
+	client        *minio.Client
+	bucket        string
+	defaultExpiry time.Duration
+}
+
+func NewS3Store(ctx context.Context, cfg S3StoreConfig) (*S3Store, error) {
+	if cfg.Endpoint == "" {
+		return nil, fmt.Errorf("endpoint is required")
+	}
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("bucket name is required")
+	}
+	if cfg.URLExpiry == 0 {
+		cfg.URLExpiry = 15 * time.Minute
+	}
+
+	cli, err := minio.New(cfg.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, ""),
+		Secure: cfg.UseSSL,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating s3 client: %w", err)
+	}
+
+	exists, err := cli.BucketExists(ctx, cfg.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("checking bucket %q: %w", cfg.Bucket, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("bucket %q does not exist", cfg.Bucket)
+	}
+
+	return &S3Store{
+		client:        cli,
+		bucket:        cfg.Bucket,
+		defaultExpiry: cfg.URLExpiry,
+	}, nil
+}
+
+// S3 presigned URLs are valid for at most 7 days.
+const maxExpiry = 7 * 24 * time.Hour
+
+func (s *S3Store) expiry(expiry time.Duration) (time.Duration, error) {
+	if expiry < 0 {
+		return 0, fmt.Errorf("expiry must be >= 0")
+	}
+	if expiry == 0 {
+		expiry = s.defaultExpiry
+	}
+	if expiry > maxExpiry {
+		expiry = maxExpiry
+	}
+	return expiry, nil
+}
+
+// GetUploadURL returns a presigned PUT URL for direct upload. The content type
+// is not part of the signature, uploads are validated when they are confirmed.
+func (s *S3Store) GetUploadURL(ctx context.Context, key, contentType string, expiry time.Duration) (string, error) {
+	expiry, err := s.expiry(expiry)
+	if err != nil {
+		return "", err
+	}
+	u, err := s.client.PresignedPutObject(ctx, s.bucket, key, expiry)
+	if err != nil {
+		return "", fmt.Errorf("presigning put for %q: %w", key, err)
+	}
+	return u.String(), nil
+}
+
+// GetDownloadURL returns a presigned GET URL for downloading the object.
+func (s *S3Store) GetDownloadURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	expiry, err := s.expiry(expiry)
+	if err != nil {
+		return "", err
+	}
+	u, err := s.client.PresignedGetObject(ctx, s.bucket, key, expiry, url.Values{})
+	if err != nil {
+		return "", fmt.Errorf("presigning get for %q: %w", key, err)
+	}
+	return u.String(), nil
+}
+
+// Stat returns the object attributes, or nil if the object does not exist.
+func (s *S3Store) Stat(ctx context.Context, key string) (*mediastorage.ObjectInfo, error) {
+	info, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading attributes of %q: %w", key, err)
+	}
+	return &mediastorage.ObjectInfo{
+		Key:         info.Key,
+		ContentType: info.ContentType,
+		Size:        info.Size,
+		Created:     info.LastModified,
+	}, nil
+}
+
+// List calls fn with the objects whose key starts with prefix, at most
+// pageSize at a time, and stops at the first error fn returns.
+func (s *S3Store) List(ctx context.Context, prefix string, pageSize int, fn func([]mediastorage.ObjectInfo) error) error {
+	// Cancelling stops the listing goroutine when fn fails part way
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	objects := make([]mediastorage.ObjectInfo, 0, pageSize)
+	for object := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	}) {
+		if object.Err != nil {
+			return fmt.Errorf("listing objects with prefix %q: %w", prefix, object.Err)
+		}
+		objects = append(objects, mediastorage.ObjectInfo{
+			Key:         object.Key,
+			ContentType: object.ContentType,
+			Size:        object.Size,
+			Created:     object.LastModified,
+		})
+		if len(objects) == pageSize {
+			if err := fn(objects); err != nil {
+				return err
+			}
+			objects = objects[:0]
+		}
+	}
+	if len(objects) == 0 {
+		return nil
+	}
+	return fn(objects)
+}
+
+// Download reads the whole object, refusing objects larger than maxSize bytes.
+func (s *S3Store) Download(ctx context.Context, key string, maxSize int64) ([]byte, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("opening object %q: %w", key, err)
+	}
+	defer object.Close()
+
+	info, err := object.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("opening object %q: %w", key, err)
+	}
+	if maxSize > 0 && info.Size > maxSize {
+		return nil, fmt.Errorf("object %q is %d bytes, limit is %d", key, info.Size, maxSize)
+	}
+
+	data, err := io.ReadAll(object)
+	if err != nil {
+		return nil, fmt.Errorf("reading object %q: %w", key, err)
+	}
+	return data, nil
+}
+
+// Upload writes data to the object, replacing it if it already exists.
+func (s *S3Store) Upload(ctx context.Context, key, contentType string, data []byte) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(data), int64(len(data)),
+		minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return fmt.Errorf("writing object %q: %w", key, err)
+	}
+	return nil
+}
+
+// Delete removes the object, deleting a missing object is not an error.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	err := s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+	if err != nil && !isNotFound(err) {
+		return fmt.Errorf("deleting object %q: %w", key, err)
+	}
+	return nil
+}
+
+func (s *S3Store) Close() error {
+	return nil
+}
+
+func isNotFound(err error) bool {
+	resp := minio.ToErrorResponse(err)
+	return resp.StatusCode == http.StatusNotFound || resp.Code == "NoSuchKey"
+}
@@ -25,6 +25,12 @@ func ExtractAuthContext(c *gin.Context) (*AuthContextResult, error) {
 
 	ctx := context.WithValue(c.Request.Context(), constants.ContextKeyUserID, userID)
 	ctx = context.WithValue(ctx, constants.ContextKeyRequestID, requestID)
+	if role, exists := c.Get(constants.ContextKeyRole); exists {
+		ctx = context.WithValue(ctx, constants.ContextKeyRole, role)
+	}
+	if locale, exists := c.Get(constants.ContextKeyLocale); exists {
+		ctx = context.WithValue(ctx, constants.ContextKeyLocale, locale)
+	}
 
 	return &AuthContextResult{
 		Ctx: ctx,
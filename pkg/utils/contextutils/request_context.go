@@ -19,6 +19,9 @@ func ExtractRequestContext(c *gin.Context) (*RequestContextResult, error) {
 	}
 
 	ctx := context.WithValue(c.Request.Context(), constants.ContextKeyRequestID, requestID)
+	if locale, exists := c.Get(constants.ContextKeyLocale); exists {
+		ctx = context.WithValue(ctx, constants.ContextKeyLocale, locale)
+	}
 
 	return &RequestContextResult{
 		Ctx: ctx,
@@ -16,6 +16,14 @@ func SetRequestIDContext(ctx context.Context, requestID string) context.Context
 	return appendToOutgoingContext(ctx, constants.ContextKeyRequestID, requestID)
 }
 
+func SetRoleContext(ctx context.Context, role string) context.Context {
+	return appendToOutgoingContext(ctx, constants.ContextKeyRole, role)
+}
+
+func SetLocaleContext(ctx context.Context, locale string) context.Context {
+	return appendToOutgoingContext(ctx, constants.ContextKeyLocale, locale)
+}
+
 func GetUserID(ctx context.Context) (string, error) {
 	return extractFromIncomingContext(ctx, constants.ContextKeyUserID)
 }
@@ -24,6 +32,16 @@ func GetRequestID(ctx context.Context) (string, error) {
 	return extractFromIncomingContext(ctx, constants.ContextKeyRequestID)
 }
 
+func GetRole(ctx context.Context) (string, error) {
+	return extractFromIncomingContext(ctx, constants.ContextKeyRole)
+}
+
+// GetLocale returns the locale the gateway resolved for the request. It is
+// optional, callers fall back to the profile or the default locale.
+func GetLocale(ctx context.Context) (string, error) {
+	return extractFromIncomingContext(ctx, constants.ContextKeyLocale)
+}
+
 func appendToOutgoingContext(ctx context.Context, key, value string) context.Context {
 	md, ok := metadata.FromOutgoingContext(ctx)
 	if !ok {
@@ -53,6 +71,8 @@ func extractFromIncomingContext(ctx context.Context, key string) (string, error)
 type GrpcContextData struct {
 	RequestID string
 	UserID    string
+	Role      string // empty when the caller did not forward a role
+	Locale    string // empty when the client did not ask for a language
 }
 
 
@@ -67,9 +87,15 @@ func ExtractGrpcContextData(ctx context.Context) (*GrpcContextData, error) {
 		return nil, fmt.Errorf("failed to get user ID from context: %w", err)
 	}
 
+	// Role is optional, only requests coming through the gateway carry it
+	role, _ := GetRole(ctx)
+	locale, _ := GetLocale(ctx)
+
 	return &GrpcContextData{
 		RequestID: requestID,
 		UserID:    userID,
+		Role:      role,
+		Locale:    locale,
 	}, nil
 }
 
@@ -86,6 +112,12 @@ func PrepareGrpcContext(ctx context.Context) (context.Context, error) {
 
 	ctx = SetUserContext(ctx, userID)
 	ctx = SetRequestIDContext(ctx, requestID)
+	if role, ok := ctx.Value(constants.ContextKeyRole).(string); ok && role != "" {
+		ctx = SetRoleContext(ctx, role)
+	}
+	if locale, ok := ctx.Value(constants.ContextKeyLocale).(string); ok && locale != "" {
+		ctx = SetLocaleContext(ctx, locale)
+	}
 
 	return ctx, nil
 }
@@ -113,6 +145,9 @@ func PrepareRequestIDForGrpcContext(ctx context.Context) (context.Context, error
 	}
 
 	ctx = SetRequestIDContext(ctx, requestID)
+	if locale, ok := ctx.Value(constants.ContextKeyLocale).(string); ok && locale != "" {
+		ctx = SetLocaleContext(ctx, locale)
+	}
 
 	return ctx, nil
 }
\ No newline at end of file
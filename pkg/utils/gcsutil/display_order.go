@@ -9,8 +9,9 @@ import (
 )
 
 func ExtractDisplayOrder(objectKey string) (int32, error) {
+	// additional-photos/<user>/<order>/<upload>, older uploads have no upload part
 	parts := strings.Split(objectKey, "/")
-	if len(parts) != 3 || parts[0] != constants.AdditionalPhotoStorageDirectory {
+	if (len(parts) != 3 && len(parts) != 4) || parts[0] != constants.AdditionalPhotoStorageDirectory {
 		return 0, fmt.Errorf("object key is not in additional-photo format: %q", objectKey)
 	}
 
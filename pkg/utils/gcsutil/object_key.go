@@ -0,0 +1,50 @@
+package gcsutil
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+)
+
+// SizedObjectKey returns the key a processed photo size is stored under.
+// The full size replaces the original upload, so it keeps the original key.
+func SizedObjectKey(objectKey, size string) string {
+	if size == constants.ImageSizeFull {
+		return objectKey
+	}
+	return fmt.Sprintf("%s_%s", objectKey, size)
+}
+
+// IsSizedObjectKey reports whether the key belongs to a derived photo size
+// rather than an upload.
+func IsSizedObjectKey(objectKey string) bool {
+	return strings.HasSuffix(objectKey, "_"+constants.ImageSizeThumbnail) ||
+		strings.HasSuffix(objectKey, "_"+constants.ImageSizeMedium)
+}
+
+// Every upload gets its own key, so photos can move between slots and the
+// profile photo without a later upload overwriting them.
+
+func ProfilePhotoKeyPrefix(userID string) string {
+	return fmt.Sprintf("%s/%s/", constants.ProfilePhotoStorageDirectory, userID)
+}
+
+func AdditionalPhotoKeyPrefix(userID string, displayOrder int32) string {
+	return fmt.Sprintf("%s/%s/%d/", constants.AdditionalPhotoStorageDirectory, userID, displayOrder)
+}
+
+func ProfilePhotoObjectKey(userID, uploadID string) string {
+	return ProfilePhotoKeyPrefix(userID) + uploadID
+}
+
+func AdditionalPhotoObjectKey(userID string, displayOrder int32, uploadID string) string {
+	return AdditionalPhotoKeyPrefix(userID, displayOrder) + uploadID
+}
+
+// HasUploadKeyPrefix reports whether objectKey is an upload directly under
+// prefix, as produced by the key functions above.
+func HasUploadKeyPrefix(objectKey, prefix string) bool {
+	uploadID, found := strings.CutPrefix(objectKey, prefix)
+	return found && uploadID != "" && !strings.Contains(uploadID, "/") && !IsSizedObjectKey(objectKey)
+}
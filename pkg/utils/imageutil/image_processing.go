@@ -0,0 +1,103 @@
+package imageutil
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+)
+
+// ErrTooManyPixels is returned by Decode for images larger than maxPixels.
+var ErrTooManyPixels = errors.New("image has too many pixels")
+
+// Decode decodes a JPEG or PNG photo and applies its EXIF orientation, so the
+// returned image is upright once the metadata is dropped on re-encoding.
+// The dimensions are read from the header first and images with more than
+// maxPixels pixels are rejected without decoding them.
+func Decode(data []byte, maxPixels int) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decoding image header: %w", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width > maxPixels/config.Height {
+		return nil, ErrTooManyPixels
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decoding image: %w", err)
+	}
+	if format == "jpeg" {
+		img = applyOrientation(img, readJPEGOrientation(data))
+	}
+	return img, nil
+}
+
+// EncodeJPEG encodes the image as JPEG. Only pixel data is written, EXIF and
+// other metadata from the original upload are not carried over.
+func EncodeJPEG(img image.Image, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, fmt.Errorf("encoding jpeg: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Resize scales the image down, keeping the aspect ratio, so its longest side
+// is at most maxDimension pixels. Smaller images are never scaled up.
+// Each destination pixel is the average of the source pixels it covers.
+// The result can be passed to Resize again to produce a smaller size.
+func Resize(img image.Image, maxDimension int) image.Image {
+	bounds := img.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	src, ok := img.(*image.RGBA)
+	if !ok || bounds.Min != (image.Point{}) {
+		src = image.NewRGBA(image.Rect(0, 0, srcW, srcH))
+		draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+	}
+
+	if srcW <= maxDimension && srcH <= maxDimension {
+		return src
+	}
+
+	dstW, dstH := maxDimension, maxDimension
+	if srcW >= srcH {
+		dstH = max(1, srcH*maxDimension/srcW)
+	} else {
+		dstW = max(1, srcW*maxDimension/srcH)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0 := y * srcH / dstH
+		y1 := max(y0+1, (y+1)*srcH/dstH)
+		for x := 0; x < dstW; x++ {
+			x0 := x * srcW / dstW
+			x1 := max(x0+1, (x+1)*srcW/dstW)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				offset := src.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += uint64(src.Pix[offset])
+					g += uint64(src.Pix[offset+1])
+					b += uint64(src.Pix[offset+2])
+					a += uint64(src.Pix[offset+3])
+					offset += 4
+					n++
+				}
+			}
+
+			offset := dst.PixOffset(x, y)
+			dst.Pix[offset] = uint8(r / n)
+			dst.Pix[offset+1] = uint8(g / n)
+			dst.Pix[offset+2] = uint8(b / n)
+			dst.Pix[offset+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
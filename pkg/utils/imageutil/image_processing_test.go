@@ -0,0 +1,176 @@
+package imageutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	red  = color.RGBA{R: 255, A: 255}
+	blue = color.RGBA{B: 255, A: 255}
+)
+
+// testJPEG encodes a 64x32 photo, red on the left half and blue on the right,
+// with an EXIF orientation tag unless orientation is 0.
+func testJPEG(t *testing.T, orientation uint16) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, 64, 32))
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 64; x++ {
+			if x < 32 {
+				img.Set(x, y, red)
+			} else {
+				img.Set(x, y, blue)
+			}
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}); err != nil {
+		t.Fatalf("encoding test jpeg: %v", err)
+	}
+	data := buf.Bytes()
+	if orientation == 0 {
+		return data
+	}
+
+	// Big endian TIFF header with a single IFD entry holding the orientation
+	tiff := []byte("MM\x00\x2A\x00\x00\x00\x08\x00\x01")
+	entry := make([]byte, 12)
+	binary.BigEndian.PutUint16(entry[0:2], exifOrientationTag)
+	binary.BigEndian.PutUint16(entry[2:4], 3) // SHORT
+	binary.BigEndian.PutUint32(entry[4:8], 1)
+	binary.BigEndian.PutUint16(entry[8:10], orientation)
+	tiff = append(tiff, entry...)
+	tiff = append(tiff, 0, 0, 0, 0) // no next IFD
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:4], uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	withExif := append([]byte{}, data[:2]...)
+	withExif = append(withExif, app1...)
+	return append(withExif, data[2:]...)
+}
+
+func colorName(c color.Color) string {
+	r, g, b, _ := c.RGBA()
+	switch {
+	case r>>8 > 200 && g>>8 < 60 && b>>8 < 60:
+		return "red"
+	case b>>8 > 200 && r>>8 < 60 && g>>8 < 60:
+		return "blue"
+	}
+	return "other"
+}
+
+func TestDecodeOrientation(t *testing.T) {
+	tests := []struct {
+		name        string
+		orientation uint16
+		wantWidth   int
+		wantHeight  int
+		// Colors sampled from the first and second half of the longest side
+		wantFirst  string
+		wantSecond string
+	}{
+		{name: "no exif", orientation: 0, wantWidth: 64, wantHeight: 32, wantFirst: "red", wantSecond: "blue"},
+		{name: "upright", orientation: 1, wantWidth: 64, wantHeight: 32, wantFirst: "red", wantSecond: "blue"},
+		{name: "mirrored horizontally", orientation: 2, wantWidth: 64, wantHeight: 32, wantFirst: "blue", wantSecond: "red"},
+		{name: "rotated 180", orientation: 3, wantWidth: 64, wantHeight: 32, wantFirst: "blue", wantSecond: "red"},
+		{name: "mirrored vertically", orientation: 4, wantWidth: 64, wantHeight: 32, wantFirst: "red", wantSecond: "blue"},
+		{name: "rotated 90 clockwise", orientation: 6, wantWidth: 32, wantHeight: 64, wantFirst: "red", wantSecond: "blue"},
+		{name: "rotated 90 counter-clockwise", orientation: 8, wantWidth: 32, wantHeight: 64, wantFirst: "blue", wantSecond: "red"},
+		{name: "invalid orientation is ignored", orientation: 9, wantWidth: 64, wantHeight: 32, wantFirst: "red", wantSecond: "blue"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img, err := Decode(testJPEG(t, tt.orientation), 1_000_000)
+			assert.NoError(t, err)
+
+			bounds := img.Bounds()
+			assert.Equal(t, tt.wantWidth, bounds.Dx())
+			assert.Equal(t, tt.wantHeight, bounds.Dy())
+
+			first := img.At(bounds.Min.X+16, bounds.Min.Y+16)
+			second := img.At(bounds.Min.X+16, bounds.Min.Y+48)
+			if bounds.Dx() > bounds.Dy() {
+				second = img.At(bounds.Min.X+48, bounds.Min.Y+16)
+			}
+			assert.Equal(t, tt.wantFirst, colorName(first))
+			assert.Equal(t, tt.wantSecond, colorName(second))
+		})
+	}
+}
+
+func TestDecodeRejects(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      []byte
+		maxPixels int
+		wantErr   error
+	}{
+		{name: "more pixels than allowed", data: nil, maxPixels: 64*32 - 1, wantErr: ErrTooManyPixels},
+		{name: "not an image", data: []byte("not an image"), maxPixels: 1_000_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.data
+			if data == nil {
+				data = testJPEG(t, 0)
+			}
+
+			_, err := Decode(data, tt.maxPixels)
+
+			assert.Error(t, err)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResize(t *testing.T) {
+	tests := []struct {
+		name         string
+		img          image.Image
+		maxDimension int
+		wantWidth    int
+		wantHeight   int
+	}{
+		{name: "landscape", img: image.NewRGBA(image.Rect(0, 0, 400, 200)), maxDimension: 100, wantWidth: 100, wantHeight: 50},
+		{name: "portrait", img: image.NewRGBA(image.Rect(0, 0, 200, 400)), maxDimension: 100, wantWidth: 50, wantHeight: 100},
+		{name: "smaller image is not scaled up", img: image.NewRGBA(image.Rect(0, 0, 50, 80)), maxDimension: 100, wantWidth: 50, wantHeight: 80},
+		{name: "thin image keeps one pixel", img: image.NewRGBA(image.Rect(0, 0, 1000, 2)), maxDimension: 100, wantWidth: 100, wantHeight: 1},
+		{name: "non zero origin", img: image.NewRGBA(image.Rect(0, 0, 400, 400)).SubImage(image.Rect(100, 100, 300, 200)), maxDimension: 100, wantWidth: 100, wantHeight: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Resize(tt.img, tt.maxDimension)
+
+			assert.Equal(t, image.Rect(0, 0, tt.wantWidth, tt.wantHeight), got.Bounds())
+		})
+	}
+}
+
+func TestResizeAveragesPixels(t *testing.T) {
+	img, err := Decode(testJPEG(t, 6), 1_000_000)
+	assert.NoError(t, err)
+
+	// Chained the way photo sizes are produced, the halves stay apart
+	got := Resize(Resize(img, 32), 16)
+
+	assert.Equal(t, image.Rect(0, 0, 8, 16), got.Bounds())
+	assert.Equal(t, "red", colorName(got.At(4, 2)))
+	assert.Equal(t, "blue", colorName(got.At(4, 13)))
+}
@@ -6,4 +6,7 @@ type PaginationData struct {
 	Limit      int   `json:"limit"`
 	Offset     int   `json:"offset"`
 	HasMore    bool  `json:"has_more"`
+
+	// Set by cursor paginated feeds while there are more items
+	NextCursor string `json:"next_cursor,omitempty"`
 }
\ No newline at end of file
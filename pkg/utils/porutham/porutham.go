@@ -0,0 +1,229 @@
+package porutham
+
+import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+
+// The ten poruthams checked in Kerala horoscope matching. Each one that agrees
+// adds a point, so the score ranges from 0 to MaxScore.
+const (
+	Dinam         = "dinam"
+	Ganam         = "ganam"
+	Mahendram     = "mahendram"
+	StreeDeergham = "stree_deergham"
+	Yoni          = "yoni"
+	Rasi          = "rasi"
+	Rasyadhipathi = "rasyadhipathi"
+	Vasyam        = "vasyam"
+	Rajju         = "rajju"
+	Vedha         = "vedha"
+	MaxScore      = 10
+)
+
+type Result struct {
+	Score     int
+	Matched   []string
+	Unmatched []string
+}
+
+// Calculate scores the match between a bride and a groom. Counts are taken
+// from the bride's star to the groom's star, as is the custom, so the
+// arguments are not interchangeable. An unknown star or sign scores zero.
+func Calculate(
+	brideStar validation.Nakshatra,
+	brideRasi validation.Rasi,
+	groomStar validation.Nakshatra,
+	groomRasi validation.Rasi) Result {
+
+	b, g := validation.NakshatraIndex(brideStar), validation.NakshatraIndex(groomStar)
+	br, gr := validation.RasiIndex(brideRasi), validation.RasiIndex(groomRasi)
+	if b < 0 || g < 0 || br < 0 || gr < 0 {
+		return Result{}
+	}
+
+	// 1 when both stars are the same, 27 for the star just before the bride's
+	starCount := (g-b+27)%27 + 1
+	rasiCount := (gr-br+12)%12 + 1
+
+	checks := []struct {
+		name    string
+		matched bool
+	}{
+		{Dinam, isDinamMatch(starCount)},
+		{Ganam, isGanamMatch(ganas[b], ganas[g])},
+		{Mahendram, starCount%3 == 1 && starCount > 1},
+		{StreeDeergham, starCount > 13},
+		{Yoni, !isEnemyYoni(yonis[b], yonis[g])},
+		{Rasi, isRasiMatch(rasiCount)},
+		{Rasyadhipathi, !isEnemyLord(rasiLords[br], rasiLords[gr])},
+		{Vasyam, isVasya(br, gr) || isVasya(gr, br)},
+		{Rajju, rajjuOf(b) != rajjuOf(g)},
+		{Vedha, !isVedha(b, g)},
+	}
+
+	var result Result
+	for _, check := range checks {
+		if check.matched {
+			result.Score++
+			result.Matched = append(result.Matched, check.name)
+		} else {
+			result.Unmatched = append(result.Unmatched, check.name)
+		}
+	}
+	return result
+}
+
+func isDinamMatch(starCount int) bool {
+	switch starCount % 9 {
+	case 0, 2, 4, 6, 8:
+		return true
+	}
+	return false
+}
+
+const (
+	deva = iota
+	manushya
+	rakshasa
+)
+
+// Gana of each star in zodiac order
+var ganas = [27]int{
+	deva, manushya, rakshasa, manushya, deva, manushya, deva, deva, rakshasa,
+	rakshasa, manushya, manushya, deva, rakshasa, deva, rakshasa, deva, rakshasa,
+	rakshasa, manushya, manushya, deva, rakshasa, rakshasa, manushya, manushya, deva,
+}
+
+func isGanamMatch(brideGana, groomGana int) bool {
+	if brideGana == groomGana {
+		return true
+	}
+	// Rakshasa gana only agrees with itself
+	return brideGana != rakshasa && groomGana != rakshasa
+}
+
+const (
+	horse = iota
+	elephant
+	sheep
+	serpent
+	dog
+	cat
+	rat
+	cow
+	buffalo
+	tiger
+	deer
+	monkey
+	mongoose
+	lion
+)
+
+// Yoni (animal) of each star in zodiac order
+var yonis = [27]int{
+	horse, elephant, sheep, serpent, serpent, dog, cat, sheep, cat,
+	rat, rat, cow, buffalo, tiger, buffalo, tiger, deer, deer,
+	dog, monkey, mongoose, monkey, lion, horse, lion, cow, elephant,
+}
+
+var enemyYonis = map[int]int{
+	horse: buffalo, buffalo: horse,
+	elephant: lion, lion: elephant,
+	sheep: monkey, monkey: sheep,
+	serpent: mongoose, mongoose: serpent,
+	dog: deer, deer: dog,
+	cat: rat, rat: cat,
+	cow: tiger, tiger: cow,
+}
+
+func isEnemyYoni(a, b int) bool {
+	enemy, ok := enemyYonis[a]
+	return ok && enemy == b
+}
+
+// The groom's sign counted from the bride's should not be 2nd to 6th, 8th
+// or 12th.
+func isRasiMatch(rasiCount int) bool {
+	switch rasiCount {
+	case 1, 7, 9, 10, 11:
+		return true
+	}
+	return false
+}
+
+const (
+	sun = iota
+	moon
+	mars
+	mercury
+	jupiter
+	venus
+	saturn
+)
+
+// Lord of each sign in zodiac order
+var rasiLords = [12]int{
+	mars, venus, mercury, moon, sun, mercury,
+	venus, mars, jupiter, saturn, saturn, jupiter,
+}
+
+var enemyLords = map[[2]int]bool{
+	{sun, saturn}: true, {saturn, sun}: true,
+	{sun, venus}: true, {venus, sun}: true,
+	{mars, mercury}: true, {mercury, mars}: true,
+	{jupiter, venus}: true, {venus, jupiter}: true,
+	{jupiter, mercury}: true, {mercury, jupiter}: true,
+}
+
+func isEnemyLord(a, b int) bool {
+	return enemyLords[[2]int{a, b}]
+}
+
+// Signs each sign is vasya (attracted) to, by zodiac index
+var vasyas = [12][]int{
+	{4, 7},  // medam: chingam, vrischikam
+	{3, 6},  // edavam: karkidakam, thulam
+	{5},     // mithunam: kanni
+	{7, 8},  // karkidakam: vrischikam, dhanu
+	{6},     // chingam: thulam
+	{2, 11}, // kanni: mithunam, meenam
+	{5, 9},  // thulam: kanni, makaram
+	{3},     // vrischikam: karkidakam
+	{11},    // dhanu: meenam
+	{0, 10}, // makaram: medam, kumbham
+	{0},     // kumbham: medam
+	{9},     // meenam: makaram
+}
+
+func isVasya(from, to int) bool {
+	for _, r := range vasyas[from] {
+		if r == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Rajju runs foot, waist, navel, neck, head and back down for every nine
+// stars, the bride and groom should not share one.
+func rajjuOf(star int) int {
+	pos := star % 9
+	if pos > 4 {
+		return 8 - pos
+	}
+	return pos
+}
+
+// Pairs of stars that obstruct (vedha) each other, by zodiac index. Makayiram,
+// chithira and avittam all obstruct one another.
+var vedhaPairs = [][2]int{
+	{0, 17}, {1, 16}, {2, 15}, {3, 14}, {5, 21}, {6, 20}, {7, 19}, {8, 18},
+	{9, 26}, {10, 25}, {11, 24}, {12, 23}, {4, 22}, {4, 13}, {13, 22},
+}
+
+func isVedha(a, b int) bool {
+	for _, pair := range vedhaPairs {
+		if (pair[0] == a && pair[1] == b) || (pair[0] == b && pair[1] == a) {
+			return true
+		}
+	}
+	return false
+}
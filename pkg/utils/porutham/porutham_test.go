@@ -0,0 +1,81 @@
+package porutham
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+)
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		name          string
+		brideStar     validation.Nakshatra
+		brideRasi     validation.Rasi
+		groomStar     validation.Nakshatra
+		groomRasi     validation.Rasi
+		wantScore     int
+		wantMatched   []string
+		wantUnmatched []string
+	}{
+		{
+			name:          "same star and sign",
+			brideStar:     validation.Ashwathi,
+			brideRasi:     validation.Medam,
+			groomStar:     validation.Ashwathi,
+			groomRasi:     validation.Medam,
+			wantScore:     5,
+			wantMatched:   []string{Ganam, Yoni, Rasi, Rasyadhipathi, Vedha},
+			wantUnmatched: []string{Dinam, Mahendram, StreeDeergham, Vasyam, Rajju},
+		},
+		{
+			name:          "groom's star fourth from the bride's",
+			brideStar:     validation.Ashwathi,
+			brideRasi:     validation.Medam,
+			groomStar:     validation.Rohini,
+			groomRasi:     validation.Edavam,
+			wantScore:     7,
+			wantMatched:   []string{Dinam, Ganam, Mahendram, Yoni, Rasyadhipathi, Rajju, Vedha},
+			wantUnmatched: []string{StreeDeergham, Rasi, Vasyam},
+		},
+		{
+			name:          "counted from the bride, so swapping them differs",
+			brideStar:     validation.Rohini,
+			brideRasi:     validation.Edavam,
+			groomStar:     validation.Ashwathi,
+			groomRasi:     validation.Medam,
+			wantScore:     7,
+			wantMatched:   []string{Ganam, Mahendram, StreeDeergham, Yoni, Rasyadhipathi, Rajju, Vedha},
+			wantUnmatched: []string{Dinam, Rasi, Vasyam},
+		},
+		{
+			name:          "rakshasa gana, shared rajju and vedha",
+			brideStar:     validation.Makayiram,
+			brideRasi:     validation.Edavam,
+			groomStar:     validation.Chithira,
+			groomRasi:     validation.Kanni,
+			wantScore:     3,
+			wantMatched:   []string{Mahendram, Yoni, Rasyadhipathi},
+			wantUnmatched: []string{Dinam, Ganam, StreeDeergham, Rasi, Vasyam, Rajju, Vedha},
+		},
+		{
+			name:      "unknown star scores zero",
+			brideStar: validation.Nakshatra("unknown"),
+			brideRasi: validation.Medam,
+			groomStar: validation.Ashwathi,
+			groomRasi: validation.Medam,
+			wantScore: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Calculate(tt.brideStar, tt.brideRasi, tt.groomStar, tt.groomRasi)
+
+			assert.Equal(t, tt.wantScore, got.Score)
+			assert.Equal(t, tt.wantMatched, got.Matched)
+			assert.Equal(t, tt.wantUnmatched, got.Unmatched)
+		})
+	}
+}
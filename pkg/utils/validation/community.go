@@ -33,7 +33,7 @@ var validSet = func() map[Community]struct{} {
 
 func (c Community) IsValid() bool {
 	_, ok := validSet[c]
-	return ok
+	return isValidTaxonomyValue(TaxonomyCommunity, string(c), string(CommunityAny), ok)
 }
 
 func IsValidCommunity(community string) bool {
@@ -31,7 +31,7 @@ var validEducationSet = func() map[EducationLevel]struct{} {
 
 func (e EducationLevel) IsValid() bool {
 	_, ok := validEducationSet[e]
-	return ok
+	return isValidTaxonomyValue(TaxonomyEducationLevel, string(e), string(EducationLevelAny), ok)
 }
 
 func IsValidEducationLevel(educationLevel string) bool {
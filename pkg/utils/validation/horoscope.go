@@ -0,0 +1,169 @@
+package validation
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type Nakshatra string
+
+// Birth stars in zodiac order, the order is used by porutham calculation.
+const (
+	Ashwathi      Nakshatra = "ashwathi"
+	Bharani       Nakshatra = "bharani"
+	Karthika      Nakshatra = "karthika"
+	Rohini        Nakshatra = "rohini"
+	Makayiram     Nakshatra = "makayiram"
+	Thiruvathira  Nakshatra = "thiruvathira"
+	Punartham     Nakshatra = "punartham"
+	Pooyam        Nakshatra = "pooyam"
+	Ayilyam       Nakshatra = "ayilyam"
+	Makam         Nakshatra = "makam"
+	Pooram        Nakshatra = "pooram"
+	Uthram        Nakshatra = "uthram"
+	Atham         Nakshatra = "atham"
+	Chithira      Nakshatra = "chithira"
+	Chothi        Nakshatra = "chothi"
+	Vishakham     Nakshatra = "vishakham"
+	Anizham       Nakshatra = "anizham"
+	Thrikketta    Nakshatra = "thrikketta"
+	Moolam        Nakshatra = "moolam"
+	Pooradam      Nakshatra = "pooradam"
+	Uthradam      Nakshatra = "uthradam"
+	Thiruvonam    Nakshatra = "thiruvonam"
+	Avittam       Nakshatra = "avittam"
+	Chathayam     Nakshatra = "chathayam"
+	Pooruruttathi Nakshatra = "pooruruttathi"
+	Uthrattathi   Nakshatra = "uthrattathi"
+	Revathi       Nakshatra = "revathi"
+)
+
+var nakshatras = []Nakshatra{
+	Ashwathi, Bharani, Karthika, Rohini, Makayiram, Thiruvathira,
+	Punartham, Pooyam, Ayilyam, Makam, Pooram, Uthram,
+	Atham, Chithira, Chothi, Vishakham, Anizham, Thrikketta,
+	Moolam, Pooradam, Uthradam, Thiruvonam, Avittam, Chathayam,
+	Pooruruttathi, Uthrattathi, Revathi,
+}
+
+var nakshatraIndexes = func() map[Nakshatra]int {
+	m := make(map[Nakshatra]int, len(nakshatras))
+	for i, n := range nakshatras {
+		m[n] = i
+	}
+	return m
+}()
+
+func (n Nakshatra) IsValid() bool {
+	_, ok := nakshatraIndexes[n]
+	return ok
+}
+
+func IsValidNakshatra(nakshatra string) bool {
+	return Nakshatra(nakshatra).IsValid()
+}
+
+// NakshatraIndex returns the position of the star in zodiac order (0 for
+// ashwathi) or -1 for an unknown star.
+func NakshatraIndex(n Nakshatra) int {
+	if i, ok := nakshatraIndexes[n]; ok {
+		return i
+	}
+	return -1
+}
+
+// Nakshatras returns all birth stars in zodiac order.
+func Nakshatras() []Nakshatra {
+	out := make([]Nakshatra, len(nakshatras))
+	copy(out, nakshatras)
+	return out
+}
+
+type Rasi string
+
+// Moon signs in zodiac order, Malayalam month names as used in Kerala horoscopes.
+const (
+	Medam      Rasi = "medam"
+	Edavam     Rasi = "edavam"
+	Mithunam   Rasi = "mithunam"
+	Karkidakam Rasi = "karkidakam"
+	Chingam    Rasi = "chingam"
+	Kanni      Rasi = "kanni"
+	Thulam     Rasi = "thulam"
+	Vrischikam Rasi = "vrischikam"
+	Dhanu      Rasi = "dhanu"
+	Makaram    Rasi = "makaram"
+	Kumbham    Rasi = "kumbham"
+	Meenam     Rasi = "meenam"
+)
+
+var rasis = []Rasi{
+	Medam, Edavam, Mithunam, Karkidakam, Chingam, Kanni,
+	Thulam, Vrischikam, Dhanu, Makaram, Kumbham, Meenam,
+}
+
+var rasiIndexes = func() map[Rasi]int {
+	m := make(map[Rasi]int, len(rasis))
+	for i, r := range rasis {
+		m[r] = i
+	}
+	return m
+}()
+
+func (r Rasi) IsValid() bool {
+	_, ok := rasiIndexes[r]
+	return ok
+}
+
+func IsValidRasi(rasi string) bool {
+	return Rasi(rasi).IsValid()
+}
+
+// RasiIndex returns the position of the sign in zodiac order (0 for medam)
+// or -1 for an unknown sign.
+func RasiIndex(r Rasi) int {
+	if i, ok := rasiIndexes[r]; ok {
+		return i
+	}
+	return -1
+}
+
+// RasisOfNakshatra returns the signs a birth star falls in. Each star has four
+// quarters (padams) and each sign spans nine, so some stars fall in two signs.
+func RasisOfNakshatra(n Nakshatra) []Rasi {
+	i := NakshatraIndex(n)
+	if i < 0 {
+		return nil
+	}
+	first, last := (i*4)/9, (i*4+3)/9
+	if first == last {
+		return []Rasi{rasis[first]}
+	}
+	return []Rasi{rasis[first], rasis[last]}
+}
+
+// IsNakshatraInRasi reports whether the birth star falls in the sign.
+func IsNakshatraInRasi(n Nakshatra, r Rasi) bool {
+	for _, rasi := range RasisOfNakshatra(n) {
+		if rasi == r {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	BirthTimeLayout   = "15:04"
+	maxBirthPlaceSize = 200
+)
+
+// IsValidBirthTime accepts a 24 hour HH:MM time.
+func IsValidBirthTime(birthTime string) bool {
+	_, err := time.Parse(BirthTimeLayout, birthTime)
+	return err == nil
+}
+
+func IsValidBirthPlace(birthPlace string) bool {
+	return utf8.RuneCountInString(strings.TrimSpace(birthPlace)) <= maxBirthPlaceSize
+}
@@ -1,5 +1,7 @@
 package validation
 
+import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+
 // If you are adding more types, please update it in ErrInvalidImageType public message as well
 var allowedImageType = map[string]bool{
 	"image/jpeg": true,
@@ -11,7 +13,7 @@ func IsValidImageType(contentType string) bool {
 	return allowedImageType[contentType]
 }
 
-const MaxImageFileSize = 5 * 1024 * 1024 // 5MB
+const MaxImageFileSize = constants.ImageFileMaxSize
 
 func IsValidImageFileSize(fileSize int64) bool {
 	return fileSize <= MaxImageFileSize && fileSize > 0
@@ -0,0 +1,102 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Countries are ISO 3166-1 alpha-2 codes stored in upper case. States,
+// districts and cities are free text, apart from Kerala where the district
+// has to be one of the HomeDistrict values.
+const (
+	CountryIndia = "IN"
+	CountryAny   = "any"
+	StateKerala  = "kerala"
+
+	maxLocationNameLength = 100
+)
+
+var countryCodes = func() map[string]struct{} {
+	codes := strings.Fields(`
+		AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ
+		BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM
+		DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS
+		GT GU GW GY HK HM HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN
+		KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ
+		MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM
+		PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV
+		SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI
+		VN VU WF WS YE YT ZA ZM ZW`)
+	m := make(map[string]struct{}, len(codes))
+	for _, c := range codes {
+		m[c] = struct{}{}
+	}
+	return m
+}()
+
+var locationNameRegex = regexp.MustCompile(`^[a-z]+(?:[ .'-][a-z]+)*$`)
+
+// NormalizeCountryCode upper cases a country code so "in" and "IN" match.
+func NormalizeCountryCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func IsValidCountryCode(code string) bool {
+	_, ok := countryCodes[NormalizeCountryCode(code)]
+	return ok
+}
+
+// NormalizeLocationName lower cases a state, district or city name and
+// collapses spaces, so the same place is always stored the same way.
+func NormalizeLocationName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+func IsValidLocationName(name string) bool {
+	name = NormalizeLocationName(name)
+	return len(name) <= maxLocationNameLength && locationNameRegex.MatchString(name)
+}
+
+// IsValidLocation checks a normalized location. Each level needs the one
+// above it, and a Kerala district has to be one of the known districts.
+func IsValidLocation(country, state, district, city string) bool {
+	if country == "" {
+		return state == "" && district == "" && city == ""
+	}
+	if !IsValidCountryCode(country) {
+		return false
+	}
+	if state == "" && district != "" {
+		return false
+	}
+	for _, name := range []string{state, district, city} {
+		if name != "" && !IsValidLocationName(name) {
+			return false
+		}
+	}
+	if country == CountryIndia && state == StateKerala && district != "" {
+		d := HomeDistrict(district)
+		return d != HomeDistrictAny && d.IsValid()
+	}
+	return true
+}
+
+// ParsePreferredCountries normalizes preferred country codes, keeping the
+// "any" semantics of the other preference lists.
+func ParsePreferredCountries(input []string) ([]string, error) {
+	for _, s := range input {
+		if strings.EqualFold(strings.TrimSpace(s), CountryAny) {
+			return []string{CountryAny}, nil
+		}
+	}
+
+	out := make([]string, 0, len(input))
+	for _, s := range input {
+		if !IsValidCountryCode(s) {
+			return nil, fmt.Errorf("invalid country code: %q", s)
+		}
+		out = append(out, NormalizeCountryCode(s))
+	}
+	return out, nil
+}
@@ -29,7 +29,7 @@ var validMaritalSet = func() map[MaritalStatus]struct{} {
 
 func (m MaritalStatus) IsValid() bool {
 	_, ok := validMaritalSet[m]
-	return ok
+	return isValidTaxonomyValue(TaxonomyMaritalStatus, string(m), string(MaritalStatusAny), ok)
 }
 
 func IsValidMaritalStatus(maritalStatus string) bool {
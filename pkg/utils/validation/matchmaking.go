@@ -1,5 +1,7 @@
 package validation
 
+import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+
 const (
 	// MatchMaking options liked, passed, mutual matches
 	MatchMakingOptionLiked string = "liked"
@@ -9,4 +11,49 @@ const (
 
 func IsValidMatchMakingOption(option string) bool {
 	return option == MatchMakingOptionLiked || option == MatchMakingOptionPassed || option == MatchMakingOptionMutual
-}
\ No newline at end of file
+}
+var validMatchCriterionSet = map[string]struct{}{
+	constants.MatchCriterionAge:                  {},
+	constants.MatchCriterionHeight:               {},
+	constants.MatchCriterionPhysicallyChallenged: {},
+	constants.MatchCriterionCommunity:            {},
+	constants.MatchCriterionMaritalStatus:        {},
+	constants.MatchCriterionProfession:           {},
+	constants.MatchCriterionProfessionType:       {},
+	constants.MatchCriterionEducationLevel:       {},
+	constants.MatchCriterionHomeDistrict:         {},
+	constants.MatchCriterionNativeCountry:        {},
+	constants.MatchCriterionResidenceCountry:     {},
+	constants.MatchCriterionPorutham:             {},
+	constants.MatchCriterionDosham:               {},
+}
+
+func IsValidMatchCriterion(criterion string) bool {
+	_, ok := validMatchCriterionSet[criterion]
+	return ok
+}
+
+func IsValidDigestFrequency(frequency string) bool {
+	switch frequency {
+	case constants.DigestFrequencyDaily,
+		constants.DigestFrequencyWeekly,
+		constants.DigestFrequencyOff:
+		return true
+	}
+	return false
+}
+
+// IsValidSearchSort accepts an empty sort, meaning recently active first.
+func IsValidSearchSort(sort string) bool {
+	switch sort {
+	case "",
+		constants.SearchSortRecentlyActive,
+		constants.SearchSortNewest,
+		constants.SearchSortAgeAsc,
+		constants.SearchSortAgeDesc,
+		constants.SearchSortHeightAsc,
+		constants.SearchSortHeightDesc:
+		return true
+	}
+	return false
+}
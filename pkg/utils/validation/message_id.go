@@ -0,0 +1,8 @@
+package validation
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+func IsValidMessageID(messageID string) bool {
+	_, err := primitive.ObjectIDFromHex(messageID)
+	return err == nil
+}
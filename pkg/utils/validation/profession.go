@@ -31,7 +31,7 @@ var validProfessions = func() map[Profession]struct{} {
 
 func (p Profession) IsValid() bool {
 	_, ok := validProfessions[p]
-	return ok
+	return isValidTaxonomyValue(TaxonomyProfession, string(p), string(ProfessionAny), ok)
 }
 
 func IsValidProfession(profession string) bool {
@@ -31,7 +31,7 @@ var validProfessionTypes = func() map[ProfessionType]struct{} {
 
 func (p ProfessionType) IsValid() bool {
 	_, ok := validProfessionTypes[p]
-	return ok
+	return isValidTaxonomyValue(TaxonomyProfessionType, string(p), string(ProfessionTypeAny), ok)
 }
 
 func IsValidProfessionType(professionType string) bool {
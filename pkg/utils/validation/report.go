@@ -0,0 +1,59 @@
+package validation
+
+import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+
+type ReportCategory string
+
+const (
+	ReportCategoryFakeProfile        ReportCategory = "fake_profile"
+	ReportCategoryInappropriatePhoto ReportCategory = "inappropriate_photo"
+	ReportCategoryAbusiveMessage     ReportCategory = "abusive_message"
+	ReportCategoryHarassment         ReportCategory = "harassment"
+	ReportCategorySpam               ReportCategory = "spam"
+	ReportCategoryOther              ReportCategory = "other"
+)
+
+var validReportCategorySet = func() map[ReportCategory]struct{} {
+	vals := []ReportCategory{
+		ReportCategoryFakeProfile,
+		ReportCategoryInappropriatePhoto,
+		ReportCategoryAbusiveMessage,
+		ReportCategoryHarassment,
+		ReportCategorySpam,
+		ReportCategoryOther,
+	}
+	m := make(map[ReportCategory]struct{}, len(vals))
+	for _, c := range vals {
+		m[c] = struct{}{}
+	}
+	return m
+}()
+
+func (c ReportCategory) IsValid() bool {
+	_, ok := validReportCategorySet[c]
+	return ok
+}
+
+func IsValidReportCategory(category string) bool {
+	return ReportCategory(category).IsValid()
+}
+
+func IsValidReportStatus(status string) bool {
+	return status == constants.ReportStatusPending ||
+		status == constants.ReportStatusDismissed ||
+		status == constants.ReportStatusActioned
+}
+
+func IsValidModerationAction(action string) bool {
+	return action == constants.ModerationActionDismiss ||
+		action == constants.ModerationActionWarn ||
+		action == constants.ModerationActionRemovePhoto ||
+		action == constants.ModerationActionSuspend
+}
+
+// IsValidProfileChangeSection accepts an empty section, meaning all sections.
+func IsValidProfileChangeSection(section string) bool {
+	return section == "" ||
+		section == constants.ProfileChangeSectionProfile ||
+		section == constants.ProfileChangeSectionPartnerPreferences
+}
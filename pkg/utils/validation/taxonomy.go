@@ -0,0 +1,176 @@
+package validation
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Taxonomy names a list of profile options that admins manage at runtime.
+type Taxonomy string
+
+const (
+	TaxonomyCommunity      Taxonomy = "community"
+	TaxonomyMaritalStatus  Taxonomy = "marital_status"
+	TaxonomyProfession     Taxonomy = "profession"
+	TaxonomyProfessionType Taxonomy = "profession_type"
+	TaxonomyEducationLevel Taxonomy = "education_level"
+)
+
+var taxonomies = []Taxonomy{
+	TaxonomyCommunity,
+	TaxonomyMaritalStatus,
+	TaxonomyProfession,
+	TaxonomyProfessionType,
+	TaxonomyEducationLevel,
+}
+
+func (t Taxonomy) IsValid() bool {
+	for _, taxonomy := range taxonomies {
+		if t == taxonomy {
+			return true
+		}
+	}
+	return false
+}
+
+func IsValidTaxonomy(taxonomy string) bool {
+	return Taxonomy(taxonomy).IsValid()
+}
+
+// Taxonomies returns every managed taxonomy.
+func Taxonomies() []Taxonomy {
+	out := make([]Taxonomy, len(taxonomies))
+	copy(out, taxonomies)
+	return out
+}
+
+const (
+	maxTaxonomyValueLength = 100
+	maxTaxonomyLabelLength = 200
+	taxonomyValueAny       = "any"
+)
+
+var taxonomyValueRegex = regexp.MustCompile(`^[a-z0-9]+(?:_[a-z0-9]+)*$`)
+
+// IsValidTaxonomyValue checks the stored form of a new option, e.g.
+// "software_engineer". "any" is reserved for preferences.
+func IsValidTaxonomyValue(value string) bool {
+	return value != taxonomyValueAny &&
+		len(value) <= maxTaxonomyValueLength &&
+		taxonomyValueRegex.MatchString(value)
+}
+
+// IsValidTaxonomyLabel checks the display label of an option.
+func IsValidTaxonomyLabel(label string) bool {
+	label = strings.TrimSpace(label)
+	return label != "" && utf8.RuneCountInString(label) <= maxTaxonomyLabelLength
+}
+
+// The options loaded from the database. A taxonomy that has never been
+// loaded falls back to the values built into this package, so validation
+// keeps working before the first load and in tools that never load.
+var taxonomyCache = struct {
+	sync.RWMutex
+	values   map[Taxonomy]map[string]struct{}
+	inactive map[Taxonomy]map[string]struct{}
+}{
+	values:   make(map[Taxonomy]map[string]struct{}),
+	inactive: make(map[Taxonomy]map[string]struct{}),
+}
+
+// TaxonomyOption is a stored option as needed by LoadTaxonomyOptions.
+type TaxonomyOption struct {
+	Taxonomy Taxonomy
+	Value    string
+	IsActive bool
+}
+
+// LoadTaxonomyOptions replaces the cached options of every taxonomy with the
+// active ones in options. Inactive options are listed too, so a taxonomy whose
+// options are all inactive is still treated as loaded. A taxonomy without any
+// options goes back to its built-in values, so an empty table never rejects
+// every profile update.
+func LoadTaxonomyOptions(options []TaxonomyOption) {
+	values := make(map[Taxonomy]map[string]struct{})
+	inactive := make(map[Taxonomy]map[string]struct{})
+	for _, option := range options {
+		if _, ok := values[option.Taxonomy]; !ok {
+			values[option.Taxonomy] = make(map[string]struct{})
+			inactive[option.Taxonomy] = make(map[string]struct{})
+		}
+		if option.IsActive {
+			values[option.Taxonomy][option.Value] = struct{}{}
+		} else {
+			inactive[option.Taxonomy][option.Value] = struct{}{}
+		}
+	}
+
+	taxonomyCache.Lock()
+	defer taxonomyCache.Unlock()
+	for _, taxonomy := range taxonomies {
+		if set, ok := values[taxonomy]; ok {
+			taxonomyCache.values[taxonomy] = set
+			taxonomyCache.inactive[taxonomy] = inactive[taxonomy]
+		} else {
+			delete(taxonomyCache.values, taxonomy)
+			delete(taxonomyCache.inactive, taxonomy)
+		}
+	}
+}
+
+// IsKnownTaxonomyValue accepts the active values of a taxonomy and also the
+// deactivated ones. Profiles keep a value after it is deactivated, whether
+// the value can still be saved depends on the profile, see IsValid for new
+// values.
+func IsKnownTaxonomyValue(taxonomy Taxonomy, value string) bool {
+	var valid bool
+	switch taxonomy {
+	case TaxonomyCommunity:
+		valid = IsValidCommunity(value)
+	case TaxonomyMaritalStatus:
+		valid = IsValidMaritalStatus(value)
+	case TaxonomyProfession:
+		valid = IsValidProfession(value)
+	case TaxonomyProfessionType:
+		valid = IsValidProfessionType(value)
+	case TaxonomyEducationLevel:
+		valid = IsValidEducationLevel(value)
+	}
+	if valid {
+		return true
+	}
+
+	taxonomyCache.RLock()
+	defer taxonomyCache.RUnlock()
+	_, found := taxonomyCache.inactive[taxonomy][value]
+	return found
+}
+
+// lookupTaxonomyValue reports whether value is in the cached taxonomy, and
+// whether the taxonomy has been loaded at all.
+func lookupTaxonomyValue(taxonomy Taxonomy, value string) (found, loaded bool) {
+	taxonomyCache.RLock()
+	defer taxonomyCache.RUnlock()
+
+	set, loaded := taxonomyCache.values[taxonomy]
+	if !loaded {
+		return false, false
+	}
+	_, found = set[value]
+	return found, true
+}
+
+// isValidTaxonomyValue checks value against the cached taxonomy, or against
+// the built-in values when the taxonomy has not been loaded. The IsValid
+// methods of the managed taxonomies all go through it.
+func isValidTaxonomyValue(taxonomy Taxonomy, value, any string, builtIn bool) bool {
+	if value == any {
+		return true
+	}
+	if found, loaded := lookupTaxonomyValue(taxonomy, value); loaded {
+		return found
+	}
+	return builtIn
+}
@@ -0,0 +1,100 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadTaxonomyOptions(t *testing.T) {
+	type check struct {
+		taxonomy  Taxonomy
+		value     string
+		wantValid bool
+		wantKnown bool
+	}
+
+	tests := []struct {
+		name   string
+		loads  [][]TaxonomyOption
+		checks []check
+	}{
+		{
+			name: "built-in values before the first load",
+			checks: []check{
+				{TaxonomyCommunity, "sunni", true, true},
+				{TaxonomyCommunity, "salafi", false, false},
+				{TaxonomyCommunity, "any", true, true},
+			},
+		},
+		{
+			name: "loaded options replace the built-in values",
+			loads: [][]TaxonomyOption{{
+				{Taxonomy: TaxonomyCommunity, Value: "salafi", IsActive: true},
+				{Taxonomy: TaxonomyCommunity, Value: "sunni", IsActive: false},
+			}},
+			checks: []check{
+				{TaxonomyCommunity, "salafi", true, true},
+				{TaxonomyCommunity, "sunni", false, true},
+				{TaxonomyCommunity, "mujahid", false, false},
+				{TaxonomyCommunity, "any", true, true},
+				// Not in the options, still on its built-in values
+				{TaxonomyMaritalStatus, "never_married", true, true},
+			},
+		},
+		{
+			name: "taxonomy with only inactive options is loaded",
+			loads: [][]TaxonomyOption{{
+				{Taxonomy: TaxonomyProfession, Value: "doctor", IsActive: false},
+			}},
+			checks: []check{
+				{TaxonomyProfession, "doctor", false, true},
+				{TaxonomyProfession, "engineer", false, false},
+			},
+		},
+		{
+			name: "taxonomy missing from a reload goes back to built-in values",
+			loads: [][]TaxonomyOption{
+				{
+					{Taxonomy: TaxonomyCommunity, Value: "salafi", IsActive: true},
+					{Taxonomy: TaxonomyCommunity, Value: "shia", IsActive: false},
+				},
+				{
+					{Taxonomy: TaxonomyMaritalStatus, Value: "separated", IsActive: true},
+				},
+			},
+			checks: []check{
+				{TaxonomyCommunity, "salafi", false, false},
+				{TaxonomyCommunity, "sunni", true, true},
+				{TaxonomyCommunity, "shia", true, true},
+				{TaxonomyMaritalStatus, "separated", true, true},
+				{TaxonomyMaritalStatus, "never_married", false, false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			LoadTaxonomyOptions(nil)
+			t.Cleanup(func() { LoadTaxonomyOptions(nil) })
+
+			for _, options := range tt.loads {
+				LoadTaxonomyOptions(options)
+			}
+
+			for _, c := range tt.checks {
+				var valid bool
+				switch c.taxonomy {
+				case TaxonomyCommunity:
+					valid = IsValidCommunity(c.value)
+				case TaxonomyMaritalStatus:
+					valid = IsValidMaritalStatus(c.value)
+				case TaxonomyProfession:
+					valid = IsValidProfession(c.value)
+				}
+				assert.Equal(t, c.wantValid, valid, "valid %s %q", c.taxonomy, c.value)
+				assert.Equal(t, c.wantKnown, IsKnownTaxonomyValue(c.taxonomy, c.value), "known %s %q", c.taxonomy, c.value)
+			}
+		})
+	}
+}
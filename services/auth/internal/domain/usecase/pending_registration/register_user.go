@@ -8,6 +8,7 @@ import (
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
 	authevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/auth"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/security/hash"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/auth/internal/config"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/auth/internal/domain/entity"
 )
@@ -83,6 +84,10 @@ func (u *pendingRegistrationUsecase) RegisterUser(ctx context.Context,
 		OTP:           otp,
 		ExpiryMinutes: config.Auth.OTPExpiryMinutes,
 	}
+	// The user has no profile yet, so the OTP email follows the app language
+	if locale, err := contextutils.GetLocale(ctx); err == nil {
+		otpEvent.Locale = locale
+	}
 
 	if err := u.eventPublisher.PublishUserOTPRequested(ctx, otpEvent); err != nil {
 		return fmt.Errorf("failed to publish OTP requested event: %w", err)
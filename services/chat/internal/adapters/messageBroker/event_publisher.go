@@ -0,0 +1,40 @@
+package messagebroker
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	chatevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/chat"
+	messageBroker "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/messagebroker"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/domain/event"
+	"go.uber.org/zap"
+)
+
+type eventPublisher struct {
+	messagingClient messageBroker.Client
+	logger          *zap.Logger
+}
+
+func NewEventPublisher(
+	messagingClient messageBroker.Client,
+	logger *zap.Logger) event.EventPublisher {
+
+	return &eventPublisher{
+		messagingClient: messagingClient,
+		logger:          logger,
+	}
+}
+
+func (p *eventPublisher) PublishMessageReported(
+	ctx context.Context,
+	event chatevents.MessageReportedEvent) error {
+
+	if err := p.messagingClient.Publish(constants.EventChatMessageReported, event); err != nil {
+		p.logger.Error("failed to publish message reported event",
+			zap.String(constants.UserIDS, event.ReporterUserID.String()),
+			zap.String("message_id", event.MessageID),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
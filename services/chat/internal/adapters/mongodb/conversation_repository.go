@@ -3,6 +3,7 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/mongodb"
@@ -71,4 +72,40 @@ func (r *conversationRepository) GetConversationByID(ctx context.Context, conver
 		return nil, fmt.Errorf("mongodb: find conversation: %w", err)
 	}
 	return &conv, nil
-}
\ No newline at end of file
+}
+
+func (r *conversationRepository) SetConversationArchived(
+	ctx context.Context,
+	participants []string,
+	isArchived bool,
+	eventAt time.Time) error {
+
+	coll := r.db.Collection(constants.MongoDBCollectionConversations)
+
+	filter := bson.M{
+		"participant_ids": bson.M{
+			"$all":  participants,
+			"$size": len(participants),
+		},
+		"$or": bson.A{
+			bson.M{"match_updated_at": bson.M{"$exists": false}},
+			bson.M{"match_updated_at": bson.M{"$lt": eventAt}},
+		},
+	}
+
+	update := bson.M{
+		"$set":   bson.M{"match_updated_at": eventAt},
+		"$unset": bson.M{"archived_at": ""},
+	}
+	if isArchived {
+		update = bson.M{"$set": bson.M{
+			"archived_at":      eventAt,
+			"match_updated_at": eventAt,
+		}}
+	}
+
+	if _, err := coll.UpdateOne(ctx, filter, update); err != nil {
+		return fmt.Errorf("mongodb: update conversation archived: %w", err)
+	}
+	return nil
+}
@@ -75,3 +75,22 @@ func (r *messageRepository) GetMessagesByConversationID(ctx context.Context, con
 
 	return messages, totalCount, nil
 }
+
+func (r *messageRepository) GetMessageByID(ctx context.Context, messageID string) (*entity.Message, error) {
+	coll := r.db.Collection(constants.MongoDBCollectionMessages)
+
+	objID, err := primitive.ObjectIDFromHex(messageID)
+	if err != nil {
+		return nil, fmt.Errorf("mongodb: invalid message ID: %w", err)
+	}
+
+	var message entity.Message
+	err = coll.FindOne(ctx, bson.M{"_id": objID}).Decode(&message)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("mongodb: find message: %w", err)
+	}
+	return &message, nil
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/domain/entity"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/domain/repository"
+)
+
+type userBlockProjectionRepository struct {
+	db *postgres.Client
+}
+
+func NewUserBlockProjectionRepository(db *postgres.Client) repository.UserBlockProjectionRepository {
+	return &userBlockProjectionRepository{db: db}
+}
+
+// Events can be redelivered or arrive out of order, the row only moves
+// forward to a later event
+func (r *userBlockProjectionRepository) ApplyUserBlock(
+	ctx context.Context,
+	userBlock *entity.UserBlockProjection) error {
+
+	return r.db.GormDB.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "blocker_uuid"}, {Name: "blocked_uuid"}},
+			DoUpdates: clause.AssignmentColumns([]string{"is_blocked", "updated_at"}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Expr{SQL: "user_block_projection.updated_at < excluded.updated_at"},
+			}},
+		}).
+		Create(userBlock).Error
+}
+
+func (r *userBlockProjectionRepository) IsBlockedEitherWay(
+	ctx context.Context,
+	userUUID1, userUUID2 string) (bool, error) {
+
+	var count int64
+	if err := r.db.GormDB.WithContext(ctx).
+		Model(&entity.UserBlockProjection{}).
+		Where("(blocker_uuid = ? AND blocked_uuid = ?) OR (blocker_uuid = ? AND blocked_uuid = ?)",
+			userUUID1, userUUID2, userUUID2, userUUID1).
+		Where("is_blocked = true").
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
@@ -19,6 +19,12 @@ type Conversation struct {
 	CreatedAt      time.Time      `bson:"created_at" json:"created_at"`
 	UpdatedAt      time.Time      `bson:"updated_at" json:"updated_at"`
 	LastMessageAt  *time.Time     `bson:"last_message_at,omitempty" json:"last_message_at,omitempty"`
+	// Set while the participants' mutual match has ended, the conversation
+	// is read-only until they match again
+	ArchivedAt *time.Time `bson:"archived_at,omitempty" json:"archived_at,omitempty"`
+	// Time of the last match event applied, match events may arrive out of
+	// order and older ones are ignored
+	MatchUpdatedAt *time.Time `bson:"match_updated_at,omitempty" json:"-"`
 }
 
 type Message struct {
@@ -58,6 +64,7 @@ type GetConversationResponse struct {
 	CreatedAt      time.Time      `json:"created_at"`
 	UpdatedAt      time.Time      `json:"updated_at"`
 	LastMessageAt  *time.Time     `json:"last_message_at,omitempty"`
+	IsArchived     bool           `json:"is_archived"`
 }
 
 type MessageInfo struct {
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserBlockProjection struct {
+	BlockerUUID uuid.UUID `json:"blocker_uuid" gorm:"type:uuid;primaryKey;column:blocker_uuid"`
+	BlockedUUID uuid.UUID `json:"blocked_uuid" gorm:"type:uuid;primaryKey;column:blocked_uuid"`
+	CreatedAt   time.Time `json:"created_at" gorm:"not null;column:created_at"`
+	// Unblocking keeps the row, UpdatedAt is the time of the last applied
+	// block event and older events are ignored
+	IsBlocked bool      `json:"is_blocked" gorm:"not null;column:is_blocked"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null;column:updated_at;autoUpdateTime:false"`
+}
+
+func (UserBlockProjection) TableName() string {
+	return "user_block_projection"
+}
@@ -0,0 +1,11 @@
+package event
+
+import (
+	"context"
+
+	chatevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/chat"
+)
+
+type EventPublisher interface {
+	PublishMessageReported(ctx context.Context, event chatevents.MessageReportedEvent) error
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/domain/entity"
 )
@@ -13,4 +14,11 @@ type ConversationRepository interface {
 		participants []string) (*entity.Conversation, error)
 	GetConversationByID(ctx context.Context,
 		conversationID string) (*entity.Conversation, error)
+	// SetConversationArchived archives the participants' conversation as of
+	// eventAt, or reopens it, unless a later match event has been applied.
+	// Participants who never talked have none.
+	SetConversationArchived(ctx context.Context,
+		participants []string,
+		isArchived bool,
+		eventAt time.Time) error
 }
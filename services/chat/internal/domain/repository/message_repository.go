@@ -9,4 +9,5 @@ import (
 type MessageRepository interface {
 	CreateMessage(ctx context.Context, message *entity.Message) error
 	GetMessagesByConversationID(ctx context.Context, conversationID string, limit, offset int32) ([]*entity.Message, int64, error)
+	GetMessageByID(ctx context.Context, messageID string) (*entity.Message, error)
 }
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/domain/entity"
+)
+
+type UserBlockProjectionRepository interface {
+	// ApplyUserBlock records a block or an unblock, unless a later event for
+	// the same pair has already been applied.
+	ApplyUserBlock(ctx context.Context, userBlock *entity.UserBlockProjection) error
+	IsBlockedEitherWay(ctx context.Context, userUUID1, userUUID2 string) (bool, error)
+}
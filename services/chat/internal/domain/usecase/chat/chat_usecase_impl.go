@@ -1,23 +1,30 @@
 package chat
 
 import (
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/domain/event"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/domain/repository"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/domain/usecase"
 )
 
 type chatUsecase struct {
-	conversationRepository   repository.ConversationRepository
-	messageRepository        repository.MessageRepository
-	userProjectionRepository repository.UserProjectionRepository
+	conversationRepository        repository.ConversationRepository
+	messageRepository             repository.MessageRepository
+	userProjectionRepository      repository.UserProjectionRepository
+	userBlockProjectionRepository repository.UserBlockProjectionRepository
+	eventPublisher                event.EventPublisher
 }
 
 func NewChatUsecase(
 	conversationRepository repository.ConversationRepository,
 	messageRepository repository.MessageRepository,
-	userProjectionRepository repository.UserProjectionRepository) usecase.ChatUsecase {
+	userProjectionRepository repository.UserProjectionRepository,
+	userBlockProjectionRepository repository.UserBlockProjectionRepository,
+	eventPublisher event.EventPublisher) usecase.ChatUsecase {
 	return &chatUsecase{
-		conversationRepository:   conversationRepository,
-		messageRepository:        messageRepository,
-		userProjectionRepository: userProjectionRepository,
+		conversationRepository:        conversationRepository,
+		messageRepository:             messageRepository,
+		userProjectionRepository:      userProjectionRepository,
+		userBlockProjectionRepository: userBlockProjectionRepository,
+		eventPublisher:                eventPublisher,
 	}
 }
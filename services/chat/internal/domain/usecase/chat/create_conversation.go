@@ -47,6 +47,14 @@ func (c *chatUsecase) CreateConversation(ctx context.Context,
 		return nil, fmt.Errorf("failed to parse partner UUID: %w", err)
 	}
 
+	isBlocked, err := c.userBlockProjectionRepository.IsBlockedEitherWay(ctx, userUUID.String(), partnerUUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check user block: %w", err)
+	}
+	if isBlocked {
+		return nil, appError.ErrConversationBlocked
+	}
+
 	participants := []string{userUUID.String(), partnerUUID.String()}
 	sort.Strings(participants)
 
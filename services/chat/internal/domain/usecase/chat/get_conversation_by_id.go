@@ -29,6 +29,7 @@ func (c *chatUsecase) GetConversationByID(ctx context.Context,
 		ParticipantIDs: participantIDs,
 		CreatedAt:      conversation.CreatedAt,
 		UpdatedAt:      conversation.UpdatedAt,
+		IsArchived:     conversation.ArchivedAt != nil,
 	}
 
 	return getConversationResponse, nil
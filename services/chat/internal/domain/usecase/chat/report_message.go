@@ -0,0 +1,84 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	appError "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	chatevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/chat"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+)
+
+func (c *chatUsecase) ReportMessage(ctx context.Context,
+	reporterID, messageID, category, description string) error {
+
+	if !validation.IsValidReportCategory(category) {
+		return appError.ErrInvalidReportCategory
+	}
+	description = strings.TrimSpace(description)
+	if len(description) > constants.MaxReportDescriptionLength {
+		return appError.ErrReportDescriptionTooLong
+	}
+
+	if !validation.IsValidMessageID(messageID) {
+		return appError.ErrMessageNotFound
+	}
+
+	message, err := c.messageRepository.GetMessageByID(ctx, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to get message: %w", err)
+	}
+	if message == nil {
+		return appError.ErrMessageNotFound
+	}
+	if string(message.SenderID) == reporterID {
+		return appError.ErrCannotReportOwnMessage
+	}
+
+	conversation, err := c.conversationRepository.GetConversationByID(ctx, message.ConversationID.Hex())
+	if err != nil {
+		return fmt.Errorf("failed to get conversation: %w", err)
+	}
+	if conversation == nil {
+		return appError.ErrConversationNotFound
+	}
+
+	isParticipant := false
+	for _, participantID := range conversation.ParticipantIDs {
+		if string(participantID) == reporterID {
+			isParticipant = true
+			break
+		}
+	}
+	if !isParticipant {
+		return appError.ErrUserNotParticipant
+	}
+
+	reporterUUID, err := uuid.Parse(reporterID)
+	if err != nil {
+		return fmt.Errorf("failed to parse reporter UUID: %w", err)
+	}
+	senderUUID, err := uuid.Parse(string(message.SenderID))
+	if err != nil {
+		return fmt.Errorf("failed to parse sender UUID: %w", err)
+	}
+
+	// Reports are stored and triaged by the user service
+	if err := c.eventPublisher.PublishMessageReported(ctx, chatevents.MessageReportedEvent{
+		ReporterUserID: reporterUUID,
+		SenderUserID:   senderUUID,
+		MessageID:      messageID,
+		MessageContent: message.Content,
+		Category:       category,
+		Description:    description,
+		ReportedAt:     time.Now().UTC(),
+	}); err != nil {
+		return fmt.Errorf("failed to publish message report: %w", err)
+	}
+
+	return nil
+}
@@ -16,6 +16,42 @@ func (c *chatUsecase) SendMessage(ctx context.Context, conversationIDStr, sender
 		return nil, fmt.Errorf("invalid conversation ID: %w", err)
 	}
 
+	conversation, err := c.conversationRepository.GetConversationByID(ctx, conversationIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversation: %w", err)
+	}
+	if conversation == nil {
+		return nil, appError.ErrConversationNotFound
+	}
+
+	isParticipant := false
+	for _, participantID := range conversation.ParticipantIDs {
+		if string(participantID) == senderID {
+			isParticipant = true
+			break
+		}
+	}
+	if !isParticipant {
+		return nil, appError.ErrUserNotParticipant
+	}
+
+	if conversation.ArchivedAt != nil {
+		return nil, appError.ErrConversationArchived
+	}
+
+	for _, participantID := range conversation.ParticipantIDs {
+		if string(participantID) == senderID {
+			continue
+		}
+		isBlocked, err := c.userBlockProjectionRepository.IsBlockedEitherWay(ctx, senderID, string(participantID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to check user block: %w", err)
+		}
+		if isBlocked {
+			return nil, appError.ErrConversationBlocked
+		}
+	}
+
 	now := time.Now().UTC()
 	message := &entity.Message{
 		ID:             entity.NewMessageID(),
@@ -0,0 +1,20 @@
+package chat
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// UpdateConversationArchived makes the conversation between the two users
+// read-only when their mutual match ends and reopens it when they match again.
+// eventAt is when the match changed, an event older than the last one applied
+// is ignored.
+func (c *chatUsecase) UpdateConversationArchived(ctx context.Context,
+	userID1, userID2 string, isArchived bool, eventAt time.Time) error {
+
+	participants := []string{userID1, userID2}
+	sort.Strings(participants)
+
+	return c.conversationRepository.SetConversationArchived(ctx, participants, isArchived, eventAt)
+}
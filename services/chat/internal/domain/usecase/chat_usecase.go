@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/domain/entity"
 )
@@ -20,4 +21,14 @@ type ChatUsecase interface {
 		conversationID string,
 		userID string,
 		limit, offset int32) (*entity.GetMessagesByConversationIDResponse, error)
+	ReportMessage(ctx context.Context,
+		reporterID,
+		messageID,
+		category,
+		description string) error
+	UpdateConversationArchived(ctx context.Context,
+		userID1,
+		userID2 string,
+		isArchived bool,
+		eventAt time.Time) error
 }
@@ -0,0 +1,19 @@
+package userprojection
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/domain/entity"
+)
+
+// UpdateUserBlockProjection applies a block or unblock event. userBlock
+// carries the event time in UpdatedAt, an event older than the one already
+// applied for the pair is ignored.
+func (u *userProjectionUsecase) UpdateUserBlockProjection(
+	ctx context.Context,
+	userBlock *entity.UserBlockProjection,
+	isBlocked bool) error {
+
+	userBlock.IsBlocked = isBlocked
+	return u.userBlockProjectionRepository.ApplyUserBlock(ctx, userBlock)
+}
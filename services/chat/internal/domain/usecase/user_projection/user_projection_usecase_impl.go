@@ -6,11 +6,15 @@ import (
 )
 
 type userProjectionUsecase struct {
-	userProjectionRepository repository.UserProjectionRepository
+	userProjectionRepository      repository.UserProjectionRepository
+	userBlockProjectionRepository repository.UserBlockProjectionRepository
 }
 
-func NewUserProjectionUsecase(userProjectionRepository repository.UserProjectionRepository) usecase.UserProjectionUsecase {
+func NewUserProjectionUsecase(
+	userProjectionRepository repository.UserProjectionRepository,
+	userBlockProjectionRepository repository.UserBlockProjectionRepository) usecase.UserProjectionUsecase {
 	return &userProjectionUsecase{
-		userProjectionRepository: userProjectionRepository,
+		userProjectionRepository:      userProjectionRepository,
+		userBlockProjectionRepository: userBlockProjectionRepository,
 	}
 }
@@ -8,4 +8,5 @@ import (
 
 type UserProjectionUsecase interface {
 	CreateOrUpdateUserProjection(ctx context.Context, userProjection *entity.UserProjection) error
+	UpdateUserBlockProjection(ctx context.Context, userBlock *entity.UserBlockProjection, isBlocked bool) error
 }
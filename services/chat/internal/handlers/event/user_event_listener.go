@@ -3,7 +3,9 @@ package event
 import (
 	"context"
 	"encoding/json"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	userevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/user"
 	messageBroker "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/messagebroker"
@@ -15,24 +17,62 @@ import (
 type UserEventListener struct {
 	messagingClient       messageBroker.Client
 	userProjectionUsecase usecase.UserProjectionUsecase
+	chatUsecase           usecase.ChatUsecase
 	logger                *zap.Logger
 }
 
 func NewUserEventListener(
 	messagingClient messageBroker.Client,
 	userProjectionUsecase usecase.UserProjectionUsecase,
+	chatUsecase usecase.ChatUsecase,
 	logger *zap.Logger) *UserEventListener {
 
 	return &UserEventListener{
 		messagingClient:       messagingClient,
 		userProjectionUsecase: userProjectionUsecase,
+		chatUsecase:           chatUsecase,
 		logger:                logger,
 	}
 }
 
 func (h *UserEventListener) StartListening(
 	ctx context.Context) error {
-	handler := func(data []byte) error {
+
+	subscriptions := []struct {
+		topic   string
+		handler messageBroker.MessageHandler
+	}{
+		{
+			topic:   constants.EventUserProfileUpdated,
+			handler: h.createUserProfileUpdatedHandler(ctx),
+		},
+		{
+			topic:   constants.EventUserBlockUpdated,
+			handler: h.createUserBlockUpdatedHandler(ctx),
+		},
+		{
+			topic:   constants.EventMutualMatchCreated,
+			handler: h.createMutualMatchCreatedHandler(ctx),
+		},
+		{
+			topic:   constants.EventMutualMatchRemoved,
+			handler: h.createMutualMatchRemovedHandler(ctx),
+		},
+	}
+
+	for _, sub := range subscriptions {
+		h.logger.Info("starting to listen for user events", zap.String("topic", sub.topic))
+		if err := h.messagingClient.Subscribe(sub.topic, sub.handler); err != nil {
+			h.logger.Error("Error subscribing to topic", zap.String("topic", sub.topic), zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *UserEventListener) createUserProfileUpdatedHandler(ctx context.Context) messageBroker.MessageHandler {
+	return func(data []byte) error {
 		var userEvent userevents.UserProfileUpdatedEvent
 		if err := json.Unmarshal(data, &userEvent); err != nil {
 			h.logger.Error("failed to unmarshal user profile updated event",
@@ -42,9 +82,53 @@ func (h *UserEventListener) StartListening(
 
 		return h.handleUserProfileUpdated(ctx, userEvent)
 	}
+}
+
+func (h *UserEventListener) createUserBlockUpdatedHandler(ctx context.Context) messageBroker.MessageHandler {
+	return func(data []byte) error {
+		var blockEvent userevents.UserBlockUpdatedEvent
+		if err := json.Unmarshal(data, &blockEvent); err != nil {
+			h.logger.Error("failed to unmarshal user block updated event", zap.Error(err))
+			return err
+		}
+
+		return h.handleUserBlockUpdated(ctx, blockEvent)
+	}
+}
+
+func (h *UserEventListener) createMutualMatchCreatedHandler(ctx context.Context) messageBroker.MessageHandler {
+	return func(data []byte) error {
+		var matchEvent userevents.MutualMatchCreatedEvent
+		if err := json.Unmarshal(data, &matchEvent); err != nil {
+			h.logger.Error("failed to unmarshal mutual match created event", zap.Error(err))
+			return err
+		}
+
+		// Published before the user IDs were added, nothing to reopen by
+		if matchEvent.User1ID == uuid.Nil || matchEvent.User2ID == uuid.Nil {
+			return nil
+		}
+
+		// Published before the match time was added, applied as of now
+		matchedAt := matchEvent.MatchedAt
+		if matchedAt.IsZero() {
+			matchedAt = time.Now().UTC()
+		}
+
+		return h.handleMutualMatchUpdated(ctx, matchEvent.User1ID, matchEvent.User2ID, false, matchedAt)
+	}
+}
+
+func (h *UserEventListener) createMutualMatchRemovedHandler(ctx context.Context) messageBroker.MessageHandler {
+	return func(data []byte) error {
+		var matchEvent userevents.MutualMatchRemovedEvent
+		if err := json.Unmarshal(data, &matchEvent); err != nil {
+			h.logger.Error("failed to unmarshal mutual match removed event", zap.Error(err))
+			return err
+		}
 
-	h.logger.Info("starting to listen for user profile updated events")
-	return h.messagingClient.Subscribe(constants.EventUserProfileUpdated, handler)
+		return h.handleMutualMatchUpdated(ctx, matchEvent.RemovedByUserID, matchEvent.MatchedUserID, true, matchEvent.RemovedAt)
+	}
 }
 
 func (h *UserEventListener) handleUserProfileUpdated(ctx context.Context, event userevents.UserProfileUpdatedEvent) error {
@@ -64,3 +148,42 @@ func (h *UserEventListener) handleUserProfileUpdated(ctx context.Context, event
 
 	return nil
 }
+
+func (h *UserEventListener) handleUserBlockUpdated(ctx context.Context, event userevents.UserBlockUpdatedEvent) error {
+	userBlock := &entity.UserBlockProjection{
+		BlockerUUID: event.BlockerUserID,
+		BlockedUUID: event.BlockedUserID,
+		CreatedAt:   event.UpdatedAt,
+		UpdatedAt:   event.UpdatedAt,
+	}
+
+	if err := h.userProjectionUsecase.UpdateUserBlockProjection(ctx, userBlock, event.IsBlocked); err != nil {
+		h.logger.Error("failed to update user block projection",
+			zap.String(constants.UserIDS, event.BlockerUserID.String()),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// handleMutualMatchUpdated archives the users' conversation when their match
+// ends and reopens it when they match again. The events may arrive out of
+// order, eventAt keeps an older one from undoing a newer one.
+func (h *UserEventListener) handleMutualMatchUpdated(
+	ctx context.Context,
+	userID1, userID2 uuid.UUID,
+	isArchived bool,
+	eventAt time.Time) error {
+
+	if err := h.chatUsecase.UpdateConversationArchived(ctx, userID1.String(), userID2.String(), isArchived, eventAt); err != nil {
+		h.logger.Error("failed to update conversation archived",
+			zap.String(constants.UserIDS, userID1.String()),
+			zap.String(constants.UserIDS, userID2.String()),
+			zap.Bool("is_archived", isArchived),
+			zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -128,6 +128,7 @@ func (h *ChatHandler) GetConversation(ctx context.Context, req *chatpbv1.GetConv
 		ParticipantIds: conversation.ParticipantIDs,
 		CreatedAt:      timestamppb.New(conversation.CreatedAt),
 		UpdatedAt:      timestamppb.New(conversation.UpdatedAt),
+		IsArchived:     conversation.IsArchived,
 	}, nil
 }
 
@@ -185,3 +186,33 @@ func (h *ChatHandler) GetMessagesByConversationId(
 		Pagination: protoPagination,
 	}, nil
 }
+
+func (h *ChatHandler) ReportMessage(
+	ctx context.Context,
+	req *chatpbv1.ReportMessageRequest) (*chatpbv1.ReportMessageResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	if err := h.chatUsecase.ReportMessage(ctx, contextData.UserID, req.MessageId, req.Category, req.Description); err != nil {
+		if !appErrors.IsAppError(err) {
+			log.Error("Failed to report message",
+				zap.Error(err),
+				zap.String("message_id", req.MessageId))
+		}
+		return nil, err
+	}
+
+	log.Info("Message reported successfully",
+		zap.String("message_id", req.MessageId))
+
+	return &chatpbv1.ReportMessageResponse{Success: true}, nil
+}
@@ -13,6 +13,7 @@ import (
 	messageBroker "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/messagebroker"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/messagebroker/pubsub"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/messagebroker/rabbitmq"
+	messageBrokerAdapter "github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/adapters/messageBroker"
 	mongodbAdapters "github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/adapters/mongodb"
 	postgresAdapters "github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/adapters/postgres"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/chat/internal/config"
@@ -111,15 +112,19 @@ func NewServer(ctx context.Context, config *config.Config, rootLogger *zap.Logge
 
 	///////////////////////// REPOSITORIES INITIALIZATION /////////////////////////
 	userProjectionRepo := postgresAdapters.NewUserProjectionRepository(pgClient)
+	userBlockProjectionRepo := postgresAdapters.NewUserBlockProjectionRepository(pgClient)
 	conversationRepo := mongodbAdapters.NewConversationRepository(mongoClient)
 	messageRepo := mongodbAdapters.NewMessageRepository(mongoClient)
 
+	///////////////////////// EVENT PUBLISHER INITIALIZATION /////////////////////////
+	eventPublisher := messageBrokerAdapter.NewEventPublisher(messagingClient, rootLogger)
+
 	///////////////////////// USE CASES INITIALIZATION /////////////////////////
-	userProjectionUC := userProjectionUsecaseImpl.NewUserProjectionUsecase(userProjectionRepo)
-	chatUC := chatUsecaseImpl.NewChatUsecase(conversationRepo, messageRepo, userProjectionRepo)
+	userProjectionUC := userProjectionUsecaseImpl.NewUserProjectionUsecase(userProjectionRepo, userBlockProjectionRepo)
+	chatUC := chatUsecaseImpl.NewChatUsecase(conversationRepo, messageRepo, userProjectionRepo, userBlockProjectionRepo, eventPublisher)
 
 	///////////////////////// EVENT HANDLER INITIALIZATION /////////////////////////
-	userEventHandler := eventHandlers.NewUserEventListener(messagingClient, userProjectionUC, rootLogger)
+	userEventHandler := eventHandlers.NewUserEventListener(messagingClient, userProjectionUC, chatUC, rootLogger)
 
 	///////////////////////// GRPC HANDLER INITIALIZATION /////////////////////////
 	chatHandler := v1.NewChatHandler(chatUC, rootLogger)
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS user_block_projection (
+    blocker_uuid  UUID NOT NULL,
+    blocked_uuid  UUID NOT NULL,
+    created_at    TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (blocker_uuid, blocked_uuid)
+);
+
+-- reverse lookup, a block applies in both directions
+CREATE INDEX IF NOT EXISTS idx_user_block_projection_blocked_uuid ON user_block_projection(blocked_uuid);
@@ -0,0 +1,5 @@
+DELETE FROM user_block_projection WHERE is_blocked = FALSE;
+
+ALTER TABLE user_block_projection
+  DROP COLUMN IF EXISTS updated_at,
+  DROP COLUMN IF EXISTS is_blocked;
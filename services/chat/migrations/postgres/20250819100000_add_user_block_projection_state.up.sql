@@ -0,0 +1,10 @@
+-- Block events can arrive out of order. An unblock is kept as a row with
+-- is_blocked false, so the time of the last applied event survives and older
+-- events are ignored.
+ALTER TABLE user_block_projection
+  ADD COLUMN IF NOT EXISTS is_blocked BOOLEAN NOT NULL DEFAULT TRUE,
+  ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP WITH TIME ZONE;
+
+UPDATE user_block_projection SET updated_at = created_at WHERE updated_at IS NULL;
+
+ALTER TABLE user_block_projection ALTER COLUMN updated_at SET NOT NULL;
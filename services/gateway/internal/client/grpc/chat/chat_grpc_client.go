@@ -107,4 +107,19 @@ func (c *chatGRPCClient) GetMessagesByConversationId(ctx context.Context, req dt
 		return nil, err
 	}
 	return MapGetMessagesByConversationIdResponse(grpcResp), nil
-}
\ No newline at end of file
+}
+
+func (c *chatGRPCClient) ReportMessage(ctx context.Context, req dto.ReportMessageRequest) (*dto.ReportMessageResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapReportMessageRequest(req)
+	grpcResp, err := c.client.ReportMessage(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapReportMessageResponse(grpcResp), nil
+}
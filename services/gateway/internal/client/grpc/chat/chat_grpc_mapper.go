@@ -53,6 +53,7 @@ func MapGetConversationResponse(resp *chatpbv1.GetConversationResponse) *dto.Get
 		ParticipantIDs: resp.ParticipantIds,
 		CreatedAt:      resp.CreatedAt.AsTime(),
 		UpdatedAt:      resp.UpdatedAt.AsTime(),
+		IsArchived:     resp.GetIsArchived(),
 	}
 }
 
@@ -87,3 +88,18 @@ func MapGetMessagesByConversationIdResponse(resp *chatpbv1.GetMessagesByConversa
 		},
 	}
 }
+
+// /////////////////////////// Report Message //////////////////////////////
+func MapReportMessageRequest(req dto.ReportMessageRequest) *chatpbv1.ReportMessageRequest {
+	return &chatpbv1.ReportMessageRequest{
+		MessageId:   req.MessageID,
+		Category:    req.Category,
+		Description: req.Description,
+	}
+}
+
+func MapReportMessageResponse(resp *chatpbv1.ReportMessageResponse) *dto.ReportMessageResponse {
+	return &dto.ReportMessageResponse{
+		Success: resp.Success,
+	}
+}
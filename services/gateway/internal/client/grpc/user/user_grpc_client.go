@@ -65,7 +65,7 @@ func (c *userGRPCClient) UpdateUserProfile(ctx context.Context, req dto.UserProf
 	return nil
 }
 
-func (c *userGRPCClient) GetUserProfile(ctx context.Context) (*dto.UserProfileRecommendation, error) {
+func (c *userGRPCClient) GetUserProfile(ctx context.Context) (*dto.GetUserProfileResponse, error) {
 	var err error
 	ctx, err = contextutils.PrepareGrpcContext(ctx)
 	if err != nil {
@@ -187,6 +187,36 @@ func (c *userGRPCClient) GetAdditionalPhotos(ctx context.Context) (*dto.GetAddit
 	return MapGetAdditionalPhotosResponse(resp), nil
 }
 
+func (c *userGRPCClient) ReorderAdditionalPhotos(ctx context.Context, req dto.ReorderAdditionalPhotosRequest) (*dto.ReorderAdditionalPhotosResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapReorderAdditionalPhotosRequest(req)
+	resp, err := c.client.ReorderAdditionalPhotos(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapReorderAdditionalPhotosResponse(resp), nil
+}
+
+func (c *userGRPCClient) SetPrimaryPhoto(ctx context.Context, req dto.SetPrimaryPhotoRequest) (*dto.SetPrimaryPhotoResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapSetPrimaryPhotoRequest(req)
+	resp, err := c.client.SetPrimaryPhoto(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapSetPrimaryPhotoResponse(resp), nil
+}
+
 //////////////// PARTNER PREFERENCES MANAGEMENT //////////////////
 func (c *userGRPCClient) UpdateUserPartnerPreferences(
 	ctx context.Context, 
@@ -237,6 +267,20 @@ func (c *userGRPCClient) RecordMatchAction(ctx context.Context, req dto.RecordMa
 	return MapRecordMatchActionResponse(resp), nil
 }
 
+func (c *userGRPCClient) UndoLastMatchAction(ctx context.Context) (*dto.UndoLastMatchActionResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.UndoLastMatchAction(ctx, &userpbv1.UndoLastMatchActionRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return MapUndoLastMatchActionResponse(resp), nil
+}
+
 func (c *userGRPCClient) GetMatchRecommendations(ctx context.Context, req dto.GetMatchRecommendationsRequest) (*dto.GetMatchRecommendationsResponse, error) {
 	var err error
 	ctx, err = contextutils.PrepareGrpcContext(ctx)
@@ -283,6 +327,350 @@ func (c *userGRPCClient) GetUserDetailsByProfileID(ctx context.Context, req dto.
 	return MapGetUserDetailsByProfileIDResponse(resp), nil
 }
 
+func (c *userGRPCClient) BlockOrUnblockProfile(ctx context.Context, req dto.BlockOrUnblockProfileRequest) (*dto.BlockOrUnblockProfileResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapBlockOrUnblockProfileRequest(req)
+	resp, err := c.client.BlockOrUnblockProfile(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapBlockOrUnblockProfileResponse(resp), nil
+}
+
+func (c *userGRPCClient) ReportProfile(ctx context.Context, req dto.ReportProfileRequest) (*dto.ReportProfileResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapReportProfileRequest(req)
+	resp, err := c.client.ReportProfile(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapReportProfileResponse(resp), nil
+}
+
+func (c *userGRPCClient) GetReports(ctx context.Context, req dto.GetReportsRequest) (*dto.GetReportsResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapGetReportsRequest(req)
+	resp, err := c.client.GetReports(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapGetReportsResponse(resp), nil
+}
+
+func (c *userGRPCClient) GetReport(ctx context.Context, reportID int64) (*dto.ReportResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.GetReport(ctx, &userpbv1.GetReportRequest{ReportId: reportID})
+	if err != nil {
+		return nil, err
+	}
+	report := mapReportInfo(resp.Report)
+	return &report, nil
+}
+
+func (c *userGRPCClient) ResolveReport(ctx context.Context, req dto.ResolveReportRequest) (*dto.ResolveReportResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapResolveReportRequest(req)
+	resp, err := c.client.ResolveReport(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapResolveReportResponse(resp), nil
+}
+
+func (c *userGRPCClient) GetPendingPhotos(ctx context.Context, req dto.GetPendingPhotosRequest) (*dto.GetPendingPhotosResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapGetPendingPhotosRequest(req)
+	resp, err := c.client.GetPendingPhotos(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapGetPendingPhotosResponse(resp), nil
+}
+
+func (c *userGRPCClient) ReviewPhoto(ctx context.Context, req dto.ReviewPhotoRequest) (*dto.ReviewPhotoResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapReviewPhotoRequest(req)
+	resp, err := c.client.ReviewPhoto(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapReviewPhotoResponse(resp), nil
+}
+
+func (c *userGRPCClient) GetProfileHistory(ctx context.Context, req dto.GetProfileHistoryRequest) (*dto.GetProfileHistoryResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapGetProfileHistoryRequest(req)
+	resp, err := c.client.GetProfileHistory(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapGetProfileHistoryResponse(resp), nil
+}
+
+func (c *userGRPCClient) GetProfileVisitors(ctx context.Context, req dto.GetProfileVisitorsRequest) (*dto.GetProfileVisitorsResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapGetProfileVisitorsRequest(req)
+	resp, err := c.client.GetProfileVisitors(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapGetProfileVisitorsResponse(resp), nil
+}
+
+func (c *userGRPCClient) GetReceivedInterests(ctx context.Context, req dto.GetReceivedInterestsRequest) (*dto.GetReceivedInterestsResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapGetReceivedInterestsRequest(req)
+	resp, err := c.client.GetReceivedInterests(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapGetReceivedInterestsResponse(resp), nil
+}
+
+func (c *userGRPCClient) GetReceivedInterestCounts(ctx context.Context) (*dto.GetReceivedInterestCountsResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.GetReceivedInterestCounts(ctx, &userpbv1.GetReceivedInterestCountsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return MapGetReceivedInterestCountsResponse(resp), nil
+}
+
+func (c *userGRPCClient) RespondToInterest(ctx context.Context, req dto.RespondToInterestRequest) (*dto.RespondToInterestResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapRespondToInterestRequest(req)
+	resp, err := c.client.RespondToInterest(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.RespondToInterestResponse{Success: resp.Success}, nil
+}
+
+func (c *userGRPCClient) AddToShortlist(ctx context.Context, req dto.AddToShortlistRequest) (*dto.ShortlistResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapAddToShortlistRequest(req)
+	resp, err := c.client.AddToShortlist(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.ShortlistResponse{Success: resp.Success}, nil
+}
+
+func (c *userGRPCClient) RemoveFromShortlist(ctx context.Context, req dto.RemoveFromShortlistRequest) (*dto.ShortlistResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapRemoveFromShortlistRequest(req)
+	resp, err := c.client.RemoveFromShortlist(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.ShortlistResponse{Success: resp.Success}, nil
+}
+
+func (c *userGRPCClient) GetShortlist(ctx context.Context, req dto.GetShortlistRequest) (*dto.GetShortlistResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapGetShortlistRequest(req)
+	resp, err := c.client.GetShortlist(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapGetShortlistResponse(resp), nil
+}
+
+func (c *userGRPCClient) SearchProfiles(ctx context.Context, req dto.SearchProfilesRequest) (*dto.SearchProfilesResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapSearchProfilesRequest(req)
+	resp, err := c.client.SearchProfiles(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapSearchProfilesResponse(resp), nil
+}
+
+func (c *userGRPCClient) CreateSavedSearch(ctx context.Context, req dto.CreateSavedSearchRequest) (*dto.CreateSavedSearchResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapCreateSavedSearchRequest(req)
+	resp, err := c.client.CreateSavedSearch(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.CreateSavedSearchResponse{SavedSearch: mapSavedSearch(resp.GetSavedSearch())}, nil
+}
+
+func (c *userGRPCClient) GetSavedSearches(ctx context.Context) (*dto.GetSavedSearchesResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.GetSavedSearches(ctx, &userpbv1.GetSavedSearchesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return MapGetSavedSearchesResponse(resp), nil
+}
+
+func (c *userGRPCClient) DeleteSavedSearch(ctx context.Context, req dto.DeleteSavedSearchRequest) (*dto.DeleteSavedSearchResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.DeleteSavedSearch(ctx, &userpbv1.DeleteSavedSearchRequest{
+		SavedSearchId: req.SavedSearchID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.DeleteSavedSearchResponse{Success: resp.Success}, nil
+}
+
+func (c *userGRPCClient) GetReferenceOptions(ctx context.Context, req dto.GetReferenceOptionsRequest) (*dto.GetReferenceOptionsResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareRequestIDForGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapGetReferenceOptionsRequest(req)
+	resp, err := c.client.GetReferenceOptions(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapGetReferenceOptionsResponse(resp), nil
+}
+
+func (c *userGRPCClient) CreateReferenceOption(ctx context.Context, req dto.CreateReferenceOptionRequest) (*dto.ReferenceOptionResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapCreateReferenceOptionRequest(req)
+	resp, err := c.client.CreateReferenceOption(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapCreateReferenceOptionResponse(resp), nil
+}
+
+func (c *userGRPCClient) UpdateReferenceOption(ctx context.Context, req dto.UpdateReferenceOptionRequest) (*dto.ReferenceOptionResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapUpdateReferenceOptionRequest(req)
+	resp, err := c.client.UpdateReferenceOption(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapUpdateReferenceOptionResponse(resp), nil
+}
+
+func (c *userGRPCClient) DeleteReferenceOption(ctx context.Context, req dto.DeleteReferenceOptionRequest) (*dto.DeleteReferenceOptionResponse, error) {
+	var err error
+	ctx, err = contextutils.PrepareGrpcContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcReq := MapDeleteReferenceOptionRequest(req)
+	resp, err := c.client.DeleteReferenceOption(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return MapDeleteReferenceOptionResponse(resp), nil
+}
+
 func (c *userGRPCClient) Close() error {
 	return c.conn.Close()
 }
@@ -40,11 +40,46 @@ func MapUpdateUserProfileRequest(req dto.UserProfilePatchRequest) *userpbv1.Upda
 	if req.HomeDistrict != nil {
 		grpcReq.HomeDistrict = &wrapperspb.StringValue{Value: pointerutil.GetStringValue(req.HomeDistrict)}
 	}
+	if req.IsHidden != nil {
+		grpcReq.IsHidden = &wrapperspb.BoolValue{Value: pointerutil.GetBoolValue(req.IsHidden)}
+	}
 
 	if req.HeightCm != nil {
 		grpcReq.HeightCm = &wrapperspb.Int32Value{Value: int32(pointerutil.GetIntValue(req.HeightCm))}
 	}
 
+	if req.BirthTime != nil {
+		grpcReq.BirthTime = &wrapperspb.StringValue{Value: pointerutil.GetStringValue(req.BirthTime)}
+	}
+	if req.BirthPlace != nil {
+		grpcReq.BirthPlace = &wrapperspb.StringValue{Value: pointerutil.GetStringValue(req.BirthPlace)}
+	}
+	if req.Nakshatra != nil {
+		grpcReq.Nakshatra = &wrapperspb.StringValue{Value: pointerutil.GetStringValue(req.Nakshatra)}
+	}
+	if req.Rasi != nil {
+		grpcReq.Rasi = &wrapperspb.StringValue{Value: pointerutil.GetStringValue(req.Rasi)}
+	}
+	if req.ChovvaDosham != nil {
+		grpcReq.ChovvaDosham = &wrapperspb.BoolValue{Value: pointerutil.GetBoolValue(req.ChovvaDosham)}
+	}
+	if req.PapaDosham != nil {
+		grpcReq.PapaDosham = &wrapperspb.BoolValue{Value: pointerutil.GetBoolValue(req.PapaDosham)}
+	}
+
+	if req.NativePlace != nil {
+		grpcReq.NativePlace = toProtoLocation(req.NativePlace)
+	}
+	if req.Residence != nil {
+		grpcReq.Residence = toProtoLocation(req.Residence)
+	}
+	if req.PreferredLanguage != nil {
+		grpcReq.PreferredLanguage = &wrapperspb.StringValue{Value: pointerutil.GetStringValue(req.PreferredLanguage)}
+	}
+	if req.DigestFrequency != nil {
+		grpcReq.DigestFrequency = &wrapperspb.StringValue{Value: pointerutil.GetStringValue(req.DigestFrequency)}
+	}
+
 	return grpcReq
 }
 
@@ -56,20 +91,32 @@ func MapUpdateUserProfileResponse(resp *userpbv1.UpdateUserProfileResponse) *dto
 
 ////////////////////////////// Get User Profile //////////////////////////////
 
-func MapGetUserProfileResponse(resp *userpbv1.GetUserProfileResponse) *dto.UserProfileRecommendation {
+func MapGetUserProfileResponse(resp *userpbv1.GetUserProfileResponse) *dto.GetUserProfileResponse {
 	p := resp.GetProfile()
 	if p == nil {
 		return nil
 	}
-	return &dto.UserProfileRecommendation{
-		ID:                p.Id,
-		FullName:          p.FullName,
-		ProfilePictureURL: p.ProfilePictureUrl,
-		Age:               int(p.Age),
-		HeightCm:          int(p.HeightCm),
-		MaritalStatus:     p.MaritalStatus,
-		Profession:        p.Profession,
-		HomeDistrict:      p.HomeDistrict,
+	return &dto.GetUserProfileResponse{
+		UserProfileRecommendation: dto.UserProfileRecommendation{
+			ID:                p.Id,
+			FullName:          p.FullName,
+			ProfilePictureURL: p.ProfilePictureUrl,
+			Age:               int(p.Age),
+			HeightCm:          int(p.HeightCm),
+			MaritalStatus:     p.MaritalStatus,
+			Profession:        p.Profession,
+			HomeDistrict:      p.HomeDistrict,
+			NativePlace:       mapLocation(p.GetNativePlace()),
+			Residence:         mapLocation(p.GetResidence()),
+			IsNRI:             p.GetIsNri(),
+		},
+		ViewStats: dto.ProfileViewStats{
+			ViewsLast7Days:  resp.GetViewStats().GetViewsLast_7Days(),
+			ViewsLast30Days: resp.GetViewStats().GetViewsLast_30Days(),
+		},
+		Horoscope:         mapHoroscope(resp.GetHoroscope()),
+		PreferredLanguage: resp.GetPreferredLanguage(),
+		DigestFrequency:   resp.GetDigestFrequency(),
 	}
 }
 
@@ -172,6 +219,33 @@ func MapGetAdditionalPhotosResponse(resp *userpbv1.GetAdditionalPhotosResponse)
 	}
 }
 
+////////////////////////////// Reorder Additional Photos //////////////////////////////
+
+func MapReorderAdditionalPhotosRequest(req dto.ReorderAdditionalPhotosRequest) *userpbv1.ReorderAdditionalPhotosRequest {
+	return &userpbv1.ReorderAdditionalPhotosRequest{
+		DisplayOrders: req.DisplayOrders,
+	}
+}
+
+func MapReorderAdditionalPhotosResponse(resp *userpbv1.ReorderAdditionalPhotosResponse) *dto.ReorderAdditionalPhotosResponse {
+	return &dto.ReorderAdditionalPhotosResponse{
+		Success: resp.Success.GetValue(),
+	}
+}
+
+////////////////////////////// Set Primary Photo //////////////////////////////
+
+func MapSetPrimaryPhotoRequest(req dto.SetPrimaryPhotoRequest) *userpbv1.SetPrimaryPhotoRequest {
+	return &userpbv1.SetPrimaryPhotoRequest{
+		DisplayOrder: &wrapperspb.Int32Value{Value: req.DisplayOrder},
+	}
+}
+
+func MapSetPrimaryPhotoResponse(resp *userpbv1.SetPrimaryPhotoResponse) *dto.SetPrimaryPhotoResponse {
+	return &dto.SetPrimaryPhotoResponse{
+		Success: resp.Success.GetValue(),
+	}
+}
 
 ////////////////////////////// Update User Partner Preferences //////////////////////////////
 
@@ -248,6 +322,30 @@ func MapUpdateUserPartnerPreferencesRequest(operationType string, req dto.Update
 		grpcReq.PreferredHomeDistricts = homeDistricts
 	}
 
+	if req.MinPoruthamScore != nil {
+		grpcReq.MinPoruthamScore = &wrapperspb.Int32Value{Value: int32(*req.MinPoruthamScore)}
+	}
+
+	if req.AcceptDosham != nil {
+		grpcReq.AcceptDosham = &wrapperspb.BoolValue{Value: *req.AcceptDosham}
+	}
+
+	if req.PreferredCountries != nil {
+		grpcReq.PreferredCountries = *req.PreferredCountries
+	}
+
+	if req.PreferredResidenceCountries != nil {
+		grpcReq.PreferredResidenceCountries = *req.PreferredResidenceCountries
+	}
+
+	// A repeated field cannot tell empty from unset, clearing is explicit
+	if req.MustHaveCriteria != nil {
+		grpcReq.MustHaveCriteria = *req.MustHaveCriteria
+		if len(*req.MustHaveCriteria) == 0 {
+			grpcReq.ClearMustHaveCriteria = &wrapperspb.BoolValue{Value: true}
+		}
+	}
+
 	return grpcReq
 }
 
@@ -277,6 +375,12 @@ func MapGetUserPartnerPreferencesResponse(resp *userpbv1.GetUserPartnerPreferenc
 			PreferredProfessionTypes:   pp.PreferredProfessionTypes,
 			PreferredEducationLevels:   pp.PreferredEducationLevels,
 			PreferredHomeDistricts:     pp.PreferredHomeDistricts,
+			MinPoruthamScore:           int(pp.MinPoruthamScore),
+			AcceptDosham:               pp.AcceptDosham,
+
+			PreferredCountries:          pp.PreferredCountries,
+			PreferredResidenceCountries: pp.PreferredResidenceCountries,
+			MustHaveCriteria:            pp.MustHaveCriteria,
 		},
 	}
 }
@@ -300,6 +404,13 @@ func MapRecordMatchActionResponse(resp *userpbv1.RecordMatchActionResponse) *dto
 	}
 }
 
+func MapUndoLastMatchActionResponse(resp *userpbv1.UndoLastMatchActionResponse) *dto.UndoLastMatchActionResponse {
+	return &dto.UndoLastMatchActionResponse{
+		TargetProfileID: resp.GetTargetProfileId(),
+		Action:          resp.GetAction(),
+	}
+}
+
 
 
 ////////////////////////////// Get Match Recommendations //////////////////////////////
@@ -308,6 +419,7 @@ func MapGetMatchRecommendationsRequest(req dto.GetMatchRecommendationsRequest) *
 	return &userpbv1.GetMatchRecommendationsRequest{
 		Limit:  req.Limit,
 		Offset: req.Offset,
+		Cursor: req.Cursor,
 	}
 }
 
@@ -323,6 +435,18 @@ func MapGetMatchRecommendationsResponse(resp *userpbv1.GetMatchRecommendationsRe
 			MaritalStatus:     profile.MaritalStatus,
 			Profession:        profile.Profession,
 			HomeDistrict:      profile.HomeDistrict,
+			IsShortlisted:     profile.IsShortlisted,
+			NativePlace:       mapLocation(profile.GetNativePlace()),
+			Residence:         mapLocation(profile.GetResidence()),
+			IsNRI:             profile.GetIsNri(),
+
+			CompatibilityScore: int(profile.GetCompatibilityScore()),
+			MatchedCriteria:    profile.GetMatchedCriteria(),
+			UnmatchedCriteria:  profile.GetUnmatchedCriteria(),
+
+			MatchesTheirPreferences: profile.GetMatchesTheirPreferences(),
+			PreviouslyPassed:        profile.GetPreviouslyPassed(),
+			ActivityStatus:          profile.GetActivityStatus(),
 		}
 	}
 
@@ -331,6 +455,7 @@ func MapGetMatchRecommendationsResponse(resp *userpbv1.GetMatchRecommendationsRe
 		Limit:      int(resp.Pagination.Limit),
 		Offset:     int(resp.Pagination.Offset),
 		HasMore:    resp.Pagination.HasMore,
+		NextCursor: resp.Pagination.NextCursor,
 	}
 
 	return &dto.GetMatchRecommendationsResponse{
@@ -362,6 +487,9 @@ func MapGetProfilesByMatchActionResponse(resp *userpbv1.GetProfilesByMatchAction
 			MaritalStatus:     profile.MaritalStatus,
 			Profession:        profile.Profession,
 			HomeDistrict:      profile.HomeDistrict,
+			NativePlace:       mapLocation(profile.GetNativePlace()),
+			Residence:         mapLocation(profile.GetResidence()),
+			IsNRI:             profile.GetIsNri(),
 		}
 	}
 
@@ -394,6 +522,8 @@ func MapGetUserDetailsByProfileIDResponse(resp *userpbv1.GetUserDetailsByProfile
 
 	out := &dto.GetUserDetailsByProfileIDResponse{
 		AdditionalPhotoURLs: resp.AdditionalPhotoUrls,
+		Horoscope:           mapHoroscope(resp.GetHoroscope()),
+		Porutham:            mapPoruthamCompatibility(resp.GetPorutham()),
 	}
 
 	if p != nil {
@@ -406,6 +536,9 @@ func MapGetUserDetailsByProfileIDResponse(resp *userpbv1.GetUserDetailsByProfile
 			MaritalStatus:     p.MaritalStatus,
 			Profession:        p.Profession,
 			HomeDistrict:      p.HomeDistrict,
+			NativePlace:       mapLocation(p.GetNativePlace()),
+			Residence:         mapLocation(p.GetResidence()),
+			IsNRI:             p.GetIsNri(),
 		}
 	}
 
@@ -422,10 +555,702 @@ func MapGetUserDetailsByProfileIDResponse(resp *userpbv1.GetUserDetailsByProfile
 			PreferredProfessionTypes:   pp.PreferredProfessionTypes,
 			PreferredEducationLevels:   pp.PreferredEducationLevels,
 			PreferredHomeDistricts:     pp.PreferredHomeDistricts,
+			MinPoruthamScore:           int(pp.MinPoruthamScore),
+			AcceptDosham:               pp.AcceptDosham,
+
+			PreferredCountries:          pp.PreferredCountries,
+			PreferredResidenceCountries: pp.PreferredResidenceCountries,
+			MustHaveCriteria:            pp.MustHaveCriteria,
 		}
 	} else {
 		out.PartnerPreferences = dto.PartnerPreferenceResponse{}
 	}
 
 	return out
-}
\ No newline at end of file
+}
+
+////////////////////////////// Location //////////////////////////////
+
+func toProtoLocation(l *dto.Location) *userpbv1.Location {
+	return &userpbv1.Location{
+		Country:  l.Country,
+		State:    l.State,
+		District: l.District,
+		City:     l.City,
+	}
+}
+
+func mapLocation(l *userpbv1.Location) *dto.Location {
+	if l == nil {
+		return nil
+	}
+	return &dto.Location{
+		Country:  l.Country,
+		State:    l.State,
+		District: l.District,
+		City:     l.City,
+	}
+}
+
+////////////////////////////// Horoscope //////////////////////////////
+
+func mapHoroscope(h *userpbv1.Horoscope) *dto.Horoscope {
+	if h == nil {
+		return nil
+	}
+	horoscope := &dto.Horoscope{
+		BirthTime:  h.BirthTime,
+		BirthPlace: h.BirthPlace,
+		Nakshatra:  h.Nakshatra,
+		Rasi:       h.Rasi,
+	}
+	if h.ChovvaDosham != nil {
+		chovvaDosham := h.ChovvaDosham.GetValue()
+		horoscope.ChovvaDosham = &chovvaDosham
+	}
+	if h.PapaDosham != nil {
+		papaDosham := h.PapaDosham.GetValue()
+		horoscope.PapaDosham = &papaDosham
+	}
+	return horoscope
+}
+
+func mapPoruthamCompatibility(p *userpbv1.PoruthamCompatibility) *dto.PoruthamCompatibility {
+	if p == nil {
+		return nil
+	}
+	compatibility := &dto.PoruthamCompatibility{
+		Score:     p.Score,
+		MaxScore:  p.MaxScore,
+		Matched:   p.MatchedPoruthams,
+		Unmatched: p.UnmatchedPoruthams,
+	}
+	if p.DoshamCompatible != nil {
+		doshamCompatible := p.DoshamCompatible.GetValue()
+		compatibility.DoshamCompatible = &doshamCompatible
+	}
+	return compatibility
+}
+
+////////////////////////////// Block Or Unblock Profile //////////////////////////////
+
+func MapBlockOrUnblockProfileRequest(req dto.BlockOrUnblockProfileRequest) *userpbv1.BlockOrUnblockProfileRequest {
+	return &userpbv1.BlockOrUnblockProfileRequest{
+		TargetProfileId: req.TargetProfileID,
+		ShouldBlock:     req.ShouldBlock,
+	}
+}
+
+func MapBlockOrUnblockProfileResponse(resp *userpbv1.BlockOrUnblockProfileResponse) *dto.BlockOrUnblockProfileResponse {
+	return &dto.BlockOrUnblockProfileResponse{
+		Success: resp.Success,
+	}
+}
+
+////////////////////////////// Abuse Reports //////////////////////////////
+
+func MapReportProfileRequest(req dto.ReportProfileRequest) *userpbv1.ReportProfileRequest {
+	grpcReq := &userpbv1.ReportProfileRequest{
+		TargetProfileId: req.TargetProfileID,
+		TargetType:      req.TargetType,
+		Category:        req.Category,
+		Description:     req.Description,
+	}
+	if req.PhotoDisplayOrder != nil {
+		grpcReq.PhotoDisplayOrder = &wrapperspb.Int32Value{Value: *req.PhotoDisplayOrder}
+	}
+	return grpcReq
+}
+
+func MapReportProfileResponse(resp *userpbv1.ReportProfileResponse) *dto.ReportProfileResponse {
+	return &dto.ReportProfileResponse{
+		ReportID: resp.ReportId,
+	}
+}
+
+func MapGetReportsRequest(req dto.GetReportsRequest) *userpbv1.GetReportsRequest {
+	return &userpbv1.GetReportsRequest{
+		Status: req.Status,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+}
+
+func MapGetReportsResponse(resp *userpbv1.GetReportsResponse) *dto.GetReportsResponse {
+	reports := make([]dto.ReportResponse, 0, len(resp.Reports))
+	for _, report := range resp.Reports {
+		reports = append(reports, mapReportInfo(report))
+	}
+
+	pagination := dto.PaginationInfo{}
+	if resp.Pagination != nil {
+		pagination = dto.PaginationInfo{
+			TotalCount: resp.Pagination.TotalCount,
+			Limit:      int(resp.Pagination.Limit),
+			Offset:     int(resp.Pagination.Offset),
+			HasMore:    resp.Pagination.HasMore,
+		}
+	}
+
+	return &dto.GetReportsResponse{
+		Reports:    reports,
+		Pagination: pagination,
+	}
+}
+
+func MapResolveReportRequest(req dto.ResolveReportRequest) *userpbv1.ResolveReportRequest {
+	return &userpbv1.ResolveReportRequest{
+		ReportId:  req.ReportID,
+		Action:    req.Action,
+		AdminNote: req.AdminNote,
+	}
+}
+
+func MapResolveReportResponse(resp *userpbv1.ResolveReportResponse) *dto.ResolveReportResponse {
+	return &dto.ResolveReportResponse{
+		Report: mapReportInfo(resp.Report),
+	}
+}
+
+func mapReportInfo(report *userpbv1.ReportInfo) dto.ReportResponse {
+	if report == nil {
+		return dto.ReportResponse{}
+	}
+
+	resp := dto.ReportResponse{
+		ID:              report.Id,
+		ReporterUserID:  report.ReporterUserId,
+		TargetUserID:    report.TargetUserId,
+		TargetProfileID: report.TargetProfileId,
+		TargetType:      report.TargetType,
+		MessageID:       report.MessageId,
+		MessageContent:  report.MessageContent,
+		Category:        report.Category,
+		Description:     report.Description,
+		Status:          report.Status,
+		Action:          report.Action,
+		AdminNote:       report.AdminNote,
+	}
+	if report.PhotoDisplayOrder != nil {
+		order := report.PhotoDisplayOrder.Value
+		resp.PhotoDisplayOrder = &order
+	}
+	if report.CreatedAt != nil {
+		resp.CreatedAt = report.CreatedAt.AsTime()
+	}
+	if report.ResolvedAt != nil {
+		resolvedAt := report.ResolvedAt.AsTime()
+		resp.ResolvedAt = &resolvedAt
+	}
+	return resp
+}
+
+////////////////////////////// Photo Review //////////////////////////////
+
+func MapGetPendingPhotosRequest(req dto.GetPendingPhotosRequest) *userpbv1.GetPendingPhotosRequest {
+	return &userpbv1.GetPendingPhotosRequest{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+}
+
+func MapGetPendingPhotosResponse(resp *userpbv1.GetPendingPhotosResponse) *dto.GetPendingPhotosResponse {
+	photos := make([]dto.PendingPhotoResponse, 0, len(resp.Photos))
+	for _, photo := range resp.Photos {
+		item := dto.PendingPhotoResponse{
+			TargetProfileID: photo.TargetProfileId,
+			TargetUserID:    photo.TargetUserId,
+			DisplayOrder:    photo.DisplayOrder,
+			PhotoURL:        photo.PhotoUrl,
+			ObjectKey:       photo.ObjectKey,
+		}
+		if photo.UploadedAt != nil {
+			item.UploadedAt = photo.UploadedAt.AsTime()
+		}
+		photos = append(photos, item)
+	}
+
+	pagination := dto.PaginationInfo{}
+	if resp.Pagination != nil {
+		pagination = dto.PaginationInfo{
+			TotalCount: resp.Pagination.TotalCount,
+			Limit:      int(resp.Pagination.Limit),
+			Offset:     int(resp.Pagination.Offset),
+			HasMore:    resp.Pagination.HasMore,
+		}
+	}
+
+	return &dto.GetPendingPhotosResponse{
+		Photos:     photos,
+		Pagination: pagination,
+	}
+}
+
+func MapReviewPhotoRequest(req dto.ReviewPhotoRequest) *userpbv1.ReviewPhotoRequest {
+	return &userpbv1.ReviewPhotoRequest{
+		TargetProfileId: req.TargetProfileID,
+		DisplayOrder:    req.DisplayOrder,
+		Approve:         req.Approve,
+		Reason:          req.Reason,
+		ObjectKey:       req.ObjectKey,
+	}
+}
+
+func MapReviewPhotoResponse(resp *userpbv1.ReviewPhotoResponse) *dto.ReviewPhotoResponse {
+	return &dto.ReviewPhotoResponse{
+		Success: resp.Success,
+	}
+}
+
+////////////////////////////// Profile History //////////////////////////////
+
+func MapGetProfileHistoryRequest(req dto.GetProfileHistoryRequest) *userpbv1.GetProfileHistoryRequest {
+	return &userpbv1.GetProfileHistoryRequest{
+		ProfileId:   req.ProfileID,
+		Section:     req.Section,
+		FlaggedOnly: req.FlaggedOnly,
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+	}
+}
+
+func MapGetProfileHistoryResponse(resp *userpbv1.GetProfileHistoryResponse) *dto.GetProfileHistoryResponse {
+	changes := make([]dto.ProfileChange, 0, len(resp.GetChanges()))
+	for _, change := range resp.GetChanges() {
+		fieldChanges := make([]dto.ProfileFieldChange, 0, len(change.GetChanges()))
+		for _, fc := range change.GetChanges() {
+			fieldChanges = append(fieldChanges, dto.ProfileFieldChange{
+				Field:    fc.GetField(),
+				OldValue: fc.GetOldValue(),
+				NewValue: fc.GetNewValue(),
+			})
+		}
+
+		changes = append(changes, dto.ProfileChange{
+			ID:          change.GetId(),
+			ProfileID:   change.GetProfileId(),
+			UserID:      change.GetUserId(),
+			Section:     change.GetSection(),
+			Version:     change.GetVersion(),
+			Changes:     fieldChanges,
+			ActorID:     change.GetActorId(),
+			ActorRole:   change.GetActorRole(),
+			IsFlagged:   change.GetIsFlagged(),
+			FlagReasons: change.GetFlagReasons(),
+			CreatedAt:   change.GetCreatedAt().AsTime(),
+		})
+	}
+
+	pagination := dto.PaginationInfo{}
+	if resp.Pagination != nil {
+		pagination = dto.PaginationInfo{
+			TotalCount: resp.Pagination.TotalCount,
+			Limit:      int(resp.Pagination.Limit),
+			Offset:     int(resp.Pagination.Offset),
+			HasMore:    resp.Pagination.HasMore,
+		}
+	}
+
+	return &dto.GetProfileHistoryResponse{
+		Changes:    changes,
+		Pagination: pagination,
+	}
+}
+
+////////////////////////////// Profile Visitors //////////////////////////////
+
+func MapGetProfileVisitorsRequest(req dto.GetProfileVisitorsRequest) *userpbv1.GetProfileVisitorsRequest {
+	return &userpbv1.GetProfileVisitorsRequest{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+}
+
+func MapGetProfileVisitorsResponse(resp *userpbv1.GetProfileVisitorsResponse) *dto.GetProfileVisitorsResponse {
+	visitors := make([]dto.ProfileVisitor, 0, len(resp.Visitors))
+	for _, v := range resp.Visitors {
+		p := v.GetProfile()
+		visitors = append(visitors, dto.ProfileVisitor{
+			Profile: dto.UserProfileRecommendation{
+				ID:                p.GetId(),
+				FullName:          p.GetFullName(),
+				ProfilePictureURL: p.GetProfilePictureUrl(),
+				Age:               int(p.GetAge()),
+				HeightCm:          int(p.GetHeightCm()),
+				MaritalStatus:     p.GetMaritalStatus(),
+				Profession:        p.GetProfession(),
+				HomeDistrict:      p.GetHomeDistrict(),
+				NativePlace:       mapLocation(p.GetNativePlace()),
+				Residence:         mapLocation(p.GetResidence()),
+				IsNRI:             p.GetIsNri(),
+			},
+			LastViewedAt: v.GetLastViewedAt().AsTime(),
+		})
+	}
+
+	pagination := dto.PaginationInfo{}
+	if resp.Pagination != nil {
+		pagination = dto.PaginationInfo{
+			TotalCount: resp.Pagination.TotalCount,
+			Limit:      int(resp.Pagination.Limit),
+			Offset:     int(resp.Pagination.Offset),
+			HasMore:    resp.Pagination.HasMore,
+		}
+	}
+
+	return &dto.GetProfileVisitorsResponse{
+		Visitors:   visitors,
+		Pagination: pagination,
+	}
+}
+
+////////////////////////////// Interests Received //////////////////////////////
+
+func MapGetReceivedInterestsRequest(req dto.GetReceivedInterestsRequest) *userpbv1.GetReceivedInterestsRequest {
+	return &userpbv1.GetReceivedInterestsRequest{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+}
+
+func MapGetReceivedInterestsResponse(resp *userpbv1.GetReceivedInterestsResponse) *dto.GetReceivedInterestsResponse {
+	interests := make([]dto.ReceivedInterest, 0, len(resp.Interests))
+	for _, i := range resp.Interests {
+		p := i.GetProfile()
+		interests = append(interests, dto.ReceivedInterest{
+			Profile: dto.UserProfileRecommendation{
+				ID:                p.GetId(),
+				FullName:          p.GetFullName(),
+				ProfilePictureURL: p.GetProfilePictureUrl(),
+				Age:               int(p.GetAge()),
+				HeightCm:          int(p.GetHeightCm()),
+				MaritalStatus:     p.GetMaritalStatus(),
+				Profession:        p.GetProfession(),
+				HomeDistrict:      p.GetHomeDistrict(),
+				NativePlace:       mapLocation(p.GetNativePlace()),
+				Residence:         mapLocation(p.GetResidence()),
+				IsNRI:             p.GetIsNri(),
+				ActivityStatus:    p.GetActivityStatus(),
+			},
+			ReceivedAt: i.GetReceivedAt().AsTime(),
+			IsNew:      i.GetIsNew(),
+		})
+	}
+
+	pagination := dto.PaginationInfo{}
+	if resp.Pagination != nil {
+		pagination = dto.PaginationInfo{
+			TotalCount: resp.Pagination.TotalCount,
+			Limit:      int(resp.Pagination.Limit),
+			Offset:     int(resp.Pagination.Offset),
+			HasMore:    resp.Pagination.HasMore,
+		}
+	}
+
+	return &dto.GetReceivedInterestsResponse{
+		Interests:  interests,
+		Pagination: pagination,
+	}
+}
+
+func MapGetReceivedInterestCountsResponse(resp *userpbv1.GetReceivedInterestCountsResponse) *dto.GetReceivedInterestCountsResponse {
+	return &dto.GetReceivedInterestCountsResponse{
+		PendingCount: resp.GetPendingCount(),
+		UnreadCount:  resp.GetUnreadCount(),
+	}
+}
+
+func MapRespondToInterestRequest(req dto.RespondToInterestRequest) *userpbv1.RespondToInterestRequest {
+	return &userpbv1.RespondToInterestRequest{
+		SenderProfileId: req.SenderProfileID,
+		Accept:          req.Accept,
+	}
+}
+
+////////////////////////////// Shortlist //////////////////////////////
+
+func MapAddToShortlistRequest(req dto.AddToShortlistRequest) *userpbv1.AddToShortlistRequest {
+	return &userpbv1.AddToShortlistRequest{
+		TargetProfileId: req.TargetProfileID,
+		Note:            req.Note,
+	}
+}
+
+func MapRemoveFromShortlistRequest(req dto.RemoveFromShortlistRequest) *userpbv1.RemoveFromShortlistRequest {
+	return &userpbv1.RemoveFromShortlistRequest{
+		TargetProfileId: req.TargetProfileID,
+	}
+}
+
+func MapGetShortlistRequest(req dto.GetShortlistRequest) *userpbv1.GetShortlistRequest {
+	return &userpbv1.GetShortlistRequest{
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}
+}
+
+func MapGetShortlistResponse(resp *userpbv1.GetShortlistResponse) *dto.GetShortlistResponse {
+	profiles := make([]dto.ShortlistedProfile, 0, len(resp.Profiles))
+	for _, entry := range resp.Profiles {
+		p := entry.GetProfile()
+		profiles = append(profiles, dto.ShortlistedProfile{
+			Profile: dto.UserProfileRecommendation{
+				ID:                p.GetId(),
+				FullName:          p.GetFullName(),
+				ProfilePictureURL: p.GetProfilePictureUrl(),
+				Age:               int(p.GetAge()),
+				HeightCm:          int(p.GetHeightCm()),
+				MaritalStatus:     p.GetMaritalStatus(),
+				Profession:        p.GetProfession(),
+				HomeDistrict:      p.GetHomeDistrict(),
+				IsShortlisted:     p.GetIsShortlisted(),
+				NativePlace:       mapLocation(p.GetNativePlace()),
+				Residence:         mapLocation(p.GetResidence()),
+				IsNRI:             p.GetIsNri(),
+			},
+			Note:          entry.Note,
+			ShortlistedAt: entry.GetShortlistedAt().AsTime(),
+		})
+	}
+
+	pagination := dto.PaginationInfo{}
+	if resp.Pagination != nil {
+		pagination = dto.PaginationInfo{
+			TotalCount: resp.Pagination.TotalCount,
+			Limit:      int(resp.Pagination.Limit),
+			Offset:     int(resp.Pagination.Offset),
+			HasMore:    resp.Pagination.HasMore,
+		}
+	}
+
+	return &dto.GetShortlistResponse{
+		Profiles:   profiles,
+		Pagination: pagination,
+	}
+}
+
+////////////////////////////// Search //////////////////////////////
+
+func mapSearchFiltersToProto(f dto.SearchFilters) *userpbv1.SearchFilters {
+	filters := &userpbv1.SearchFilters{
+		Communities:        f.Communities,
+		MaritalStatuses:    f.MaritalStatuses,
+		Professions:        f.Professions,
+		ProfessionTypes:    f.ProfessionTypes,
+		EducationLevels:    f.EducationLevels,
+		HomeDistricts:      f.HomeDistricts,
+		NativeCountries:    f.NativeCountries,
+		ResidenceCountries: f.ResidenceCountries,
+		HasPhoto:           f.HasPhoto,
+		VerifiedOnly:       f.VerifiedOnly,
+		ActiveWithinDays:   f.ActiveWithinDays,
+		Sort:               f.Sort,
+	}
+	if f.MinAgeYears != nil {
+		filters.MinAgeYears = wrapperspb.Int32(*f.MinAgeYears)
+	}
+	if f.MaxAgeYears != nil {
+		filters.MaxAgeYears = wrapperspb.Int32(*f.MaxAgeYears)
+	}
+	if f.MinHeightCm != nil {
+		filters.MinHeightCm = wrapperspb.Int32(*f.MinHeightCm)
+	}
+	if f.MaxHeightCm != nil {
+		filters.MaxHeightCm = wrapperspb.Int32(*f.MaxHeightCm)
+	}
+	if f.PhysicallyChallenged != nil {
+		filters.PhysicallyChallenged = wrapperspb.Bool(*f.PhysicallyChallenged)
+	}
+	return filters
+}
+
+func mapSearchFilters(f *userpbv1.SearchFilters) dto.SearchFilters {
+	if f == nil {
+		return dto.SearchFilters{}
+	}
+	filters := dto.SearchFilters{
+		Communities:        f.GetCommunities(),
+		MaritalStatuses:    f.GetMaritalStatuses(),
+		Professions:        f.GetProfessions(),
+		ProfessionTypes:    f.GetProfessionTypes(),
+		EducationLevels:    f.GetEducationLevels(),
+		HomeDistricts:      f.GetHomeDistricts(),
+		NativeCountries:    f.GetNativeCountries(),
+		ResidenceCountries: f.GetResidenceCountries(),
+		HasPhoto:           f.GetHasPhoto(),
+		VerifiedOnly:       f.GetVerifiedOnly(),
+		ActiveWithinDays:   f.GetActiveWithinDays(),
+		Sort:               f.GetSort(),
+	}
+	if f.MinAgeYears != nil {
+		filters.MinAgeYears = &f.MinAgeYears.Value
+	}
+	if f.MaxAgeYears != nil {
+		filters.MaxAgeYears = &f.MaxAgeYears.Value
+	}
+	if f.MinHeightCm != nil {
+		filters.MinHeightCm = &f.MinHeightCm.Value
+	}
+	if f.MaxHeightCm != nil {
+		filters.MaxHeightCm = &f.MaxHeightCm.Value
+	}
+	if f.PhysicallyChallenged != nil {
+		filters.PhysicallyChallenged = &f.PhysicallyChallenged.Value
+	}
+	return filters
+}
+
+func mapSavedSearch(s *userpbv1.SavedSearch) dto.SavedSearch {
+	if s == nil {
+		return dto.SavedSearch{}
+	}
+	return dto.SavedSearch{
+		ID:            s.GetId(),
+		Name:          s.GetName(),
+		Filters:       mapSearchFilters(s.GetFilters()),
+		AlertsEnabled: s.GetAlertsEnabled(),
+		CreatedAt:     s.GetCreatedAt().AsTime(),
+	}
+}
+
+func MapSearchProfilesRequest(req dto.SearchProfilesRequest) *userpbv1.SearchProfilesRequest {
+	return &userpbv1.SearchProfilesRequest{
+		Filters: mapSearchFiltersToProto(req.Filters),
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+	}
+}
+
+func MapSearchProfilesResponse(resp *userpbv1.SearchProfilesResponse) *dto.SearchProfilesResponse {
+	profiles := make([]dto.UserProfileRecommendation, 0, len(resp.Profiles))
+	for _, p := range resp.Profiles {
+		profiles = append(profiles, dto.UserProfileRecommendation{
+			ID:                p.GetId(),
+			FullName:          p.GetFullName(),
+			ProfilePictureURL: p.GetProfilePictureUrl(),
+			Age:               int(p.GetAge()),
+			HeightCm:          int(p.GetHeightCm()),
+			MaritalStatus:     p.GetMaritalStatus(),
+			Profession:        p.GetProfession(),
+			HomeDistrict:      p.GetHomeDistrict(),
+			IsShortlisted:     p.GetIsShortlisted(),
+			NativePlace:       mapLocation(p.GetNativePlace()),
+			Residence:         mapLocation(p.GetResidence()),
+			IsNRI:             p.GetIsNri(),
+			ActivityStatus:    p.GetActivityStatus(),
+		})
+	}
+
+	pagination := dto.PaginationInfo{}
+	if resp.Pagination != nil {
+		pagination = dto.PaginationInfo{
+			TotalCount: resp.Pagination.TotalCount,
+			Limit:      int(resp.Pagination.Limit),
+			Offset:     int(resp.Pagination.Offset),
+			HasMore:    resp.Pagination.HasMore,
+		}
+	}
+
+	return &dto.SearchProfilesResponse{
+		Profiles:   profiles,
+		Pagination: pagination,
+	}
+}
+
+func MapCreateSavedSearchRequest(req dto.CreateSavedSearchRequest) *userpbv1.CreateSavedSearchRequest {
+	return &userpbv1.CreateSavedSearchRequest{
+		Name:          req.Name,
+		Filters:       mapSearchFiltersToProto(req.Filters),
+		AlertsEnabled: req.AlertsEnabled,
+	}
+}
+
+func MapGetSavedSearchesResponse(resp *userpbv1.GetSavedSearchesResponse) *dto.GetSavedSearchesResponse {
+	savedSearches := make([]dto.SavedSearch, 0, len(resp.SavedSearches))
+	for _, s := range resp.SavedSearches {
+		savedSearches = append(savedSearches, mapSavedSearch(s))
+	}
+	return &dto.GetSavedSearchesResponse{SavedSearches: savedSearches}
+}
+
+////////////////////////////// Reference Data //////////////////////////////
+
+func mapReferenceOption(option *userpbv1.ReferenceOption) dto.ReferenceOption {
+	if option == nil {
+		return dto.ReferenceOption{}
+	}
+	return dto.ReferenceOption{
+		ID:           option.Id,
+		Taxonomy:     option.Taxonomy,
+		Value:        option.Value,
+		Label:        option.Label,
+		DisplayOrder: option.DisplayOrder,
+		IsActive:     option.IsActive,
+	}
+}
+
+func MapGetReferenceOptionsRequest(req dto.GetReferenceOptionsRequest) *userpbv1.GetReferenceOptionsRequest {
+	return &userpbv1.GetReferenceOptionsRequest{
+		Taxonomy:        req.Taxonomy,
+		IncludeInactive: req.IncludeInactive,
+	}
+}
+
+func MapGetReferenceOptionsResponse(resp *userpbv1.GetReferenceOptionsResponse) *dto.GetReferenceOptionsResponse {
+	options := make([]dto.ReferenceOption, 0, len(resp.Options))
+	for _, option := range resp.Options {
+		options = append(options, mapReferenceOption(option))
+	}
+	return &dto.GetReferenceOptionsResponse{
+		Options: options,
+	}
+}
+
+func MapCreateReferenceOptionRequest(req dto.CreateReferenceOptionRequest) *userpbv1.CreateReferenceOptionRequest {
+	return &userpbv1.CreateReferenceOptionRequest{
+		Taxonomy:     req.Taxonomy,
+		Value:        req.Value,
+		Label:        req.Label,
+		DisplayOrder: req.DisplayOrder,
+	}
+}
+
+func MapCreateReferenceOptionResponse(resp *userpbv1.CreateReferenceOptionResponse) *dto.ReferenceOptionResponse {
+	return &dto.ReferenceOptionResponse{
+		Option: mapReferenceOption(resp.Option),
+	}
+}
+
+func MapUpdateReferenceOptionRequest(req dto.UpdateReferenceOptionRequest) *userpbv1.UpdateReferenceOptionRequest {
+	grpcReq := &userpbv1.UpdateReferenceOptionRequest{
+		OptionId: req.OptionID,
+	}
+	if req.Label != nil {
+		grpcReq.Label = wrapperspb.String(*req.Label)
+	}
+	if req.DisplayOrder != nil {
+		grpcReq.DisplayOrder = wrapperspb.Int32(*req.DisplayOrder)
+	}
+	if req.IsActive != nil {
+		grpcReq.IsActive = wrapperspb.Bool(*req.IsActive)
+	}
+	return grpcReq
+}
+
+func MapUpdateReferenceOptionResponse(resp *userpbv1.UpdateReferenceOptionResponse) *dto.ReferenceOptionResponse {
+	return &dto.ReferenceOptionResponse{
+		Option: mapReferenceOption(resp.Option),
+	}
+}
+
+func MapDeleteReferenceOptionRequest(req dto.DeleteReferenceOptionRequest) *userpbv1.DeleteReferenceOptionRequest {
+	return &userpbv1.DeleteReferenceOptionRequest{
+		OptionId: req.OptionID,
+	}
+}
+
+func MapDeleteReferenceOptionResponse(resp *userpbv1.DeleteReferenceOptionResponse) *dto.DeleteReferenceOptionResponse {
+	return &dto.DeleteReferenceOptionResponse{
+		Success: resp.Success,
+	}
+}
@@ -10,7 +10,7 @@ type UserClient interface {
 	///////// USER PROFILE MANAGEMENT //////////
 	UpdateUserProfile(ctx context.Context,
 		req dto.UserProfilePatchRequest) error
-	GetUserProfile(ctx context.Context) (*dto.UserProfileRecommendation, error)
+	GetUserProfile(ctx context.Context) (*dto.GetUserProfileResponse, error)
 	GetProfilePhotoUploadURL(ctx context.Context,
 		req dto.GetProfilePhotoUploadURLRequest) (*dto.GetProfilePhotoUploadURLResponse, error)
 	ConfirmProfilePhotoUpload(ctx context.Context,
@@ -25,6 +25,10 @@ type UserClient interface {
 	DeleteAdditionalPhoto(ctx context.Context,
 		req dto.DeleteAdditionalPhotoRequest) (*dto.DeleteAdditionalPhotoResponse, error)
 	GetAdditionalPhotos(ctx context.Context) (*dto.GetAdditionalPhotosResponse, error)
+	ReorderAdditionalPhotos(ctx context.Context,
+		req dto.ReorderAdditionalPhotosRequest) (*dto.ReorderAdditionalPhotosResponse, error)
+	SetPrimaryPhoto(ctx context.Context,
+		req dto.SetPrimaryPhotoRequest) (*dto.SetPrimaryPhotoResponse, error)
 	///////// PARTNER PREFERENCES MANAGEMENT //////////
 	UpdateUserPartnerPreferences(ctx context.Context,
 		operationType string, req dto.UpdatePartnerPreferenceRequest) error
@@ -32,12 +36,73 @@ type UserClient interface {
 	///////// MATCH MAKING //////////
 	RecordMatchAction(ctx context.Context,
 		req dto.RecordMatchActionRequest) (*dto.RecordMatchActionResponse, error)
+	UndoLastMatchAction(ctx context.Context) (*dto.UndoLastMatchActionResponse, error)
 	GetMatchRecommendations(ctx context.Context,
 		req dto.GetMatchRecommendationsRequest) (*dto.GetMatchRecommendationsResponse, error)
 	GetProfilesByMatchAction(ctx context.Context,
 		req dto.GetProfilesByMatchActionRequest) (*dto.GetProfilesByMatchActionResponse, error)
+	GetReceivedInterests(ctx context.Context,
+		req dto.GetReceivedInterestsRequest) (*dto.GetReceivedInterestsResponse, error)
+	GetReceivedInterestCounts(ctx context.Context) (*dto.GetReceivedInterestCountsResponse, error)
+	RespondToInterest(ctx context.Context,
+		req dto.RespondToInterestRequest) (*dto.RespondToInterestResponse, error)
 
 	///////// USER DETAILS MANAGEMENT //////////
 	GetUserDetailsByProfileID(ctx context.Context,
 		req dto.GetUserDetailsByProfileIDRequest) (*dto.GetUserDetailsByProfileIDResponse, error)
+
+	///////// USER BLOCKING //////////
+	BlockOrUnblockProfile(ctx context.Context,
+		req dto.BlockOrUnblockProfileRequest) (*dto.BlockOrUnblockProfileResponse, error)
+
+	///////// ABUSE REPORTS //////////
+	ReportProfile(ctx context.Context,
+		req dto.ReportProfileRequest) (*dto.ReportProfileResponse, error)
+	GetReports(ctx context.Context,
+		req dto.GetReportsRequest) (*dto.GetReportsResponse, error)
+	GetReport(ctx context.Context,
+		reportID int64) (*dto.ReportResponse, error)
+	ResolveReport(ctx context.Context,
+		req dto.ResolveReportRequest) (*dto.ResolveReportResponse, error)
+
+	///////// PHOTO REVIEW //////////
+	GetPendingPhotos(ctx context.Context,
+		req dto.GetPendingPhotosRequest) (*dto.GetPendingPhotosResponse, error)
+	ReviewPhoto(ctx context.Context,
+		req dto.ReviewPhotoRequest) (*dto.ReviewPhotoResponse, error)
+
+	///////// PROFILE HISTORY //////////
+	GetProfileHistory(ctx context.Context,
+		req dto.GetProfileHistoryRequest) (*dto.GetProfileHistoryResponse, error)
+
+	///////// PROFILE VIEWS //////////
+	GetProfileVisitors(ctx context.Context,
+		req dto.GetProfileVisitorsRequest) (*dto.GetProfileVisitorsResponse, error)
+
+	///////// SHORTLIST //////////
+	AddToShortlist(ctx context.Context,
+		req dto.AddToShortlistRequest) (*dto.ShortlistResponse, error)
+	RemoveFromShortlist(ctx context.Context,
+		req dto.RemoveFromShortlistRequest) (*dto.ShortlistResponse, error)
+	GetShortlist(ctx context.Context,
+		req dto.GetShortlistRequest) (*dto.GetShortlistResponse, error)
+
+	///////// SEARCH //////////
+	SearchProfiles(ctx context.Context,
+		req dto.SearchProfilesRequest) (*dto.SearchProfilesResponse, error)
+	CreateSavedSearch(ctx context.Context,
+		req dto.CreateSavedSearchRequest) (*dto.CreateSavedSearchResponse, error)
+	GetSavedSearches(ctx context.Context) (*dto.GetSavedSearchesResponse, error)
+	DeleteSavedSearch(ctx context.Context,
+		req dto.DeleteSavedSearchRequest) (*dto.DeleteSavedSearchResponse, error)
+
+	///////// REFERENCE DATA //////////
+	GetReferenceOptions(ctx context.Context,
+		req dto.GetReferenceOptionsRequest) (*dto.GetReferenceOptionsResponse, error)
+	CreateReferenceOption(ctx context.Context,
+		req dto.CreateReferenceOptionRequest) (*dto.ReferenceOptionResponse, error)
+	UpdateReferenceOption(ctx context.Context,
+		req dto.UpdateReferenceOptionRequest) (*dto.ReferenceOptionResponse, error)
+	DeleteReferenceOption(ctx context.Context,
+		req dto.DeleteReferenceOptionRequest) (*dto.DeleteReferenceOptionResponse, error)
 }
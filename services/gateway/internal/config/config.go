@@ -2,6 +2,7 @@ package config
 
 import (
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -14,6 +15,8 @@ type Config struct {
 	OTP             OTPConfig      `mapstructure:"otp"`
 	BaseURL         string         `mapstructure:"base_url"`
 	DefaultTimezone string         `mapstructure:"default_timezone"`
+
+	ReferenceData ReferenceDataConfig `mapstructure:"reference_data"`
 	// Tracing config @TODO: add tracing config
 }
 
@@ -46,6 +49,12 @@ type JWTConfig struct {
 	Issuer             string `mapstructure:"issuer"`
 }
 
+// ReferenceDataConfig controls how often the admin-managed profile options
+// are reloaded from the user service for request validation.
+type ReferenceDataConfig struct {
+	CacheRefreshInterval time.Duration `mapstructure:"cache_refresh_interval"`
+}
+
 type OTPConfig struct {
 	Length int `mapstructure:"length"`
 }
@@ -100,6 +109,7 @@ func bindEnvVars(v *viper.Viper) {
 		"otp.length",
 		"base_url",
 		"default_timezone",
+		"reference_data.cache_refresh_interval",
 	}
 	for _, key := range keys {
 		_ = v.BindEnv(key)
@@ -130,4 +140,5 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("otp.length", 6)
 	v.SetDefault("base_url", "http://localhost:8080")
 	v.SetDefault("default_timezone", "Asia/Kolkata")
+	v.SetDefault("reference_data.cache_refresh_interval", "5m")
 }
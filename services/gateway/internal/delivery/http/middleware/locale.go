@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/client"
+)
+
+const profileLocaleLookupTimeout = 2 * time.Second
+
+// LocaleMiddleware resolves the language of the request from Accept-Language.
+// It is only set when the client asked for a supported language, so services
+// can fall back to the language saved on the profile. Without one, responses
+// use the profile language of the signed in user, fetched from the user
+// service the first time a response needs it.
+func LocaleMiddleware(userClient client.UserClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if locale, ok := i18n.FromAcceptLanguage(c.GetHeader(constants.HeaderAcceptLanguage)); ok {
+			c.Set(constants.ContextKeyLocale, string(locale))
+			c.Writer.Header().Set("Content-Language", string(locale))
+		} else if userClient != nil {
+			var once sync.Once
+			var profileLocale string
+			c.Set(constants.ContextKeyProfileLocale, func() string {
+				once.Do(func() {
+					profileLocale = lookupProfileLocale(c, userClient)
+				})
+				return profileLocale
+			})
+		}
+
+		c.Next()
+	}
+}
+
+// lookupProfileLocale returns the language saved on the signed in user's
+// profile, empty for guests, admins and when the lookup fails.
+func lookupProfileLocale(c *gin.Context, userClient client.UserClient) string {
+	role := c.GetString(constants.ContextKeyRole)
+	if role != constants.RoleUser && role != constants.RolePremiumUser {
+		return ""
+	}
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		return ""
+	}
+
+	ctx, cancel := context.WithTimeout(authCtx.Ctx, profileLocaleLookupTimeout)
+	defer cancel()
+	profile, err := userClient.GetUserProfile(ctx)
+	if err != nil {
+		return ""
+	}
+	return profile.PreferredLanguage
+}
@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/usecase"
+	"go.uber.org/zap"
+)
+
+type AdminHandler struct {
+	adminUsecase usecase.AdminUsecase
+	logger       *zap.Logger
+}
+
+func NewAdminHandler(adminUsecase usecase.AdminUsecase,
+	logger *zap.Logger) *AdminHandler {
+
+	return &AdminHandler{
+		adminUsecase: adminUsecase,
+		logger:       logger,
+	}
+}
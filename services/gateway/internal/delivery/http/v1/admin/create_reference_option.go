@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Create reference option (admin)
+// @Description Add an option to a profile taxonomy. The value is stored on profiles and cannot be changed later, only the label, display order and active flag can.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param create_reference_option_request body dto.CreateReferenceOptionRequest true "Option"
+// @Success 200 {object} dto.ReferenceOptionResponse "Option created"
+// @Failure 400 {object} dto.BadRequestError "Bad request - validation errors"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenError "Forbidden"
+// @Failure 409 {object} dto.ConflictError "Option already exists"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/reference-data [post]
+func (h *AdminHandler) CreateReferenceOption(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	var req dto.CreateReferenceOptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiresponse.Error(c, apperrors.ErrBindingJSON, nil)
+		return
+	}
+
+	resp, err := h.adminUsecase.CreateReferenceOption(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to create reference option", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Reference option created successfully",
+		zap.String("taxonomy", req.Taxonomy),
+		zap.String("value", req.Value))
+	apiresponse.Success(c, "Reference option created successfully", resp)
+}
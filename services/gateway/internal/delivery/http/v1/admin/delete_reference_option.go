@@ -0,0 +1,57 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Delete reference option (admin)
+// @Description Remove an option from a profile taxonomy. Profiles that already use the value keep it; deactivating the option is usually preferable.
+// @Tags Admin
+// @Produce json
+// @Param option_id path int true "Option ID"
+// @Success 200 {object} dto.DeleteReferenceOptionResponse "Option deleted"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenError "Forbidden"
+// @Failure 404 {object} dto.NotFoundError "Option not found"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/reference-data/{option_id} [delete]
+func (h *AdminHandler) DeleteReferenceOption(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	optionID, err := strconv.ParseInt(c.Param("option_id"), 10, 64)
+	if err != nil || optionID <= 0 {
+		apiresponse.Error(c, apperrors.ErrReferenceOptionNotFound, nil)
+		return
+	}
+
+	req := dto.DeleteReferenceOptionRequest{OptionID: optionID}
+	resp, err := h.adminUsecase.DeleteReferenceOption(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to delete reference option", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Reference option deleted successfully", zap.Int64("option_id", optionID))
+	apiresponse.Success(c, "Reference option deleted successfully", resp)
+}
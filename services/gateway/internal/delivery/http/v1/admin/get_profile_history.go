@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Get profile change history (admin)
+// @Description List versioned changes of profiles and partner preferences, latest first. Changes that repeatedly edit the date of birth, marital status or gender are flagged.
+// @Tags Admin
+// @Produce json
+// @Param profile_id query int false "Profile ID, omit to list every profile"
+// @Param section query string false "Section (profile, partner_preferences)"
+// @Param flagged_only query bool false "Only flagged changes" default(false)
+// @Param limit query int false "Items per page (1-50)" minimum(1) maximum(50)
+// @Param offset query int false "Offset (>= 0)" minimum(0)
+// @Success 200 {object} dto.GetProfileHistoryResponse "Profile history"
+// @Failure 400 {object} dto.BadRequestError "Bad request - validation errors"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenError "Forbidden"
+// @Failure 404 {object} dto.NotFoundError "Profile not found"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/profile-history [get]
+func (h *AdminHandler) GetProfileHistory(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	limit64, err := strconv.ParseInt(c.DefaultQuery("limit", "10"), 10, 32)
+	if err != nil || limit64 < 1 || limit64 > 50 {
+		apiresponse.Error(c, apperrors.ErrInvalidPaginationLimit, nil)
+		return
+	}
+	offset64, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 32)
+	if err != nil || offset64 < 0 {
+		apiresponse.Error(c, apperrors.ErrInvalidPaginationPage, nil)
+		return
+	}
+	profileID, err := strconv.ParseInt(c.DefaultQuery("profile_id", "0"), 10, 64)
+	if err != nil {
+		apiresponse.Error(c, apperrors.ErrInvalidTargetProfileID, nil)
+		return
+	}
+	flaggedOnly, err := strconv.ParseBool(c.DefaultQuery("flagged_only", "false"))
+	if err != nil {
+		apiresponse.Error(c, apperrors.ErrInvalidInput, nil)
+		return
+	}
+
+	req := dto.GetProfileHistoryRequest{
+		ProfileID:   profileID,
+		Section:     c.Query("section"),
+		FlaggedOnly: flaggedOnly,
+		Limit:       int32(limit64),
+		Offset:      int32(offset64),
+	}
+
+	resp, err := h.adminUsecase.GetProfileHistory(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to get profile history", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Profile history retrieved successfully", zap.Int64("profile_id", req.ProfileID))
+	apiresponse.Success(c, "Profile history retrieved successfully", resp)
+}
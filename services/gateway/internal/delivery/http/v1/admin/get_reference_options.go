@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Get reference options (admin)
+// @Description List the options of the profile taxonomies, inactive ones included.
+// @Tags Admin
+// @Produce json
+// @Param taxonomy query string false "Taxonomy (community, marital_status, profession, profession_type, education_level); empty lists all"
+// @Success 200 {object} dto.GetReferenceOptionsResponse "Reference options"
+// @Failure 400 {object} dto.BadRequestError "Bad request - invalid taxonomy"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenError "Forbidden"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/reference-data [get]
+func (h *AdminHandler) GetReferenceOptions(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	req := dto.GetReferenceOptionsRequest{
+		Taxonomy: c.Query("taxonomy"),
+	}
+
+	resp, err := h.adminUsecase.GetReferenceOptions(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to get reference options", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Reference options retrieved successfully", zap.String("taxonomy", req.Taxonomy))
+	apiresponse.Success(c, "Reference options retrieved successfully", resp)
+}
@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Get reports (admin)
+// @Description List abuse reports for the moderation queue. Pending reports are returned oldest first.
+// @Tags Admin
+// @Produce json
+// @Param status query string false "Report status (pending, dismissed, actioned)" default(pending)
+// @Param limit query int false "Items per page (1-50)" minimum(1) maximum(50)
+// @Param offset query int false "Offset (>= 0)" minimum(0)
+// @Success 200 {object} dto.GetReportsResponse "Reports list"
+// @Failure 400 {object} dto.BadRequestError "Bad request - validation errors"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenError "Forbidden"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/reports [get]
+func (h *AdminHandler) GetReports(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	limitStr := c.DefaultQuery("limit", "10")
+	offsetStr := c.DefaultQuery("offset", "0")
+
+	limit64, err := strconv.ParseInt(limitStr, 10, 32)
+	if err != nil || limit64 < 1 || limit64 > 50 {
+		apiresponse.Error(c, apperrors.ErrInvalidPaginationLimit, nil)
+		return
+	}
+	offset64, err := strconv.ParseInt(offsetStr, 10, 32)
+	if err != nil || offset64 < 0 {
+		apiresponse.Error(c, apperrors.ErrInvalidPaginationPage, nil)
+		return
+	}
+
+	req := dto.GetReportsRequest{
+		Status: c.DefaultQuery("status", constants.ReportStatusPending),
+		Limit:  int32(limit64),
+		Offset: int32(offset64),
+	}
+
+	resp, err := h.adminUsecase.GetReports(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to get reports", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Reports retrieved successfully", zap.String("status", req.Status))
+	apiresponse.Success(c, "Reports retrieved successfully", resp)
+}
@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Resolve report (admin)
+// @Description Take a moderation action on a pending report: dismiss, warn, remove_photo or suspend
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param report_id path int true "Report ID"
+// @Param resolve_report_request body dto.ResolveReportRequest true "Moderation action"
+// @Success 200 {object} dto.ResolveReportResponse "Resolved report"
+// @Failure 400 {object} dto.BadRequestError "Bad request - validation errors"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenError "Forbidden"
+// @Failure 404 {object} dto.NotFoundError "Report not found"
+// @Failure 409 {object} dto.ConflictError "Report already resolved"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/reports/{report_id}/action [post]
+func (h *AdminHandler) ResolveReport(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	reportID, err := strconv.ParseInt(c.Param("report_id"), 10, 64)
+	if err != nil || reportID <= 0 {
+		apiresponse.Error(c, apperrors.ErrReportNotFound, nil)
+		return
+	}
+
+	var req dto.ResolveReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiresponse.Error(c, apperrors.ErrBindingJSON, nil)
+		return
+	}
+	req.ReportID = reportID
+
+	resp, err := h.adminUsecase.ResolveReport(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to resolve report", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Report resolved successfully",
+		zap.Int64("report_id", reportID),
+		zap.String("action", req.Action))
+	apiresponse.Success(c, "Report resolved successfully", resp)
+}
@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Review photo (admin)
+// @Description Approve or reject a photo pending review. Approved photos become visible to other users, rejected photos are removed and the user is emailed the reason.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param review_photo_request body dto.ReviewPhotoRequest true "Review decision"
+// @Success 200 {object} dto.ReviewPhotoResponse "Photo reviewed"
+// @Failure 400 {object} dto.BadRequestError "Bad request - validation errors"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenError "Forbidden"
+// @Failure 404 {object} dto.NotFoundError "Profile or photo not found"
+// @Failure 409 {object} dto.ConflictError "Photo already reviewed"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/photos/review [post]
+func (h *AdminHandler) ReviewPhoto(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	var req dto.ReviewPhotoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiresponse.Error(c, apperrors.ErrBindingJSON, nil)
+		return
+	}
+
+	resp, err := h.adminUsecase.ReviewPhoto(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to review photo", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Photo reviewed successfully",
+		zap.Int64("target_profile_id", req.TargetProfileID),
+		zap.Int32("display_order", req.DisplayOrder),
+		zap.Bool("approved", req.Approve))
+	apiresponse.Success(c, "Photo reviewed successfully", resp)
+}
@@ -0,0 +1,66 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Update reference option (admin)
+// @Description Change the label, display order or active flag of an option. Inactive options are hidden from the option lists and rejected for new profile values.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param option_id path int true "Option ID"
+// @Param update_reference_option_request body dto.UpdateReferenceOptionRequest true "Fields to change"
+// @Success 200 {object} dto.ReferenceOptionResponse "Option updated"
+// @Failure 400 {object} dto.BadRequestError "Bad request - validation errors"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenError "Forbidden"
+// @Failure 404 {object} dto.NotFoundError "Option not found"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/admin/reference-data/{option_id} [patch]
+func (h *AdminHandler) UpdateReferenceOption(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	optionID, err := strconv.ParseInt(c.Param("option_id"), 10, 64)
+	if err != nil || optionID <= 0 {
+		apiresponse.Error(c, apperrors.ErrReferenceOptionNotFound, nil)
+		return
+	}
+
+	var req dto.UpdateReferenceOptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiresponse.Error(c, apperrors.ErrBindingJSON, nil)
+		return
+	}
+	req.OptionID = optionID
+
+	resp, err := h.adminUsecase.UpdateReferenceOption(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to update reference option", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Reference option updated successfully", zap.Int64("option_id", optionID))
+	apiresponse.Success(c, "Reference option updated successfully", resp)
+}
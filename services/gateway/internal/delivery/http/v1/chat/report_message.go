@@ -0,0 +1,55 @@
+package chat
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Report message
+// @Description Report an abusive or inappropriate chat message for admin review
+// @Tags Chat
+// @Accept json
+// @Produce json
+// @Param report_message body dto.ReportMessageRequest true "Report details"
+// @Success 200 {object} dto.ReportMessageResponse "Message reported"
+// @Failure 400 {object} dto.BadRequestError "Bad request - validation errors"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 404 {object} dto.NotFoundError "Message not found"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/chat/report-message [post]
+func (h *ChatHandler) ReportMessage(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	var req dto.ReportMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiresponse.Error(c, apperrors.ErrBindingJSON, nil)
+		return
+	}
+
+	response, err := h.chatUsecase.ReportMessage(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("failed to report message", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("message reported successfully", zap.String("message_id", req.MessageID))
+	apiresponse.Success(c, "Message reported successfully", response)
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
 )
 
@@ -20,6 +21,7 @@ func (h *PaymentHandler) ShowFailurePage(c *gin.Context) {
 	failureData := dto.PaymentFailureData{
 		OrderID: c.Query("order_id"),
 		Error:   c.Query("error"),
+		Locale:  string(apiresponse.RequestLocale(c)),
 	}
 
 	c.HTML(http.StatusOK, "payment_failed.html", failureData)
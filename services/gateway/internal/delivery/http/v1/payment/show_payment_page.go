@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
 	// "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
@@ -60,5 +61,6 @@ func (h *PaymentHandler) ShowPaymentPage(c *gin.Context) {
 	}
 
 	log.Info("Payment page data is retrieved", zap.String("razorpay_order_id", razorpayOrderID))
+	resp.Locale = string(apiresponse.RequestLocale(c))
 	c.HTML(http.StatusOK, "payment.html", resp)
 }
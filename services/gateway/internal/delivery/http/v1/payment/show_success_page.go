@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
 )
 
@@ -28,6 +29,7 @@ func (h *PaymentHandler) ShowSuccessPage(c *gin.Context) {
 		SubscriptionStartDate: startDate,
 		SubscriptionEndDate:   endDate,
 		SubscriptionStatus:    status,
+		Locale:                string(apiresponse.RequestLocale(c)),
 	}
 
 	c.HTML(http.StatusOK, "payment_success.html", successData)
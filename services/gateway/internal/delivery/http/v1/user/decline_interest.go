@@ -0,0 +1,62 @@
+package user
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Decline interest
+// @Description Pass on a user who liked the authenticated user, removing the interest from the inbox
+// @Tags User
+// @Produce json
+// @Param profile_id path int true "Sender profile ID"
+// @Success 200 {object} dto.RespondToInterestResponse "Result"
+// @Failure 400 {object} dto.BadRequestError "Bad request - validation errors"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 403 {object} dto.ForbiddenError "Profile blocked"
+// @Failure 404 {object} dto.NotFoundError "Interest not pending"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/user/interests/received/{profile_id}/decline [post]
+func (h *UserHandler) DeclineInterest(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	senderID, err := strconv.ParseInt(c.Param("profile_id"), 10, 64)
+	if err != nil || senderID <= 0 {
+		apiresponse.Error(c, apperrors.ErrInvalidTargetProfileID, nil)
+		return
+	}
+
+	req := dto.RespondToInterestRequest{
+		SenderProfileID: senderID,
+		Accept:          false,
+	}
+
+	resp, err := h.userUsecase.RespondToInterest(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to decline interest", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Interest declined successfully", zap.Int64("sender_profile_id", senderID))
+	apiresponse.Success(c, "Interest declined successfully", resp)
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Delete a saved search
+// @Description Delete a saved search, which also stops its alerts
+// @Tags User
+// @Produce json
+// @Param saved_search_id path int true "Saved search ID"
+// @Success 200 {object} dto.DeleteSavedSearchResponse "Result"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 404 {object} dto.NotFoundError "Saved search not found"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/user/saved-searches/{saved_search_id} [delete]
+func (h *UserHandler) DeleteSavedSearch(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	savedSearchID, err := strconv.ParseInt(c.Param("saved_search_id"), 10, 64)
+	if err != nil || savedSearchID <= 0 {
+		apiresponse.Error(c, apperrors.ErrSavedSearchNotFound, nil)
+		return
+	}
+
+	req := dto.DeleteSavedSearchRequest{
+		SavedSearchID: savedSearchID,
+	}
+
+	resp, err := h.userUsecase.DeleteSavedSearch(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to delete saved search", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Saved search deleted successfully", zap.Int64("saved_search_id", savedSearchID))
+	apiresponse.Success(c, "Saved search deleted successfully", resp)
+}
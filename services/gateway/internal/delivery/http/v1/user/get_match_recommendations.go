@@ -13,11 +13,12 @@ import (
 )
 
 // @Summary Get match recommendations
-// @Description Get recommended profiles for the authenticated user with pagination
+// @Description Get recommended profiles for the authenticated user with pagination. Pass the next_cursor of a page as cursor to get the next one, unlike the offset it does not skip or repeat profiles after likes and passes.
 // @Tags User
 // @Produce json
 // @Param limit query int false "Items per page (1-50)" minimum(1) maximum(50)
-// @Param offset query int false "Offset (>= 0)" minimum(0)
+// @Param offset query int false "Offset (>= 0), ignored with a cursor" minimum(0)
+// @Param cursor query string false "next_cursor of the previous page"
 // @Success 200 {object} dto.GetMatchRecommendationsResponse "Profiles list"
 // @Failure 400 {object} dto.BadRequestError "Bad request - validation errors"
 // @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
@@ -53,6 +54,7 @@ func (h *UserHandler) GetMatchRecommendations(c *gin.Context) {
 	req := dto.GetMatchRecommendationsRequest{
 		Limit:  int32(limit64),
 		Offset: int32(offset64),
+		Cursor: c.Query("cursor"),
 	}
 
 	resp, err := h.userUsecase.GetMatchRecommendations(authCtx.Ctx, req)
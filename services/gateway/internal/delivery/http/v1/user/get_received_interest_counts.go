@@ -0,0 +1,44 @@
+package user
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+// @Summary Get interests received counts
+// @Description Count the pending likes the authenticated user received and how many of them are not seen yet
+// @Tags User
+// @Produce json
+// @Success 200 {object} dto.GetReceivedInterestCountsResponse "Pending and unread counts"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/user/interests/received/count [get]
+func (h *UserHandler) GetReceivedInterestCounts(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	resp, err := h.userUsecase.GetReceivedInterestCounts(authCtx.Ctx)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to get received interest counts", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Received interest counts retrieved successfully")
+	apiresponse.Success(c, "Received interest counts retrieved successfully", resp)
+}
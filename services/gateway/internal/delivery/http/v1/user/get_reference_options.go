@@ -0,0 +1,48 @@
+package user
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Get reference options
+// @Description List the active options of the profile taxonomies (community, marital status, profession, profession type, education level) with display labels, for building forms and filters.
+// @Tags User
+// @Produce json
+// @Param taxonomy query string false "Taxonomy (community, marital_status, profession, profession_type, education_level); empty lists all"
+// @Success 200 {object} dto.GetReferenceOptionsResponse "Reference options"
+// @Failure 400 {object} dto.BadRequestError "Bad request - invalid taxonomy"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Router /api/v1/reference-data [get]
+func (h *UserHandler) GetReferenceOptions(c *gin.Context) {
+	reqCtx, err := contextutils.ExtractRequestContext(c)
+	if err != nil {
+		h.logger.Error("Failed to extract request context", zap.Error(err))
+		apiresponse.Error(c, err, nil)
+		return
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, reqCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+	)
+
+	req := dto.GetReferenceOptionsRequest{
+		Taxonomy: c.Query("taxonomy"),
+	}
+
+	resp, err := h.userUsecase.GetReferenceOptions(reqCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to get reference options", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Reference options retrieved successfully", zap.String("taxonomy", req.Taxonomy))
+	apiresponse.Success(c, "Reference options retrieved successfully", resp)
+}
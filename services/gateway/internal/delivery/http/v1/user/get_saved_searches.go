@@ -0,0 +1,44 @@
+package user
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+// @Summary Get saved searches
+// @Description List the user's saved searches, most recent first
+// @Tags User
+// @Produce json
+// @Success 200 {object} dto.GetSavedSearchesResponse "Saved searches"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/user/saved-searches [get]
+func (h *UserHandler) GetSavedSearches(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	resp, err := h.userUsecase.GetSavedSearches(authCtx.Ctx)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to get saved searches", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Saved searches retrieved successfully")
+	apiresponse.Success(c, "Saved searches retrieved successfully", resp)
+}
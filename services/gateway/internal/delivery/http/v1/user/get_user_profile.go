@@ -13,7 +13,7 @@ import (
 // @Description Fetch the authenticated user's profile
 // @Tags User
 // @Produce json
-// @Success 200 {object} dto.UserProfileRecommendation "User profile"
+// @Success 200 {object} dto.GetUserProfileResponse "User profile with profile view counts"
 // @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
 // @Failure 500 {object} dto.InternalServerError "Internal server error"
 // @Security BearerAuth
@@ -41,17 +41,22 @@ func (h *UserHandler) PostPartnerPreference(c *gin.Context) {
 	}
 
 	request := dto.PartnerPreferencePatchRequest{
-		MinAgeYears:                &req.MinAgeYears,
-		MaxAgeYears:                &req.MaxAgeYears,
-		MinHeightCM:                &req.MinHeightCM,
-		MaxHeightCM:                &req.MaxHeightCM,
-		AcceptPhysicallyChallenged: &req.AcceptPhysicallyChallenged,
-		PreferredCommunities:       &req.PreferredCommunities,
-		PreferredMaritalStatus:     &req.PreferredMaritalStatus,
-		PreferredProfessions:       &req.PreferredProfessions,
-		PreferredProfessionTypes:   &req.PreferredProfessionTypes,
-		PreferredEducationLevels:   &req.PreferredEducationLevels,
-		PreferredHomeDistricts:     &req.PreferredHomeDistricts,
+		MinAgeYears:                 &req.MinAgeYears,
+		MaxAgeYears:                 &req.MaxAgeYears,
+		MinHeightCM:                 &req.MinHeightCM,
+		MaxHeightCM:                 &req.MaxHeightCM,
+		AcceptPhysicallyChallenged:  &req.AcceptPhysicallyChallenged,
+		PreferredCommunities:        &req.PreferredCommunities,
+		PreferredMaritalStatus:      &req.PreferredMaritalStatus,
+		PreferredProfessions:        &req.PreferredProfessions,
+		PreferredProfessionTypes:    &req.PreferredProfessionTypes,
+		PreferredEducationLevels:    &req.PreferredEducationLevels,
+		PreferredHomeDistricts:      &req.PreferredHomeDistricts,
+		MinPoruthamScore:            req.MinPoruthamScore,
+		AcceptDosham:                req.AcceptDosham,
+		PreferredCountries:          &req.PreferredCountries,
+		PreferredResidenceCountries: &req.PreferredResidenceCountries,
+		MustHaveCriteria:            &req.MustHaveCriteria,
 	}
 
 	err = h.userUsecase.UpdateUserPartnerPreferences(authCtx.Ctx, constants.CreateOperationType, request)
@@ -53,6 +53,16 @@ func (h *UserHandler) PutUserProfile(c *gin.Context) {
 		ProfessionType:        &req.ProfessionType,
 		HighestEducationLevel: &req.HighestEducationLevel,
 		HomeDistrict:          &req.HomeDistrict,
+		BirthTime:             req.BirthTime,
+		BirthPlace:            req.BirthPlace,
+		Nakshatra:             req.Nakshatra,
+		Rasi:                  req.Rasi,
+		ChovvaDosham:          req.ChovvaDosham,
+		PapaDosham:            req.PapaDosham,
+		NativePlace:           req.NativePlace,
+		Residence:             req.Residence,
+		PreferredLanguage:     req.PreferredLanguage,
+		DigestFrequency:       req.DigestFrequency,
 	}
 
 	err = h.userUsecase.UpdateUserProfile(authCtx.Ctx, request)
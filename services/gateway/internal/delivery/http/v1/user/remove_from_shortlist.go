@@ -0,0 +1,60 @@
+package user
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Remove profile from shortlist
+// @Description Remove a profile and its private note from the shortlist
+// @Tags User
+// @Produce json
+// @Param profile_id path int true "Target profile ID"
+// @Success 200 {object} dto.ShortlistResponse "Result"
+// @Failure 400 {object} dto.BadRequestError "Bad request - validation errors"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 404 {object} dto.NotFoundError "Profile not in shortlist"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/user/shortlist/{profile_id} [delete]
+func (h *UserHandler) RemoveFromShortlist(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	targetID, err := strconv.ParseInt(c.Param("profile_id"), 10, 64)
+	if err != nil || targetID <= 0 {
+		apiresponse.Error(c, apperrors.ErrInvalidTargetProfileID, nil)
+		return
+	}
+
+	req := dto.RemoveFromShortlistRequest{
+		TargetProfileID: targetID,
+	}
+
+	resp, err := h.userUsecase.RemoveFromShortlist(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to remove profile from shortlist", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Profile removed from shortlist successfully", zap.Int64("target_profile_id", targetID))
+	apiresponse.Success(c, "Profile removed from shortlist successfully", resp)
+}
@@ -0,0 +1,54 @@
+package user
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+	"go.uber.org/zap"
+)
+
+// @Summary Reorder additional photos
+// @Description Rearrange the additional photos, display_orders lists every occupied slot in its new order
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param request body dto.ReorderAdditionalPhotosRequest true "Reorder request"
+// @Success 200 {object} dto.ReorderAdditionalPhotosResponse "Reorder result"
+// @Failure 400 {object} dto.BadRequestError "Bad request - display orders do not match the photos"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/user/profile/additional-photos/order [put]
+func (h *UserHandler) ReorderAdditionalPhotos(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	var req dto.ReorderAdditionalPhotosRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apiresponse.Error(c, apperrors.ErrBindingJSON, nil)
+		return
+	}
+
+	resp, err := h.userUsecase.ReorderAdditionalPhotos(authCtx.Ctx, req)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to reorder additional photos", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("ReorderAdditionalPhotos request successful")
+	apiresponse.Success(c, "Additional photos reordered successfully", resp)
+}
@@ -0,0 +1,47 @@
+package user
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apiresponse"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+// @Summary Undo last match action
+// @Description Undo the authenticated user's latest like or pass made within the undo window, the profile is recommended again
+// @Tags User
+// @Produce json
+// @Success 200 {object} dto.UndoLastMatchActionResponse "Undone action"
+// @Failure 401 {object} dto.UnauthorizedError "Unauthorized"
+// @Failure 404 {object} dto.NotFoundError "No recent like or pass to undo"
+// @Failure 500 {object} dto.InternalServerError "Internal server error"
+// @Security BearerAuth
+// @Router /api/v1/user/match-action/undo [post]
+func (h *UserHandler) UndoLastMatchAction(c *gin.Context) {
+	authCtx, err := contextutils.ExtractAuthContext(c)
+	if err != nil {
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, authCtx.Ctx.Value(constants.ContextKeyRequestID).(string)),
+		zap.String(constants.ContextKeyUserID, authCtx.Ctx.Value(constants.ContextKeyUserID).(string)),
+	)
+
+	resp, err := h.userUsecase.UndoLastMatchAction(authCtx.Ctx)
+	if err != nil {
+		if apperrors.ShouldLogError(err) {
+			log.Error("Failed to undo last match action", zap.Error(err))
+		}
+		apiresponse.Error(c, err, nil)
+		return
+	}
+
+	log.Info("Last match action undone successfully",
+		zap.Int64("target_profile_id", resp.TargetProfileID),
+		zap.String("action", resp.Action))
+	apiresponse.Success(c, "Match action undone successfully", resp)
+}
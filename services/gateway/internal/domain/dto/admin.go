@@ -0,0 +1,136 @@
+package dto
+
+import "time"
+
+/////////////////// MODERATION QUEUE /////////////////////
+type GetReportsRequest struct {
+	Status string `json:"status"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+type ReportResponse struct {
+	ID                int64      `json:"id"`
+	ReporterUserID    string     `json:"reporter_user_id"`
+	TargetUserID      string     `json:"target_user_id"`
+	TargetProfileID   int64      `json:"target_profile_id"`
+	TargetType        string     `json:"target_type"`
+	PhotoDisplayOrder *int32     `json:"photo_display_order,omitempty"`
+	MessageID         string     `json:"message_id,omitempty"`
+	MessageContent    string     `json:"message_content,omitempty"`
+	Category          string     `json:"category"`
+	Description       string     `json:"description,omitempty"`
+	Status            string     `json:"status"`
+	Action            string     `json:"action,omitempty"`
+	AdminNote         string     `json:"admin_note,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	ResolvedAt        *time.Time `json:"resolved_at,omitempty"`
+}
+
+type GetReportsResponse struct {
+	Reports    []ReportResponse `json:"reports"`
+	Pagination PaginationInfo   `json:"pagination"`
+}
+
+type ResolveReportRequest struct {
+	ReportID  int64  `json:"-"` // taken from the path
+	Action    string `json:"action" binding:"required"`
+	AdminNote string `json:"admin_note"`
+}
+
+type ResolveReportResponse struct {
+	Report ReportResponse `json:"report"`
+}
+
+/////////////////// PHOTO REVIEW /////////////////////
+type GetPendingPhotosRequest struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type PendingPhotoResponse struct {
+	TargetProfileID int64     `json:"target_profile_id"`
+	TargetUserID    string    `json:"target_user_id"`
+	DisplayOrder    int32     `json:"display_order"` // 0 is the profile photo
+	PhotoURL        string    `json:"photo_url"`
+	UploadedAt      time.Time `json:"uploaded_at"`
+	ObjectKey       string    `json:"object_key"` // sent back with the review decision
+}
+
+type GetPendingPhotosResponse struct {
+	Photos     []PendingPhotoResponse `json:"photos"`
+	Pagination PaginationInfo         `json:"pagination"`
+}
+
+type ReviewPhotoRequest struct {
+	TargetProfileID int64  `json:"target_profile_id" binding:"required"`
+	DisplayOrder    int32  `json:"display_order"` // 0 is the profile photo
+	Approve         bool   `json:"approve"`
+	Reason          string `json:"reason"` // required when rejecting, shared with the user
+	ObjectKey       string `json:"object_key" binding:"required"` // the photo the admin reviewed
+}
+
+type ReviewPhotoResponse struct {
+	Success bool `json:"success"`
+}
+
+/////////////////// REFERENCE DATA /////////////////////
+type CreateReferenceOptionRequest struct {
+	Taxonomy     string `json:"taxonomy" binding:"required"`
+	Value        string `json:"value" binding:"required"`
+	Label        string `json:"label" binding:"required"`
+	DisplayOrder int32  `json:"display_order"`
+}
+
+type UpdateReferenceOptionRequest struct {
+	OptionID     int64   `json:"-"`
+	Label        *string `json:"label"`
+	DisplayOrder *int32  `json:"display_order"`
+	IsActive     *bool   `json:"is_active"` // inactive options are hidden and rejected for new values
+}
+
+type ReferenceOptionResponse struct {
+	Option ReferenceOption `json:"option"`
+}
+
+type DeleteReferenceOptionRequest struct {
+	OptionID int64 `json:"-"`
+}
+
+type DeleteReferenceOptionResponse struct {
+	Success bool `json:"success"`
+}
+
+/////////////////// PROFILE HISTORY /////////////////////
+type GetProfileHistoryRequest struct {
+	ProfileID   int64  `json:"profile_id"` // 0 for every profile
+	Section     string `json:"section"`    // profile or partner_preferences, empty for both
+	FlaggedOnly bool   `json:"flagged_only"`
+	Limit       int32  `json:"limit"`
+	Offset      int32  `json:"offset"`
+}
+
+type ProfileFieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"` // empty when the field was not set
+	NewValue string `json:"new_value"`
+}
+
+type ProfileChange struct {
+	ID          int64                `json:"id"`
+	ProfileID   int64                `json:"profile_id"`
+	UserID      string               `json:"user_id"`
+	Section     string               `json:"section"`
+	Version     int32                `json:"version"`
+	Changes     []ProfileFieldChange `json:"changes"`
+	ActorID     string               `json:"actor_id"`
+	ActorRole   string               `json:"actor_role"`
+	IsFlagged   bool                 `json:"is_flagged"`
+	FlagReasons []string             `json:"flag_reasons,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+}
+
+type GetProfileHistoryResponse struct {
+	Changes    []ProfileChange `json:"changes"`
+	Pagination PaginationInfo  `json:"pagination"`
+}
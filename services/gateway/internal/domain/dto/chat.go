@@ -39,6 +39,7 @@ type GetConversationResponse struct {
 	ParticipantIDs []string  `json:"participant_ids"`
 	CreatedAt      time.Time `json:"created_at"`
 	UpdatedAt      time.Time `json:"updated_at"`
+	IsArchived     bool      `json:"is_archived"` // read-only since the match ended
 }
 
 type GetMessagesByConversationIdRequest struct {
@@ -90,3 +91,13 @@ type WebSocketMessage struct {
 	Content    string    `json:"content,omitempty"`
 	SentAt     time.Time `json:"sent_at,omitempty"`
 }
+
+type ReportMessageRequest struct {
+	MessageID   string `json:"message_id" binding:"required"`
+	Category    string `json:"category" binding:"required"`
+	Description string `json:"description"`
+}
+
+type ReportMessageResponse struct {
+	Success bool `json:"success"`
+}
@@ -34,6 +34,7 @@ type ShowPaymentPageResponse struct {
 	DisplayAmount      string `json:"display_amount"` // Formatted amount for display
 	PlanDurationInDays int    `json:"plan_duration_in_days"`
 	GatewayURL         string `json:"gateway_url"`
+	Locale             string `json:"-"` // language the page is rendered in
 }
 
 type VerifyPaymentRequest struct {
@@ -66,11 +67,13 @@ type PaymentSuccessData struct {
 	SubscriptionStartDate string `json:"subscription_start_date"`
 	SubscriptionEndDate   string `json:"subscription_end_date"`
 	SubscriptionStatus    string `json:"subscription_status"`
+	Locale                string `json:"-"` // language the page is rendered in
 }
 
 type PaymentFailureData struct {
 	OrderID string `json:"order_id"`
 	Error   string `json:"error"`
+	Locale  string `json:"-"` // language the page is rendered in
 }
 
 type CreateSubscriptionPlanRequest struct {
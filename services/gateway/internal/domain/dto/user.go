@@ -1,6 +1,10 @@
 package dto
 
-import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+import (
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+)
 
 ///////////////////// USER PROFILE MANAGEMENT /////////////////////
 type UserProfilePutRequest struct {
@@ -15,6 +19,21 @@ type UserProfilePutRequest struct {
 	ProfessionType        string `json:"profession_type"`
 	HighestEducationLevel string `json:"highest_education_level"`
 	HomeDistrict          string `json:"home_district"`
+
+	// Horoscope is optional even on a full update
+	BirthTime    *string `json:"birth_time,omitempty"`
+	BirthPlace   *string `json:"birth_place,omitempty"`
+	Nakshatra    *string `json:"nakshatra,omitempty"`
+	Rasi         *string `json:"rasi,omitempty"`
+	ChovvaDosham *bool   `json:"chovva_dosham,omitempty"`
+	PapaDosham   *bool   `json:"papa_dosham,omitempty"`
+
+	// Location, a location with an empty country clears it
+	NativePlace *Location `json:"native_place,omitempty"`
+	Residence   *Location `json:"residence,omitempty"`
+
+	PreferredLanguage *string `json:"preferred_language,omitempty"` // en or ml, language of emails
+	DigestFrequency   *string `json:"digest_frequency,omitempty"`   // daily, weekly or off, match digest emails
 }
 
 type UserProfilePatchRequest struct {
@@ -29,6 +48,29 @@ type UserProfilePatchRequest struct {
 	ProfessionType        *string `json:"profession_type,omitempty"`
 	HighestEducationLevel *string `json:"highest_education_level,omitempty"`
 	HomeDistrict          *string `json:"home_district,omitempty"`
+	IsHidden              *bool   `json:"is_hidden,omitempty"` // hidden users browse without being recorded as visitors
+	// Horoscope, an empty string clears the field
+	BirthTime    *string `json:"birth_time,omitempty"` // HH:MM, 24 hour
+	BirthPlace   *string `json:"birth_place,omitempty"`
+	Nakshatra    *string `json:"nakshatra,omitempty"`
+	Rasi         *string `json:"rasi,omitempty"`
+	ChovvaDosham *bool   `json:"chovva_dosham,omitempty"`
+	PapaDosham   *bool   `json:"papa_dosham,omitempty"`
+
+	// Location, a location with an empty country clears it
+	NativePlace *Location `json:"native_place,omitempty"`
+	Residence   *Location `json:"residence,omitempty"`
+
+	PreferredLanguage *string `json:"preferred_language,omitempty"` // en or ml, language of emails
+	DigestFrequency   *string `json:"digest_frequency,omitempty"`   // daily, weekly or off, match digest emails
+}
+
+// Country is an ISO 3166-1 alpha-2 code, the rest are optional
+type Location struct {
+	Country  string `json:"country"`
+	State    string `json:"state,omitempty"`
+	District string `json:"district,omitempty"`
+	City     string `json:"city,omitempty"`
 }
 
 type UserProfileRecommendation struct {
@@ -40,6 +82,55 @@ type UserProfileRecommendation struct {
 	MaritalStatus     string `json:"marital_status"`
 	Profession        string `json:"profession"`
 	HomeDistrict      string `json:"home_district"`
+	IsShortlisted     bool   `json:"is_shortlisted,omitempty"`
+
+	NativePlace *Location `json:"native_place,omitempty"`
+	Residence   *Location `json:"residence,omitempty"`
+	IsNRI       bool      `json:"is_nri"`
+
+	// Recommendations only, 0 to 100 against the viewer's partner preferences,
+	// with the preferences this profile satisfies and the ones it does not
+	CompatibilityScore int      `json:"compatibility_score,omitempty"`
+	MatchedCriteria    []string `json:"matched_criteria,omitempty"`
+	UnmatchedCriteria  []string `json:"unmatched_criteria,omitempty"`
+	// The viewer fits this profile's own partner preferences
+	MatchesTheirPreferences bool `json:"matches_their_preferences,omitempty"`
+	// Recommendations only, the viewer passed on this profile before
+	PreviouslyPassed bool `json:"previously_passed,omitempty"`
+	// active_today or active_this_week, empty for profiles inactive longer
+	ActivityStatus string `json:"activity_status,omitempty"`
+}
+
+type GetUserProfileResponse struct {
+	UserProfileRecommendation
+	ViewStats ProfileViewStats `json:"view_stats"`
+	Horoscope *Horoscope       `json:"horoscope,omitempty"`
+
+	PreferredLanguage string `json:"preferred_language,omitempty"`
+	DigestFrequency   string `json:"digest_frequency,omitempty"`
+}
+
+// Birth time and place are only returned to the owner
+type Horoscope struct {
+	BirthTime    string `json:"birth_time,omitempty"`
+	BirthPlace   string `json:"birth_place,omitempty"`
+	Nakshatra    string `json:"nakshatra"`
+	Rasi         string `json:"rasi"`
+	ChovvaDosham *bool  `json:"chovva_dosham"`
+	PapaDosham   *bool  `json:"papa_dosham"`
+}
+
+type PoruthamCompatibility struct {
+	Score            int32    `json:"score"`
+	MaxScore         int32    `json:"max_score"`
+	Matched          []string `json:"matched_poruthams"`
+	Unmatched        []string `json:"unmatched_poruthams"`
+	DoshamCompatible *bool    `json:"dosham_compatible"` // null when dosham is unknown
+}
+
+type ProfileViewStats struct {
+	ViewsLast7Days  int64 `json:"views_last_7_days"`
+	ViewsLast30Days int64 `json:"views_last_30_days"`
 }
 
 //////////////////// USER PROFILE PHOTO MANAGEMENT /////////////////////
@@ -104,6 +195,24 @@ type GetAdditionalPhotosResponse struct {
 	AdditionalPhotoURLs []string `json:"additional_photo_urls"`
 }
 
+// DisplayOrders lists the current slots in their new order, e.g. [3, 1, 2]
+// moves the photo in slot 3 to the front.
+type ReorderAdditionalPhotosRequest struct {
+	DisplayOrders []int32 `json:"display_orders"`
+}
+
+type ReorderAdditionalPhotosResponse struct {
+	Success bool `json:"success"`
+}
+
+type SetPrimaryPhotoRequest struct {
+	DisplayOrder int32 `json:"display_order"`
+}
+
+type SetPrimaryPhotoResponse struct {
+	Success bool `json:"success"`
+}
+
 
 /////////////////// PARTNER PREFERENCES MANAGEMENT /////////////////////
 type PartnerPreferenceCreateRequest struct {
@@ -118,6 +227,16 @@ type PartnerPreferenceCreateRequest struct {
 	PreferredProfessionTypes   []string `json:"preferred_profession_types"`
 	PreferredEducationLevels   []string `json:"preferred_education_levels"`
 	PreferredHomeDistricts     []string `json:"preferred_home_districts"`
+	MinPoruthamScore           *int     `json:"min_porutham_score,omitempty"` // 0 to 10, 0 disables the filter
+	AcceptDosham               *bool    `json:"accept_dosham,omitempty"`      // defaults to true
+
+	// ISO country codes or "any", default "any"
+	PreferredCountries          []string `json:"preferred_countries"`
+	PreferredResidenceCountries []string `json:"preferred_residence_countries"`
+
+	// Preferences applied as hard filters, the rest only affect the
+	// compatibility score. Default none
+	MustHaveCriteria []string `json:"must_have_criteria"`
 }
 
 type PartnerPreferencePatchRequest struct {
@@ -132,6 +251,13 @@ type PartnerPreferencePatchRequest struct {
 	PreferredProfessionTypes   *[]string `json:"preferred_profession_types,omitempty"`
 	PreferredEducationLevels   *[]string `json:"preferred_education_levels,omitempty"`
 	PreferredHomeDistricts     *[]string `json:"preferred_home_districts,omitempty"`
+	MinPoruthamScore           *int      `json:"min_porutham_score,omitempty"`
+	AcceptDosham               *bool     `json:"accept_dosham,omitempty"`
+
+	PreferredCountries          *[]string `json:"preferred_countries,omitempty"`
+	PreferredResidenceCountries *[]string `json:"preferred_residence_countries,omitempty"`
+
+	MustHaveCriteria *[]string `json:"must_have_criteria,omitempty"` // an empty list clears them
 }
 
 // similar to above given patch, but this is used in usecase => client layer , so no need to convert custom type to string type.
@@ -147,6 +273,13 @@ type UpdatePartnerPreferenceRequest struct {
 	PreferredProfessionTypes   *[]validation.ProfessionType `json:"preferred_profession_types,omitempty"`
 	PreferredEducationLevels   *[]validation.EducationLevel `json:"preferred_education_levels,omitempty"`
 	PreferredHomeDistricts     *[]validation.HomeDistrict   `json:"preferred_home_districts,omitempty"`
+	MinPoruthamScore           *int                         `json:"min_porutham_score,omitempty"`
+	AcceptDosham               *bool                        `json:"accept_dosham,omitempty"`
+
+	PreferredCountries          *[]string `json:"preferred_countries,omitempty"`
+	PreferredResidenceCountries *[]string `json:"preferred_residence_countries,omitempty"`
+
+	MustHaveCriteria *[]string `json:"must_have_criteria,omitempty"`
 }
 
 type UpdateUserPartnerPreferencesResponse struct {
@@ -165,6 +298,12 @@ type PartnerPreferenceResponse struct {
 	PreferredProfessionTypes   []string `json:"preferred_profession_types"`
 	PreferredEducationLevels   []string `json:"preferred_education_levels"`
 	PreferredHomeDistricts     []string `json:"preferred_home_districts"`
+	MinPoruthamScore           int      `json:"min_porutham_score"`
+	AcceptDosham               bool     `json:"accept_dosham"`
+
+	PreferredCountries          []string `json:"preferred_countries"`
+	PreferredResidenceCountries []string `json:"preferred_residence_countries"`
+	MustHaveCriteria            []string `json:"must_have_criteria"`
 }
 
 type GetUserPartnerPreferencesResponse struct {
@@ -185,9 +324,15 @@ type RecordMatchActionResponse struct {
 	Success bool `json:"success"`
 }
 
+type UndoLastMatchActionResponse struct {
+	TargetProfileID int64  `json:"target_profile_id"`
+	Action          string `json:"action"` // the undone action, like or pass
+}
+
 type GetMatchRecommendationsRequest struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+	Cursor string `json:"cursor"` // next_cursor of the previous page, takes precedence over the offset
 }
 
 type GetMatchRecommendationsResponse struct {
@@ -212,6 +357,9 @@ type PaginationInfo struct {
 	Limit      int   `json:"limit"`
 	Offset     int   `json:"offset"`
 	HasMore    bool  `json:"has_more"`
+
+	// Cursor paginated feeds only, pass it back to get the next page
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 
@@ -225,4 +373,189 @@ type GetUserDetailsByProfileIDResponse struct {
 	Profile UserProfileRecommendation `json:"profile"`
 	PartnerPreferences PartnerPreferenceResponse `json:"partner_preferences"`
 	AdditionalPhotoURLs []string `json:"additional_photo_urls"`
-}
\ No newline at end of file
+	Horoscope *Horoscope `json:"horoscope,omitempty"`
+	Porutham *PoruthamCompatibility `json:"porutham,omitempty"` // absent when either profile has no horoscope
+}
+
+/////////////////// USER BLOCKING /////////////////////
+type BlockOrUnblockProfileRequest struct {
+	TargetProfileID int64 `json:"target_profile_id" binding:"required"`
+	ShouldBlock     bool  `json:"should_block"` // set by the handler based on route
+}
+
+type BlockOrUnblockProfileResponse struct {
+	Success bool `json:"success"`
+}
+
+/////////////////// ABUSE REPORTS /////////////////////
+type ReportProfileRequest struct {
+	TargetProfileID   int64  `json:"target_profile_id" binding:"required"`
+	TargetType        string `json:"target_type" binding:"required"`   // profile or photo
+	PhotoDisplayOrder *int32 `json:"photo_display_order,omitempty"` // 0 for profile photo, required when target_type is photo
+	Category          string `json:"category" binding:"required"`
+	Description       string `json:"description"`
+}
+
+type ReportProfileResponse struct {
+	ReportID int64 `json:"report_id"`
+}
+
+/////////////////// PROFILE VIEWS /////////////////////
+type GetProfileVisitorsRequest struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type ProfileVisitor struct {
+	Profile      UserProfileRecommendation `json:"profile"`
+	LastViewedAt time.Time                 `json:"last_viewed_at"`
+}
+
+type GetProfileVisitorsResponse struct {
+	Visitors   []ProfileVisitor `json:"visitors"`
+	Pagination PaginationInfo   `json:"pagination"`
+}
+
+/////////////////// INTERESTS RECEIVED /////////////////////
+type GetReceivedInterestsRequest struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type ReceivedInterest struct {
+	Profile    UserProfileRecommendation `json:"profile"`
+	ReceivedAt time.Time                 `json:"received_at"`
+	IsNew      bool                      `json:"is_new"` // first time shown in the inbox
+}
+
+type GetReceivedInterestsResponse struct {
+	Interests  []ReceivedInterest `json:"interests"`
+	Pagination PaginationInfo     `json:"pagination"`
+}
+
+type GetReceivedInterestCountsResponse struct {
+	PendingCount int64 `json:"pending_count"`
+	UnreadCount  int64 `json:"unread_count"`
+}
+
+type RespondToInterestRequest struct {
+	SenderProfileID int64 `json:"sender_profile_id"`
+	Accept          bool  `json:"accept"`
+}
+
+type RespondToInterestResponse struct {
+	Success bool `json:"success"`
+}
+
+/////////////////// SHORTLIST /////////////////////
+type AddToShortlistRequest struct {
+	TargetProfileID int64  `json:"target_profile_id" binding:"required"`
+	Note            string `json:"note"` // private, never shown to the shortlisted user
+}
+
+type RemoveFromShortlistRequest struct {
+	TargetProfileID int64 `json:"target_profile_id"`
+}
+
+type ShortlistResponse struct {
+	Success bool `json:"success"`
+}
+
+type GetShortlistRequest struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+type ShortlistedProfile struct {
+	Profile       UserProfileRecommendation `json:"profile"`
+	Note          string                    `json:"note"`
+	ShortlistedAt time.Time                 `json:"shortlisted_at"`
+}
+
+type GetShortlistResponse struct {
+	Profiles   []ShortlistedProfile `json:"profiles"`
+	Pagination PaginationInfo       `json:"pagination"`
+}
+
+/////////////////// SEARCH /////////////////////
+// SearchFilters are independent of the partner preferences, unset fields do
+// not filter and a list matches any of its values.
+type SearchFilters struct {
+	MinAgeYears          *int32   `json:"min_age_years,omitempty"`
+	MaxAgeYears          *int32   `json:"max_age_years,omitempty"`
+	MinHeightCm          *int32   `json:"min_height_cm,omitempty"`
+	MaxHeightCm          *int32   `json:"max_height_cm,omitempty"`
+	PhysicallyChallenged *bool    `json:"physically_challenged,omitempty"`
+	Communities          []string `json:"communities,omitempty"`
+	MaritalStatuses      []string `json:"marital_statuses,omitempty"`
+	Professions          []string `json:"professions,omitempty"`
+	ProfessionTypes      []string `json:"profession_types,omitempty"`
+	EducationLevels      []string `json:"education_levels,omitempty"`
+	HomeDistricts        []string `json:"home_districts,omitempty"`
+	NativeCountries      []string `json:"native_countries,omitempty"`
+	ResidenceCountries   []string `json:"residence_countries,omitempty"`
+	HasPhoto             bool     `json:"has_photo,omitempty"`
+	VerifiedOnly         bool     `json:"verified_only,omitempty"`
+	ActiveWithinDays     int32    `json:"active_within_days,omitempty"` // 0 for any
+	Sort                 string   `json:"sort,omitempty"`               // recently_active by default
+}
+
+type SearchProfilesRequest struct {
+	Filters SearchFilters `json:"filters"`
+	Limit   int32         `json:"limit"`
+	Offset  int32         `json:"offset"`
+}
+
+type SearchProfilesResponse struct {
+	Profiles   []UserProfileRecommendation `json:"profiles"`
+	Pagination PaginationInfo              `json:"pagination"`
+}
+
+type SavedSearch struct {
+	ID            int64         `json:"id"`
+	Name          string        `json:"name"`
+	Filters       SearchFilters `json:"filters"`
+	AlertsEnabled bool          `json:"alerts_enabled"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+type CreateSavedSearchRequest struct {
+	Name          string        `json:"name" binding:"required"`
+	Filters       SearchFilters `json:"filters"`
+	AlertsEnabled bool          `json:"alerts_enabled"` // email when new profiles match
+}
+
+type CreateSavedSearchResponse struct {
+	SavedSearch SavedSearch `json:"saved_search"`
+}
+
+type GetSavedSearchesResponse struct {
+	SavedSearches []SavedSearch `json:"saved_searches"`
+}
+
+type DeleteSavedSearchRequest struct {
+	SavedSearchID int64 `json:"saved_search_id"`
+}
+
+type DeleteSavedSearchResponse struct {
+	Success bool `json:"success"`
+}
+
+/////////////////// REFERENCE DATA /////////////////////
+type GetReferenceOptionsRequest struct {
+	Taxonomy        string `json:"taxonomy"` // empty lists every taxonomy
+	IncludeInactive bool   `json:"-"`        // set for admins only
+}
+
+type ReferenceOption struct {
+	ID           int64  `json:"id"`
+	Taxonomy     string `json:"taxonomy"`
+	Value        string `json:"value"`
+	Label        string `json:"label"`
+	DisplayOrder int32  `json:"display_order"`
+	IsActive     bool   `json:"is_active"`
+}
+
+type GetReferenceOptionsResponse struct {
+	Options []ReferenceOption `json:"options"` // ordered by taxonomy, then display order
+}
@@ -0,0 +1,18 @@
+package admin
+
+import (
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/client"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/usecase"
+)
+
+type adminUsecase struct {
+	userClient client.UserClient
+	authClient client.AuthClient
+}
+
+func NewAdminUsecase(userClient client.UserClient, authClient client.AuthClient) usecase.AdminUsecase {
+	return &adminUsecase{
+		userClient: userClient,
+		authClient: authClient,
+	}
+}
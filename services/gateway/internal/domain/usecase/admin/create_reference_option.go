@@ -0,0 +1,31 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *adminUsecase) CreateReferenceOption(ctx context.Context, req dto.CreateReferenceOptionRequest) (*dto.ReferenceOptionResponse, error) {
+	if !validation.IsValidTaxonomy(req.Taxonomy) {
+		return nil, apperrors.ErrInvalidTaxonomy
+	}
+	req.Value = strings.TrimSpace(req.Value)
+	if !validation.IsValidTaxonomyValue(req.Value) {
+		return nil, apperrors.ErrInvalidReferenceOptionValue
+	}
+	if !validation.IsValidTaxonomyLabel(req.Label) {
+		return nil, apperrors.ErrInvalidReferenceOptionLabel
+	}
+
+	resp, err := u.userClient.CreateReferenceOption(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = u.RefreshValidationCache(ctx)
+	return resp, nil
+}
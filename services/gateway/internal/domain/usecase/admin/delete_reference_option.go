@@ -0,0 +1,22 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *adminUsecase) DeleteReferenceOption(ctx context.Context, req dto.DeleteReferenceOptionRequest) (*dto.DeleteReferenceOptionResponse, error) {
+	if req.OptionID <= 0 {
+		return nil, apperrors.ErrReferenceOptionNotFound
+	}
+
+	resp, err := u.userClient.DeleteReferenceOption(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = u.RefreshValidationCache(ctx)
+	return resp, nil
+}
@@ -0,0 +1,11 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *adminUsecase) GetPendingPhotos(ctx context.Context, req dto.GetPendingPhotosRequest) (*dto.GetPendingPhotosResponse, error) {
+	return u.userClient.GetPendingPhotos(ctx, req)
+}
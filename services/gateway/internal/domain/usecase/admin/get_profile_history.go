@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *adminUsecase) GetProfileHistory(ctx context.Context, req dto.GetProfileHistoryRequest) (*dto.GetProfileHistoryResponse, error) {
+	if req.ProfileID < 0 {
+		return nil, apperrors.ErrInvalidTargetProfileID
+	}
+	if !validation.IsValidProfileChangeSection(req.Section) {
+		return nil, apperrors.ErrInvalidProfileChangeSection
+	}
+
+	return u.userClient.GetProfileHistory(ctx, req)
+}
@@ -0,0 +1,18 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *adminUsecase) GetReferenceOptions(ctx context.Context, req dto.GetReferenceOptionsRequest) (*dto.GetReferenceOptionsResponse, error) {
+	if req.Taxonomy != "" && !validation.IsValidTaxonomy(req.Taxonomy) {
+		return nil, apperrors.ErrInvalidTaxonomy
+	}
+	req.IncludeInactive = true
+
+	return u.userClient.GetReferenceOptions(ctx, req)
+}
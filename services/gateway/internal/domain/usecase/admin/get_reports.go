@@ -0,0 +1,17 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *adminUsecase) GetReports(ctx context.Context, req dto.GetReportsRequest) (*dto.GetReportsResponse, error) {
+	if req.Status != "" && !validation.IsValidReportStatus(req.Status) {
+		return nil, apperrors.ErrInvalidReportStatus
+	}
+
+	return u.userClient.GetReports(ctx, req)
+}
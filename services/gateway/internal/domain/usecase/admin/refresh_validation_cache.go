@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+// RefreshValidationCache loads the options managed in the user service into
+// the validation package, so the gateway accepts the same values it does.
+// Admin changes call it right away, errors are ignored there since the
+// periodic refresh picks the change up later.
+func (u *adminUsecase) RefreshValidationCache(ctx context.Context) error {
+	resp, err := u.userClient.GetReferenceOptions(ctx, dto.GetReferenceOptionsRequest{IncludeInactive: true})
+	if err != nil {
+		return err
+	}
+
+	options := make([]validation.TaxonomyOption, len(resp.Options))
+	for i, option := range resp.Options {
+		options[i] = validation.TaxonomyOption{
+			Taxonomy: validation.Taxonomy(option.Taxonomy),
+			Value:    option.Value,
+			IsActive: option.IsActive,
+		}
+	}
+	validation.LoadTaxonomyOptions(options)
+	return nil
+}
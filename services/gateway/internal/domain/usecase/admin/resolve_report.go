@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *adminUsecase) ResolveReport(ctx context.Context, req dto.ResolveReportRequest) (*dto.ResolveReportResponse, error) {
+	if req.ReportID <= 0 {
+		return nil, apperrors.ErrReportNotFound
+	}
+	if !validation.IsValidModerationAction(req.Action) {
+		return nil, apperrors.ErrInvalidModerationAction
+	}
+
+	// Accounts live in auth service, so suspension is applied from here before
+	// the report is marked as actioned. A failed suspension leaves the report
+	// pending so it can be resolved again. Auth service notifies the user by email.
+	if req.Action == constants.ModerationActionSuspend {
+		report, err := u.userClient.GetReport(ctx, req.ReportID)
+		if err != nil {
+			return nil, err
+		}
+		if report.Status != constants.ReportStatusPending {
+			return nil, apperrors.ErrReportAlreadyResolved
+		}
+		if _, err := u.authClient.BlockOrUnblockUser(ctx, dto.BlockOrUnblockUserRequest{
+			Field:       "id",
+			Value:       report.TargetUserID,
+			ShouldBlock: true,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to suspend user: %w", err)
+		}
+	}
+
+	return u.userClient.ResolveReport(ctx, req)
+}
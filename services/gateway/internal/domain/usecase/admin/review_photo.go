@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *adminUsecase) ReviewPhoto(ctx context.Context, req dto.ReviewPhotoRequest) (*dto.ReviewPhotoResponse, error) {
+	if req.TargetProfileID <= 0 {
+		return nil, apperrors.ErrUserProfileNotFound
+	}
+	// 0 is the profile photo, the rest are the additional photos
+	if req.DisplayOrder < 0 || req.DisplayOrder > constants.MaxAdditionalPhotoSlots {
+		return nil, apperrors.ErrInvalidDisplayOrder
+	}
+
+	req.Reason = strings.TrimSpace(req.Reason)
+	if !req.Approve {
+		if req.Reason == "" {
+			return nil, apperrors.ErrPhotoRejectionReasonRequired
+		}
+		if len(req.Reason) > constants.MaxPhotoRejectionReasonLength {
+			return nil, apperrors.ErrPhotoRejectionReasonTooLong
+		}
+	}
+
+	return u.userClient.ReviewPhoto(ctx, req)
+}
@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *adminUsecase) UpdateReferenceOption(ctx context.Context, req dto.UpdateReferenceOptionRequest) (*dto.ReferenceOptionResponse, error) {
+	if req.OptionID <= 0 {
+		return nil, apperrors.ErrReferenceOptionNotFound
+	}
+	if req.Label == nil && req.DisplayOrder == nil && req.IsActive == nil {
+		return nil, apperrors.ErrMissingRequiredFields
+	}
+	if req.Label != nil && !validation.IsValidTaxonomyLabel(*req.Label) {
+		return nil, apperrors.ErrInvalidReferenceOptionLabel
+	}
+
+	resp, err := u.userClient.UpdateReferenceOption(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = u.RefreshValidationCache(ctx)
+	return resp, nil
+}
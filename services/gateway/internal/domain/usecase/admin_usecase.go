@@ -1,4 +1,20 @@
 package usecase
 
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
 type AdminUsecase interface {
+	GetReports(ctx context.Context, req dto.GetReportsRequest) (*dto.GetReportsResponse, error)
+	ResolveReport(ctx context.Context, req dto.ResolveReportRequest) (*dto.ResolveReportResponse, error)
+	GetPendingPhotos(ctx context.Context, req dto.GetPendingPhotosRequest) (*dto.GetPendingPhotosResponse, error)
+	ReviewPhoto(ctx context.Context, req dto.ReviewPhotoRequest) (*dto.ReviewPhotoResponse, error)
+	GetProfileHistory(ctx context.Context, req dto.GetProfileHistoryRequest) (*dto.GetProfileHistoryResponse, error)
+	GetReferenceOptions(ctx context.Context, req dto.GetReferenceOptionsRequest) (*dto.GetReferenceOptionsResponse, error)
+	CreateReferenceOption(ctx context.Context, req dto.CreateReferenceOptionRequest) (*dto.ReferenceOptionResponse, error)
+	UpdateReferenceOption(ctx context.Context, req dto.UpdateReferenceOptionRequest) (*dto.ReferenceOptionResponse, error)
+	DeleteReferenceOption(ctx context.Context, req dto.DeleteReferenceOptionRequest) (*dto.DeleteReferenceOptionResponse, error)
+	RefreshValidationCache(ctx context.Context) error
 }
@@ -0,0 +1,17 @@
+package chat
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *chatUsecase) ReportMessage(ctx context.Context, req dto.ReportMessageRequest) (*dto.ReportMessageResponse, error) {
+	if !validation.IsValidReportCategory(req.Category) {
+		return nil, apperrors.ErrInvalidReportCategory
+	}
+
+	return u.chatClient.ReportMessage(ctx, req)
+}
@@ -11,4 +11,5 @@ type ChatUsecase interface {
 	SendMessage(ctx context.Context, req dto.SendMessageRequest) (*dto.SendMessageResponse, error)
 	GetConversation(ctx context.Context, req dto.GetConversationRequest) (*dto.GetConversationResponse, error)
 	GetMessagesByConversationId(ctx context.Context, req dto.GetMessagesByConversationIdRequest) (*dto.PublicGetMessagesByConversationIdResponse, error)
+	ReportMessage(ctx context.Context, req dto.ReportMessageRequest) (*dto.ReportMessageResponse, error)
 }
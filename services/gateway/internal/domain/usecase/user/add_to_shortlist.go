@@ -0,0 +1,25 @@
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) AddToShortlist(
+	ctx context.Context,
+	req dto.AddToShortlistRequest) (*dto.ShortlistResponse, error) {
+
+	if req.TargetProfileID <= 0 {
+		return nil, apperrors.ErrInvalidTargetProfileID
+	}
+	req.Note = strings.TrimSpace(req.Note)
+	if len(req.Note) > constants.MaxShortlistNoteLength {
+		return nil, apperrors.ErrShortlistNoteTooLong
+	}
+
+	return u.userClient.AddToShortlist(ctx, req)
+}
@@ -0,0 +1,19 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) BlockOrUnblockProfile(
+	ctx context.Context,
+	req dto.BlockOrUnblockProfileRequest) (*dto.BlockOrUnblockProfileResponse, error) {
+
+	if req.TargetProfileID <= 0 {
+		return nil, apperrors.ErrInvalidTargetProfileID
+	}
+
+	return u.userClient.BlockOrUnblockProfile(ctx, req)
+}
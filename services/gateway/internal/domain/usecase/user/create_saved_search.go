@@ -0,0 +1,23 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) CreateSavedSearch(
+	ctx context.Context,
+	req dto.CreateSavedSearchRequest) (*dto.CreateSavedSearchResponse, error) {
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" || utf8.RuneCountInString(req.Name) > constants.MaxSavedSearchNameLength {
+		return nil, apperrors.ErrInvalidSavedSearchName
+	}
+
+	return u.userClient.CreateSavedSearch(ctx, req)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
 )
 
@@ -11,7 +12,7 @@ func (u *userUsecase) DeleteAdditionalPhoto(
 	ctx context.Context, 
 	req dto.DeleteAdditionalPhotoRequest) (*dto.DeleteAdditionalPhotoResponse, error) {
 	
-	if req.DisplayOrder < 1 || req.DisplayOrder > 3 {
+	if req.DisplayOrder < 1 || req.DisplayOrder > constants.MaxAdditionalPhotoSlots {
 		return nil, apperrors.ErrInvalidDisplayOrder
 	}
 	
@@ -0,0 +1,19 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) DeleteSavedSearch(
+	ctx context.Context,
+	req dto.DeleteSavedSearchRequest) (*dto.DeleteSavedSearchResponse, error) {
+
+	if req.SavedSearchID <= 0 {
+		return nil, apperrors.ErrSavedSearchNotFound
+	}
+
+	return u.userClient.DeleteSavedSearch(ctx, req)
+}
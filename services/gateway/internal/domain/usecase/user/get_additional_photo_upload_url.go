@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
 )
@@ -17,7 +18,7 @@ func (u *userUsecase) GetAdditionalPhotoUploadURL(
 		return nil, apperrors.ErrInvalidImageType
 	}
 
-	if req.DisplayOrder < 1 || req.DisplayOrder > 3 {
+	if req.DisplayOrder < 1 || req.DisplayOrder > constants.MaxAdditionalPhotoSlots {
 		return nil, apperrors.ErrInvalidDisplayOrder
 	}
 
@@ -0,0 +1,14 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) GetProfileVisitors(
+	ctx context.Context,
+	req dto.GetProfileVisitorsRequest) (*dto.GetProfileVisitorsResponse, error) {
+
+	return u.userClient.GetProfileVisitors(ctx, req)
+}
@@ -0,0 +1,11 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) GetReceivedInterestCounts(ctx context.Context) (*dto.GetReceivedInterestCountsResponse, error) {
+	return u.userClient.GetReceivedInterestCounts(ctx)
+}
@@ -0,0 +1,14 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) GetReceivedInterests(
+	ctx context.Context,
+	req dto.GetReceivedInterestsRequest) (*dto.GetReceivedInterestsResponse, error) {
+
+	return u.userClient.GetReceivedInterests(ctx, req)
+}
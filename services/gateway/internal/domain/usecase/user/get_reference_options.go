@@ -0,0 +1,19 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) GetReferenceOptions(ctx context.Context, req dto.GetReferenceOptionsRequest) (*dto.GetReferenceOptionsResponse, error) {
+	if req.Taxonomy != "" && !validation.IsValidTaxonomy(req.Taxonomy) {
+		return nil, apperrors.ErrInvalidTaxonomy
+	}
+	// Inactive options are only listed for admins.
+	req.IncludeInactive = false
+
+	return u.userClient.GetReferenceOptions(ctx, req)
+}
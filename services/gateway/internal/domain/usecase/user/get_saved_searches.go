@@ -0,0 +1,11 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) GetSavedSearches(ctx context.Context) (*dto.GetSavedSearchesResponse, error) {
+	return u.userClient.GetSavedSearches(ctx)
+}
@@ -0,0 +1,14 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) GetShortlist(
+	ctx context.Context,
+	req dto.GetShortlistRequest) (*dto.GetShortlistResponse, error) {
+
+	return u.userClient.GetShortlist(ctx, req)
+}
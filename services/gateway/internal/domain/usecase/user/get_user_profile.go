@@ -6,6 +6,6 @@ import (
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
 )
 
-func (u *userUsecase) GetUserProfile(ctx context.Context) (*dto.UserProfileRecommendation, error) {
+func (u *userUsecase) GetUserProfile(ctx context.Context) (*dto.GetUserProfileResponse, error) {
 	return u.userClient.GetUserProfile(ctx)
 }
\ No newline at end of file
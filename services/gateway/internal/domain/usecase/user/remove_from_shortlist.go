@@ -0,0 +1,19 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) RemoveFromShortlist(
+	ctx context.Context,
+	req dto.RemoveFromShortlistRequest) (*dto.ShortlistResponse, error) {
+
+	if req.TargetProfileID <= 0 {
+		return nil, apperrors.ErrInvalidTargetProfileID
+	}
+
+	return u.userClient.RemoveFromShortlist(ctx, req)
+}
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) ReorderAdditionalPhotos(
+	ctx context.Context,
+	req dto.ReorderAdditionalPhotosRequest) (*dto.ReorderAdditionalPhotosResponse, error) {
+
+	if len(req.DisplayOrders) == 0 || len(req.DisplayOrders) > constants.MaxAdditionalPhotoSlots {
+		return nil, apperrors.ErrInvalidPhotoOrdering
+	}
+	for _, displayOrder := range req.DisplayOrders {
+		if displayOrder < 1 || displayOrder > constants.MaxAdditionalPhotoSlots {
+			return nil, apperrors.ErrInvalidDisplayOrder
+		}
+	}
+
+	resp, err := u.userClient.ReorderAdditionalPhotos(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,33 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) ReportProfile(
+	ctx context.Context,
+	req dto.ReportProfileRequest) (*dto.ReportProfileResponse, error) {
+
+	if req.TargetProfileID <= 0 {
+		return nil, apperrors.ErrInvalidTargetProfileID
+	}
+	if req.TargetType != constants.ReportTargetProfile && req.TargetType != constants.ReportTargetPhoto {
+		return nil, apperrors.ErrInvalidReportTarget
+	}
+	if req.TargetType == constants.ReportTargetPhoto && req.PhotoDisplayOrder == nil {
+		return nil, apperrors.ErrInvalidReportTarget
+	}
+	if !validation.IsValidReportCategory(req.Category) {
+		return nil, apperrors.ErrInvalidReportCategory
+	}
+	if len(req.Description) > constants.MaxReportDescriptionLength {
+		return nil, apperrors.ErrReportDescriptionTooLong
+	}
+
+	return u.userClient.ReportProfile(ctx, req)
+}
@@ -0,0 +1,19 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) RespondToInterest(
+	ctx context.Context,
+	req dto.RespondToInterestRequest) (*dto.RespondToInterestResponse, error) {
+
+	if req.SenderProfileID <= 0 {
+		return nil, apperrors.ErrInvalidTargetProfileID
+	}
+
+	return u.userClient.RespondToInterest(ctx, req)
+}
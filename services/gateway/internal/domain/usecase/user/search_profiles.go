@@ -0,0 +1,14 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) SearchProfiles(
+	ctx context.Context,
+	req dto.SearchProfilesRequest) (*dto.SearchProfilesResponse, error) {
+
+	return u.userClient.SearchProfiles(ctx, req)
+}
@@ -0,0 +1,24 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) SetPrimaryPhoto(
+	ctx context.Context,
+	req dto.SetPrimaryPhotoRequest) (*dto.SetPrimaryPhotoResponse, error) {
+
+	if req.DisplayOrder < 1 || req.DisplayOrder > constants.MaxAdditionalPhotoSlots {
+		return nil, apperrors.ErrInvalidDisplayOrder
+	}
+
+	resp, err := u.userClient.SetPrimaryPhoto(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,11 @@
+package user
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
+)
+
+func (u *userUsecase) UndoLastMatchAction(ctx context.Context) (*dto.UndoLastMatchActionResponse, error) {
+	return u.userClient.UndoLastMatchAction(ctx)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pointerutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/porutham"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
 )
@@ -114,5 +115,39 @@ func (u *userUsecase) UpdateUserPartnerPreferences(
 		updatePartnerPreferenceRequest.PreferredProfessionTypes = &professionTypes
 	}
 
+	if req.PreferredCountries != nil {
+		countries, err := validation.ParsePreferredCountries(*req.PreferredCountries)
+		if err != nil {
+			return apperrors.ErrInvalidCountry
+		}
+		updatePartnerPreferenceRequest.PreferredCountries = &countries
+	}
+
+	if req.PreferredResidenceCountries != nil {
+		residenceCountries, err := validation.ParsePreferredCountries(*req.PreferredResidenceCountries)
+		if err != nil {
+			return apperrors.ErrInvalidCountry
+		}
+		updatePartnerPreferenceRequest.PreferredResidenceCountries = &residenceCountries
+	}
+
+	if req.MinPoruthamScore != nil {
+		if *req.MinPoruthamScore < 0 || *req.MinPoruthamScore > porutham.MaxScore {
+			return apperrors.ErrInvalidMinPoruthamScore
+		}
+		updatePartnerPreferenceRequest.MinPoruthamScore = req.MinPoruthamScore
+	}
+
+	updatePartnerPreferenceRequest.AcceptDosham = req.AcceptDosham
+
+	if req.MustHaveCriteria != nil {
+		for _, criterion := range *req.MustHaveCriteria {
+			if !validation.IsValidMatchCriterion(criterion) {
+				return apperrors.ErrInvalidMatchCriterion
+			}
+		}
+		updatePartnerPreferenceRequest.MustHaveCriteria = req.MustHaveCriteria
+	}
+
 	return u.userClient.UpdateUserPartnerPreferences(ctx, operationType, updatePartnerPreferenceRequest)
 }
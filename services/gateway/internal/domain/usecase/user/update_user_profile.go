@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/dto"
 )
@@ -16,32 +17,34 @@ func (u *userUsecase) UpdateUserProfile(ctx context.Context, req dto.UserProfile
 		}
 	}
 
+	// Deactivated values are let through, the user service accepts them only
+	// when the profile already holds them
 	if req.Community != nil {
-		if !validation.IsValidCommunity(*req.Community) {
+		if !validation.IsKnownTaxonomyValue(validation.TaxonomyCommunity, *req.Community) {
 			return apperrors.ErrInvalidCommunity
 		}
 	}
 
 	if req.MaritalStatus != nil {
-		if !validation.IsValidMaritalStatus(*req.MaritalStatus) {
+		if !validation.IsKnownTaxonomyValue(validation.TaxonomyMaritalStatus, *req.MaritalStatus) {
 			return apperrors.ErrInvalidMaritalStatus
 		}
 	}
 
 	if req.Profession != nil {
-		if !validation.IsValidProfession(*req.Profession) {
+		if !validation.IsKnownTaxonomyValue(validation.TaxonomyProfession, *req.Profession) {
 			return apperrors.ErrInvalidProfession
 		}
 	}
 
 	if req.ProfessionType != nil {
-		if !validation.IsValidProfessionType(*req.ProfessionType) {
+		if !validation.IsKnownTaxonomyValue(validation.TaxonomyProfessionType, *req.ProfessionType) {
 			return apperrors.ErrInvalidProfessionType
 		}
 	}
 
 	if req.HighestEducationLevel != nil {
-		if !validation.IsValidEducationLevel(*req.HighestEducationLevel) {
+		if !validation.IsKnownTaxonomyValue(validation.TaxonomyEducationLevel, *req.HighestEducationLevel) {
 			return apperrors.ErrInvalidEducationLevel
 		}
 	}
@@ -64,5 +67,66 @@ func (u *userUsecase) UpdateUserProfile(ctx context.Context, req dto.UserProfile
 		}
 	}
 
+	// Horoscope fields can be cleared with an empty string
+	if req.BirthTime != nil && *req.BirthTime != "" {
+		if !validation.IsValidBirthTime(*req.BirthTime) {
+			return apperrors.ErrInvalidBirthTime
+		}
+	}
+
+	if req.BirthPlace != nil {
+		if !validation.IsValidBirthPlace(*req.BirthPlace) {
+			return apperrors.ErrInvalidBirthPlace
+		}
+	}
+
+	if req.Nakshatra != nil && *req.Nakshatra != "" {
+		if !validation.IsValidNakshatra(*req.Nakshatra) {
+			return apperrors.ErrInvalidNakshatra
+		}
+	}
+
+	if req.Rasi != nil && *req.Rasi != "" {
+		if !validation.IsValidRasi(*req.Rasi) {
+			return apperrors.ErrInvalidRasi
+		}
+	}
+
+	for _, location := range []*dto.Location{req.NativePlace, req.Residence} {
+		if err := validateLocation(location); err != nil {
+			return err
+		}
+	}
+
+	if req.PreferredLanguage != nil {
+		if _, ok := i18n.ParseLocale(*req.PreferredLanguage); !ok {
+			return apperrors.ErrInvalidLocale
+		}
+	}
+
+	if req.DigestFrequency != nil && !validation.IsValidDigestFrequency(*req.DigestFrequency) {
+		return apperrors.ErrInvalidDigestFrequency
+	}
+
 	return u.userClient.UpdateUserProfile(ctx, req)
 }
+
+// validateLocation checks a location the way the user service stores it, an
+// empty country clears the location.
+func validateLocation(location *dto.Location) error {
+	if location == nil {
+		return nil
+	}
+	country := validation.NormalizeCountryCode(location.Country)
+	if country != "" && !validation.IsValidCountryCode(country) {
+		return apperrors.ErrInvalidCountry
+	}
+	if !validation.IsValidLocation(
+		country,
+		validation.NormalizeLocationName(location.State),
+		validation.NormalizeLocationName(location.District),
+		validation.NormalizeLocationName(location.City)) {
+		return apperrors.ErrInvalidLocation
+	}
+	return nil
+}
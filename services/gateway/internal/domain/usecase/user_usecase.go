@@ -9,7 +9,7 @@ import (
 type UserUsecase interface {
 	///////// USER PROFILE MANAGEMENT //////////
 	UpdateUserProfile(ctx context.Context, req dto.UserProfilePatchRequest) error
-	GetUserProfile(ctx context.Context) (*dto.UserProfileRecommendation, error)
+	GetUserProfile(ctx context.Context) (*dto.GetUserProfileResponse, error)
 	GetProfilePhotoUploadURL(ctx context.Context, req dto.GetProfilePhotoUploadURLRequest) (*dto.GetProfilePhotoUploadURLResponse, error)
 	ConfirmProfilePhotoUpload(ctx context.Context, req dto.ConfirmProfilePhotoUploadRequest) (*dto.ConfirmProfilePhotoUploadResponse, error)
 	DeleteProfilePhoto(ctx context.Context, req dto.DeleteProfilePhotoRequest) error
@@ -18,6 +18,8 @@ type UserUsecase interface {
 	GetAdditionalPhotoUploadURL(ctx context.Context, req dto.GetAdditionalPhotoUploadURLRequest) (*dto.GetAdditionalPhotoUploadURLResponse, error)
 	ConfirmAdditionalPhotoUpload(ctx context.Context, req dto.ConfirmAdditionalPhotoUploadRequest) (*dto.ConfirmAdditionalPhotoUploadResponse, error)
 	DeleteAdditionalPhoto(ctx context.Context, req dto.DeleteAdditionalPhotoRequest) (*dto.DeleteAdditionalPhotoResponse, error)
+	ReorderAdditionalPhotos(ctx context.Context, req dto.ReorderAdditionalPhotosRequest) (*dto.ReorderAdditionalPhotosResponse, error)
+	SetPrimaryPhoto(ctx context.Context, req dto.SetPrimaryPhotoRequest) (*dto.SetPrimaryPhotoResponse, error)
 	GetAdditionalPhotos(ctx context.Context) (*dto.GetAdditionalPhotosResponse, error)
 	
 	///////// PARTNER PREFERENCES MANAGEMENT //////////
@@ -26,9 +28,30 @@ type UserUsecase interface {
 
 	///////// MATCH MAKING //////////
 	RecordMatchAction(ctx context.Context, req dto.RecordMatchActionRequest) (*dto.RecordMatchActionResponse, error)
+	UndoLastMatchAction(ctx context.Context) (*dto.UndoLastMatchActionResponse, error)
 	GetMatchRecommendations(ctx context.Context, req dto.GetMatchRecommendationsRequest) (*dto.GetMatchRecommendationsResponse, error)
 	GetProfilesByMatchAction(ctx context.Context, req dto.GetProfilesByMatchActionRequest) (*dto.GetProfilesByMatchActionResponse, error)
+	GetReceivedInterests(ctx context.Context, req dto.GetReceivedInterestsRequest) (*dto.GetReceivedInterestsResponse, error)
+	GetReceivedInterestCounts(ctx context.Context) (*dto.GetReceivedInterestCountsResponse, error)
+	RespondToInterest(ctx context.Context, req dto.RespondToInterestRequest) (*dto.RespondToInterestResponse, error)
 
 	///////// USER DETAILS MANAGEMENT //////////
 	GetUserDetailsByProfileID(ctx context.Context, req dto.GetUserDetailsByProfileIDRequest) (*dto.GetUserDetailsByProfileIDResponse, error)
+
+	///////// USER BLOCKING //////////
+	BlockOrUnblockProfile(ctx context.Context, req dto.BlockOrUnblockProfileRequest) (*dto.BlockOrUnblockProfileResponse, error)
+	ReportProfile(ctx context.Context, req dto.ReportProfileRequest) (*dto.ReportProfileResponse, error)
+	GetProfileVisitors(ctx context.Context, req dto.GetProfileVisitorsRequest) (*dto.GetProfileVisitorsResponse, error)
+	AddToShortlist(ctx context.Context, req dto.AddToShortlistRequest) (*dto.ShortlistResponse, error)
+	RemoveFromShortlist(ctx context.Context, req dto.RemoveFromShortlistRequest) (*dto.ShortlistResponse, error)
+	GetShortlist(ctx context.Context, req dto.GetShortlistRequest) (*dto.GetShortlistResponse, error)
+
+	///////// SEARCH //////////
+	SearchProfiles(ctx context.Context, req dto.SearchProfilesRequest) (*dto.SearchProfilesResponse, error)
+	CreateSavedSearch(ctx context.Context, req dto.CreateSavedSearchRequest) (*dto.CreateSavedSearchResponse, error)
+	GetSavedSearches(ctx context.Context) (*dto.GetSavedSearchesResponse, error)
+	DeleteSavedSearch(ctx context.Context, req dto.DeleteSavedSearchRequest) (*dto.DeleteSavedSearchResponse, error)
+
+	///////// REFERENCE DATA //////////
+	GetReferenceOptions(ctx context.Context, req dto.GetReferenceOptionsRequest) (*dto.GetReferenceOptionsResponse, error)
 }
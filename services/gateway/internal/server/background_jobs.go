@@ -0,0 +1,43 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"go.uber.org/zap"
+)
+
+// runReferenceDataRefresh loads the admin-managed profile options at startup
+// and then on every interval, so request validation follows changes made in
+// the user service. Until the first load succeeds the built-in values apply.
+func (s *Server) runReferenceDataRefresh(ctx context.Context) {
+	refresh := func() {
+		// The user service expects a request ID on every call.
+		reqCtx := context.WithValue(ctx, constants.ContextKeyRequestID, uuid.NewString())
+		if err := s.adminUsecase.RefreshValidationCache(reqCtx); err != nil {
+			s.logger.Error("failed to refresh reference data", zap.Error(err))
+		}
+	}
+
+	refresh()
+
+	interval := s.config.ReferenceData.CacheRefreshInterval
+	if interval <= 0 {
+		s.logger.Info("reference data refresh disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			refresh()
+		}
+	}
+}
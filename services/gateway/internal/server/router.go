@@ -13,6 +13,7 @@ import (
 func (s *Server) setupRoutes(router *gin.Engine) {
 	middleware.RegisterMetrics()
 	router.Use(middleware.RequestIDMiddleware())
+	router.Use(middleware.LocaleMiddleware(s.userClient))
 	// Prometheus middleware SHOULD NOT register collectors; it should only observe them.
     router.Use(middleware.PrometheusMiddleware())
 
@@ -38,6 +39,7 @@ func (s *Server) setupRoutes(router *gin.Engine) {
 	s.registerPaymentRoutes(v1, router)
 	s.registerChatRoutes(v1)
 	s.registerUserRoutes(v1)
+	s.registerAdminRoutes(v1)
 
 }
 
@@ -139,6 +141,10 @@ func (s *Server) registerUserRoutes(v1 *gin.RouterGroup) {
 			middleware.AuthMiddleware(s.jwtManager),
 			middleware.RequireRole(constants.RoleUser),
 			s.userHandler.GetUserProfile)
+		user.GET("/profile/visitors",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RolePremiumUser),
+			s.userHandler.GetProfileVisitors)
 
 		// user full details retrieve (profile,partner pre, images)
 		user.GET("/profiles/:profile_id", 
@@ -174,6 +180,14 @@ func (s *Server) registerUserRoutes(v1 *gin.RouterGroup) {
 			middleware.AuthMiddleware(s.jwtManager),
 			middleware.RequireRole(constants.RoleUser),
 			s.userHandler.DeleteAdditionalPhoto)
+		user.POST("/profile/additional-photo/:display_order/make-primary",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.SetPrimaryPhoto)
+		user.PUT("/profile/additional-photos/order",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.ReorderAdditionalPhotos)
 		user.GET("/profile/additional-photos",
 			middleware.AuthMiddleware(s.jwtManager),
 			middleware.RequireRole(constants.RoleUser),
@@ -202,6 +216,10 @@ func (s *Server) registerUserRoutes(v1 *gin.RouterGroup) {
 			middleware.AuthMiddleware(s.jwtManager),
 			middleware.RequireRole(constants.RoleUser),
 			s.userHandler.PutRecordMatchAction)
+		user.POST("/match-action/undo",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.UndoLastMatchAction)
 		user.GET("/matches/liked",
 			middleware.AuthMiddleware(s.jwtManager),
 			middleware.RequireRole(constants.RoleUser),
@@ -214,9 +232,71 @@ func (s *Server) registerUserRoutes(v1 *gin.RouterGroup) {
 			middleware.AuthMiddleware(s.jwtManager),
 			middleware.RequireRole(constants.RoleUser),
 			s.userHandler.GetMutuallyMatchedProfiles)
+		user.GET("/interests/received",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RolePremiumUser),
+			s.userHandler.GetReceivedInterests)
+		user.GET("/interests/received/count",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.GetReceivedInterestCounts)
+		user.POST("/interests/received/:profile_id/accept",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.AcceptInterest)
+		user.POST("/interests/received/:profile_id/decline",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.DeclineInterest)
+
+		user.POST("/block",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.BlockProfile)
+		user.POST("/unblock",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.UnblockProfile)
+		user.POST("/report",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.ReportProfile)
+
+		user.POST("/shortlist",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.AddToShortlist)
+		user.DELETE("/shortlist/:profile_id",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.RemoveFromShortlist)
+		user.GET("/shortlist",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.GetShortlist)
+
+		user.POST("/search",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.SearchProfiles)
+		user.POST("/saved-searches",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.CreateSavedSearch)
+		user.GET("/saved-searches",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.GetSavedSearches)
+		user.DELETE("/saved-searches/:saved_search_id",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.userHandler.DeleteSavedSearch)
 		
 		
 	}
+
+	// Public, the option lists are needed on the registration forms.
+	v1.GET("/reference-data", s.userHandler.GetReferenceOptions)
 }
 
 func (s *Server) registerChatRoutes(v1 *gin.RouterGroup) {
@@ -230,6 +310,52 @@ func (s *Server) registerChatRoutes(v1 *gin.RouterGroup) {
 			middleware.AuthMiddleware(s.jwtManager),
 			middleware.RequireRole(constants.RolePremiumUser),
 			s.chatHandler.GetMessagesByConversationId)
+		chat.POST("/report-message",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleUser),
+			s.chatHandler.ReportMessage)
 		chat.GET("/ws", s.chatHandler.HandleWebSocket)
 	}
 }
+
+func (s *Server) registerAdminRoutes(v1 *gin.RouterGroup) {
+	admin := v1.Group("/admin")
+	{
+		admin.GET("/reports",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleAdmin),
+			s.adminHandler.GetReports)
+		admin.POST("/reports/:report_id/action",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleAdmin),
+			s.adminHandler.ResolveReport)
+		admin.GET("/photos/pending",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleAdmin),
+			s.adminHandler.GetPendingPhotos)
+		admin.POST("/photos/review",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleAdmin),
+			s.adminHandler.ReviewPhoto)
+		admin.GET("/profile-history",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleAdmin),
+			s.adminHandler.GetProfileHistory)
+		admin.GET("/reference-data",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleAdmin),
+			s.adminHandler.GetReferenceOptions)
+		admin.POST("/reference-data",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleAdmin),
+			s.adminHandler.CreateReferenceOption)
+		admin.PATCH("/reference-data/:option_id",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleAdmin),
+			s.adminHandler.UpdateReferenceOption)
+		admin.DELETE("/reference-data/:option_id",
+			middleware.AuthMiddleware(s.jwtManager),
+			middleware.RequireRole(constants.RoleAdmin),
+			s.adminHandler.DeleteReferenceOption)
+	}
+}
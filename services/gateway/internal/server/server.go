@@ -3,11 +3,13 @@ package server
 import (
 	"context"
 	"fmt"
+	"html/template"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	constants "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/security/jwt"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/config"
 
@@ -20,12 +22,14 @@ import (
 
 	// Usecase imports
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/usecase"
+	adminUsecase "github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/usecase/admin"
 	authUsecase "github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/usecase/auth"
 	chatUsecase "github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/usecase/chat"
 	paymentUsecase "github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/usecase/payment"
 	userUsecase "github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/domain/usecase/user"
 
 	// Handler imports
+	adminHandler "github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/delivery/http/v1/admin"
 	authHandler "github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/delivery/http/v1/auth"
 	chatHandler "github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/delivery/http/v1/chat"
 	paymentHandler "github.com/mohamedfawas/quboolkallyanam.xyz/services/gateway/internal/delivery/http/v1/payment"
@@ -50,12 +54,14 @@ type Server struct {
 	paymentUsecase usecase.PaymentUsecase
 	chatUsecase    usecase.ChatUsecase
 	userUsecase    usecase.UserUsecase
+	adminUsecase   usecase.AdminUsecase
 
 	// Handlers
 	authHandler    *authHandler.AuthHandler
 	paymentHandler *paymentHandler.PaymentHandler
 	chatHandler    *chatHandler.ChatHandler
 	userHandler    *userHandler.UserHandler
+	adminHandler   *adminHandler.AdminHandler
 }
 
 func NewHTTPServer(ctx context.Context, config *config.Config, rootLogger *zap.Logger) (*Server, error) {
@@ -93,9 +99,19 @@ func NewHTTPServer(ctx context.Context, config *config.Config, rootLogger *zap.L
 	}
 	rootLogger.Info("Handlers initialized")
 
+	go server.runReferenceDataRefresh(ctx)
+
 	///////////////////////// ROUTES INITIALIZATION /////////////////////////
 	router := gin.New()
 	router.Use(gin.Recovery())
+	router.SetFuncMap(template.FuncMap{
+		"t": func(locale, key string) string {
+			return i18n.Text(i18n.Locale(locale), key)
+		},
+		"tf": func(locale, key string, args ...interface{}) string {
+			return i18n.Format(i18n.Locale(locale), key, args...)
+		},
+	})
 	router.LoadHTMLGlob("internal/web/templates/*")
 
 	server.setupRoutes(router)
@@ -173,6 +189,7 @@ func (s *Server) initUsecases() error {
 	s.paymentUsecase = paymentUsecase.NewPaymentUsecase(s.paymentClient, s.config)
 	s.chatUsecase = chatUsecase.NewChatUsecase(s.chatClient)
 	s.userUsecase = userUsecase.NewUserUsecase(s.userClient)
+	s.adminUsecase = adminUsecase.NewAdminUsecase(s.userClient, s.authClient)
 
 	return nil
 }
@@ -182,6 +199,7 @@ func (s *Server) initHandlers() error {
 	s.paymentHandler = paymentHandler.NewPaymentHandler(s.paymentUsecase, s.logger)
 	s.chatHandler = chatHandler.NewChatHandler(s.chatUsecase, s.logger, s.jwtManager)
 	s.userHandler = userHandler.NewUserHandler(s.userUsecase, s.logger)
+	s.adminHandler = adminHandler.NewAdminHandler(s.adminUsecase, s.logger)
 
 	return nil
 }
@@ -0,0 +1,12 @@
+package model
+
+// MatchDigestProfile is one profile card of a match digest email.
+type MatchDigestProfile struct {
+	ProfileID          int64
+	FullName           string
+	Age                int32
+	HeightCm           int32
+	Profession         string
+	HomeDistrict       string
+	CompatibilityScore int32
+}
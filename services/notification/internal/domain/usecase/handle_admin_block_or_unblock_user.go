@@ -1,20 +1,24 @@
-package usecase	
+package usecase
 
 import (
 	"context"
 
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/templates"
 )
 
+// The auth service does not know the user's language, so this email is
+// always sent in the default locale.
 func (n *notificationUsecase) HandleAdminBlockedUser(ctx context.Context,
 	userEmail string, shouldBlock bool) error {
 
+	locale := i18n.DefaultLocale
 	emailReq := model.EmailRequest{
 		To:      userEmail,
-		Subject: "Account reviewed by an admin",
-		Body:    templates.BuildAdminBlockOrUnblockUserStatusBody(userEmail, shouldBlock),
+		Subject: i18n.Text(locale, "email.account_status.subject"),
+		Body:    templates.BuildAdminBlockOrUnblockUserStatusBody(locale, userEmail, shouldBlock),
 	}
 
 	return n.emailAdapter.SendEmail(ctx, emailReq)
-}
\ No newline at end of file
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/templates"
+)
+
+func (n *notificationUsecase) HandleMatchDigest(ctx context.Context,
+	userEmail, fullName, frequency string,
+	profiles []model.MatchDigestProfile,
+	locale i18n.Locale) error {
+
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	subjectKey := "email.match_digest.subject.weekly"
+	if frequency == constants.DigestFrequencyDaily {
+		subjectKey = "email.match_digest.subject.daily"
+	}
+
+	emailReq := model.EmailRequest{
+		To:      userEmail,
+		Subject: i18n.Text(locale, subjectKey),
+		Body:    templates.BuildMatchDigestBody(locale, fullName, profiles),
+	}
+
+	return n.emailAdapter.SendEmail(ctx, emailReq)
+}
@@ -3,26 +3,26 @@ package usecase
 import (
 	"context"
 
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/templates"
 )
 
 func (n *notificationUsecase) HandleMutualMatchCreated(ctx context.Context,
-	user1Email string, user1ProfileID int64, user1FullName string,
-	user2Email string, user2ProfileID int64, user2FullName string) error {
-
-	body := templates.BuildMutualMatchCommonBody(user1FullName, user1ProfileID, user2FullName, user2ProfileID)
+	user1Email string, user1ProfileID int64, user1FullName string, user1Locale i18n.Locale,
+	user2Email string, user2ProfileID int64, user2FullName string, user2Locale i18n.Locale) error {
 
+	// Both users get the same email, each in their own language
 	emailReq1 := model.EmailRequest{
 		To:      user1Email,
-		Subject: "New mutual match on Qubool Kallyanam",
-		Body:    body,
+		Subject: i18n.Text(user1Locale, "email.mutual_match.subject"),
+		Body:    templates.BuildMutualMatchCommonBody(user1Locale, user1FullName, user1ProfileID, user2FullName, user2ProfileID),
 	}
 
 	emailReq2 := model.EmailRequest{
 		To:      user2Email,
-		Subject: "New mutual match on Qubool Kallyanam",
-		Body:    body,
+		Subject: i18n.Text(user2Locale, "email.mutual_match.subject"),
+		Body:    templates.BuildMutualMatchCommonBody(user2Locale, user1FullName, user1ProfileID, user2FullName, user2ProfileID),
 	}
 
 	if err := n.emailAdapter.SendEmail(ctx, emailReq1); err != nil {
@@ -30,4 +30,4 @@ func (n *notificationUsecase) HandleMutualMatchCreated(ctx context.Context,
 	}
 
 	return n.emailAdapter.SendEmail(ctx, emailReq2)
-}
\ No newline at end of file
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/templates"
+)
+
+// HandleMutualMatchRemoved tells the matched user, in neutral terms, that the
+// match has ended. The user who ended it gets no email.
+func (n *notificationUsecase) HandleMutualMatchRemoved(ctx context.Context,
+	userEmail, fullName, removedByFullName string,
+	locale i18n.Locale) error {
+
+	emailReq := model.EmailRequest{
+		To:      userEmail,
+		Subject: i18n.Text(locale, "email.mutual_match_removed.subject"),
+		Body:    templates.BuildMutualMatchRemovedBody(locale, fullName, removedByFullName),
+	}
+
+	return n.emailAdapter.SendEmail(ctx, emailReq)
+}
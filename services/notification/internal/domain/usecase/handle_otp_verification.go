@@ -2,21 +2,19 @@ package usecase
 
 import (
 	"context"
-	"strconv"
 
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/templates"
 )
 
 func (n *notificationUsecase) HandleOTPVerification(ctx context.Context,
-	userEmail, otp string, expiryMinutes int) error {
-
-	expiryMinutesStr := strconv.Itoa(expiryMinutes)
+	userEmail, otp string, expiryMinutes int, locale i18n.Locale) error {
 
 	emailReq := model.EmailRequest{
 		To:      userEmail,
-		Subject: "OTP Verification",
-		Body:    templates.BuildOTPVerificationBody(userEmail, otp, expiryMinutesStr),
+		Subject: i18n.Text(locale, "email.otp.subject"),
+		Body:    templates.BuildOTPVerificationBody(locale, userEmail, otp, expiryMinutes),
 	}
 
 	return n.emailAdapter.SendEmail(ctx, emailReq)
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/templates"
+)
+
+func (n *notificationUsecase) HandleSavedSearchMatched(ctx context.Context,
+	userEmail, fullName, searchName string,
+	matchCount int64,
+	locale i18n.Locale) error {
+
+	emailReq := model.EmailRequest{
+		To:      userEmail,
+		Subject: i18n.Text(locale, "email.saved_search_matched.subject"),
+		Body:    templates.BuildSavedSearchMatchedBody(locale, fullName, searchName, matchCount),
+	}
+
+	return n.emailAdapter.SendEmail(ctx, emailReq)
+}
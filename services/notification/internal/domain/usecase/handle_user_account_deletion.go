@@ -3,14 +3,18 @@ package usecase
 import (
 	"context"
 
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/templates"
 )
 
+// The profile and its language are gone by the time this is sent, so the
+// default locale is used.
 func (n *notificationUsecase) HandleUserAccountDeletion(ctx context.Context, userEmail string) error {
+	locale := i18n.DefaultLocale
 	return n.emailAdapter.SendEmail(ctx, model.EmailRequest{
 		To:      userEmail,
-		Subject: "Account Deletion Confirmation",
-		Body:    templates.BuildAccountDeletionBody(userEmail),
+		Subject: i18n.Text(locale, "email.account_deletion.subject"),
+		Body:    templates.BuildAccountDeletionBody(locale, userEmail),
 	})
-}
\ No newline at end of file
+}
@@ -0,0 +1,24 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/templates"
+)
+
+func (n *notificationUsecase) HandleUserPhotoRejected(ctx context.Context,
+	userEmail, fullName string,
+	displayOrder int32,
+	reason string,
+	locale i18n.Locale) error {
+
+	emailReq := model.EmailRequest{
+		To:      userEmail,
+		Subject: i18n.Text(locale, "email.photo_rejected.subject"),
+		Body:    templates.BuildUserPhotoRejectedBody(locale, fullName, displayOrder, reason),
+	}
+
+	return n.emailAdapter.SendEmail(ctx, emailReq)
+}
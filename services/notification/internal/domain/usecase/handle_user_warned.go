@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/templates"
+)
+
+func (n *notificationUsecase) HandleUserWarned(ctx context.Context,
+	userEmail, fullName, category string, locale i18n.Locale) error {
+
+	emailReq := model.EmailRequest{
+		To:      userEmail,
+		Subject: i18n.Text(locale, "email.user_warned.subject"),
+		Body:    templates.BuildUserWarnedBody(locale, fullName, category),
+	}
+
+	return n.emailAdapter.SendEmail(ctx, emailReq)
+}
@@ -3,18 +3,19 @@ package usecase
 import (
 	"context"
 
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/templates"
 )
 
 func (n *notificationUsecase) HandleUserInterestSent(ctx context.Context,
-	receiverEmail string, senderProfileID int64, senderName string) error {
+	receiverEmail string, senderProfileID int64, senderName string, receiverLocale i18n.Locale) error {
 
 	emailReq := model.EmailRequest{
 		To:      receiverEmail,
-		Subject: "New interest on Qubool Kallyanam",
-		Body:    templates.BuildInterestSentBody(receiverEmail, senderProfileID, senderName),
+		Subject: i18n.Text(receiverLocale, "email.interest_sent.subject"),
+		Body:    templates.BuildInterestSentBody(receiverLocale, receiverEmail, senderProfileID, senderName),
 	}
 
 	return n.emailAdapter.SendEmail(ctx, emailReq)
-}
\ No newline at end of file
+}
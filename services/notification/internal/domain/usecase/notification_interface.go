@@ -2,14 +2,22 @@ package usecase
 
 import (
 	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
 )
 
 type NotificationUsecase interface {
-	HandleOTPVerification(ctx context.Context, userEmail, otp string, expiryMinutes int) error
+	HandleOTPVerification(ctx context.Context, userEmail, otp string, expiryMinutes int, locale i18n.Locale) error
 	HandleUserAccountDeletion(ctx context.Context, userEmail string) error
 	HandleAdminBlockedUser(ctx context.Context, userEmail string, shouldBlock bool) error
-	HandleUserInterestSent(ctx context.Context, receiverEmail string, senderProfileID int64, senderName string) error
-	HandleMutualMatchCreated(ctx context.Context, 
-		user1Email string, user1ProfileID int64, user1FullName string, 
-		user2Email string, user2ProfileID int64, user2FullName string) error
+	HandleUserInterestSent(ctx context.Context, receiverEmail string, senderProfileID int64, senderName string, receiverLocale i18n.Locale) error
+	HandleMutualMatchCreated(ctx context.Context,
+		user1Email string, user1ProfileID int64, user1FullName string, user1Locale i18n.Locale,
+		user2Email string, user2ProfileID int64, user2FullName string, user2Locale i18n.Locale) error
+	HandleMutualMatchRemoved(ctx context.Context, userEmail, fullName, removedByFullName string, locale i18n.Locale) error
+	HandleUserWarned(ctx context.Context, userEmail, fullName, category string, locale i18n.Locale) error
+	HandleUserPhotoRejected(ctx context.Context, userEmail, fullName string, displayOrder int32, reason string, locale i18n.Locale) error
+	HandleSavedSearchMatched(ctx context.Context, userEmail, fullName, searchName string, matchCount int64, locale i18n.Locale) error
+	HandleMatchDigest(ctx context.Context, userEmail, fullName, frequency string, profiles []model.MatchDigestProfile, locale i18n.Locale) error
 }
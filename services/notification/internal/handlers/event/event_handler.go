@@ -7,7 +7,9 @@ import (
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	authEvents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/auth"
 	userEvents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/user"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 	messageBroker "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/messagebroker"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/usecase"
 	"go.uber.org/zap"
 )
@@ -56,6 +58,26 @@ func (h *EventHandler) StartListening(ctx context.Context) error {
 			topic:   constants.EventMutualMatchCreated,
 			handler: h.createMutualMatchCreatedHandler(ctx),
 		},
+		{
+			topic:   constants.EventMutualMatchRemoved,
+			handler: h.createMutualMatchRemovedHandler(ctx),
+		},
+		{
+			topic:   constants.EventUserWarned,
+			handler: h.createUserWarnedHandler(ctx),
+		},
+		{
+			topic:   constants.EventUserPhotoRejected,
+			handler: h.createUserPhotoRejectedHandler(ctx),
+		},
+		{
+			topic:   constants.EventSavedSearchMatched,
+			handler: h.createSavedSearchMatchedHandler(ctx),
+		},
+		{
+			topic:   constants.EventMatchDigest,
+			handler: h.createMatchDigestHandler(ctx),
+		},
 	}
 
 	for _, sub := range subscriptions {
@@ -77,7 +99,7 @@ func (h *EventHandler) createUserOTPRequestedHandler(ctx context.Context) messag
 			return err
 		}
 
-		return h.notificationUsecase.HandleOTPVerification(ctx, eventBody.Email, eventBody.OTP, eventBody.ExpiryMinutes)
+		return h.notificationUsecase.HandleOTPVerification(ctx, eventBody.Email, eventBody.OTP, eventBody.ExpiryMinutes, i18n.Resolve(eventBody.Locale))
 	}
 }
 
@@ -116,7 +138,7 @@ func (h *EventHandler) createUserInterestSentHandler(ctx context.Context) messag
 			return err
 		}
 
-		return h.notificationUsecase.HandleUserInterestSent(ctx, eventBody.ReceiverEmail, eventBody.SenderProfileID, eventBody.SenderName)
+		return h.notificationUsecase.HandleUserInterestSent(ctx, eventBody.ReceiverEmail, eventBody.SenderProfileID, eventBody.SenderName, i18n.Resolve(eventBody.ReceiverLocale))
 	}
 }
 
@@ -130,7 +152,89 @@ func (h *EventHandler) createMutualMatchCreatedHandler(ctx context.Context) mess
 		}
 
 		return h.notificationUsecase.HandleMutualMatchCreated(ctx, 
-			eventBody.User1Email, eventBody.User1ProfileID, eventBody.User1FullName, 
-			eventBody.User2Email, eventBody.User2ProfileID, eventBody.User2FullName)
+			eventBody.User1Email, eventBody.User1ProfileID, eventBody.User1FullName, i18n.Resolve(eventBody.User1Locale),
+			eventBody.User2Email, eventBody.User2ProfileID, eventBody.User2FullName, i18n.Resolve(eventBody.User2Locale))
+	}
+}
+
+func (h *EventHandler) createUserWarnedHandler(ctx context.Context) messageBroker.MessageHandler {
+	return func(body []byte) error {
+		var eventBody userEvents.UserWarnedEvent
+
+		if err := json.Unmarshal(body, &eventBody); err != nil {
+			h.logger.Error("Error unmarshalling event", zap.Error(err))
+			return err
+		}
+
+		return h.notificationUsecase.HandleUserWarned(ctx, eventBody.Email, eventBody.FullName, eventBody.Category, i18n.Resolve(eventBody.Locale))
+	}
+}
+
+func (h *EventHandler) createUserPhotoRejectedHandler(ctx context.Context) messageBroker.MessageHandler {
+	return func(body []byte) error {
+		var eventBody userEvents.UserPhotoRejectedEvent
+
+		if err := json.Unmarshal(body, &eventBody); err != nil {
+			h.logger.Error("Error unmarshalling event", zap.Error(err))
+			return err
+		}
+
+		return h.notificationUsecase.HandleUserPhotoRejected(ctx,
+			eventBody.Email, eventBody.FullName, eventBody.DisplayOrder, eventBody.Reason, i18n.Resolve(eventBody.Locale))
 	}
-}
\ No newline at end of file
+}
+
+func (h *EventHandler) createMutualMatchRemovedHandler(ctx context.Context) messageBroker.MessageHandler {
+	return func(body []byte) error {
+		var eventBody userEvents.MutualMatchRemovedEvent
+
+		if err := json.Unmarshal(body, &eventBody); err != nil {
+			h.logger.Error("Error unmarshalling event", zap.Error(err))
+			return err
+		}
+
+		return h.notificationUsecase.HandleMutualMatchRemoved(ctx,
+			eventBody.MatchedUserEmail, eventBody.MatchedUserFullName, eventBody.RemovedByFullName, i18n.Resolve(eventBody.MatchedUserLocale))
+	}
+}
+
+func (h *EventHandler) createSavedSearchMatchedHandler(ctx context.Context) messageBroker.MessageHandler {
+	return func(body []byte) error {
+		var eventBody userEvents.SavedSearchMatchedEvent
+
+		if err := json.Unmarshal(body, &eventBody); err != nil {
+			h.logger.Error("Error unmarshalling event", zap.Error(err))
+			return err
+		}
+
+		return h.notificationUsecase.HandleSavedSearchMatched(ctx,
+			eventBody.Email, eventBody.FullName, eventBody.SearchName, eventBody.MatchCount, i18n.Resolve(eventBody.Locale))
+	}
+}
+
+func (h *EventHandler) createMatchDigestHandler(ctx context.Context) messageBroker.MessageHandler {
+	return func(body []byte) error {
+		var eventBody userEvents.MatchDigestEvent
+
+		if err := json.Unmarshal(body, &eventBody); err != nil {
+			h.logger.Error("Error unmarshalling event", zap.Error(err))
+			return err
+		}
+
+		profiles := make([]model.MatchDigestProfile, len(eventBody.Profiles))
+		for i, p := range eventBody.Profiles {
+			profiles[i] = model.MatchDigestProfile{
+				ProfileID:          p.ProfileID,
+				FullName:           p.FullName,
+				Age:                p.Age,
+				HeightCm:           p.HeightCm,
+				Profession:         p.Profession,
+				HomeDistrict:       p.HomeDistrict,
+				CompatibilityScore: p.CompatibilityScore,
+			}
+		}
+
+		return h.notificationUsecase.HandleMatchDigest(ctx,
+			eventBody.Email, eventBody.FullName, eventBody.Frequency, profiles, i18n.Resolve(eventBody.Locale))
+	}
+}
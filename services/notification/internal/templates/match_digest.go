@@ -0,0 +1,18 @@
+package templates
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/notification/internal/domain/model"
+)
+
+func BuildMatchDigestBody(locale i18n.Locale, fullName string, profiles []model.MatchDigestProfile) string {
+	cards := make([]string, len(profiles))
+	for i, p := range profiles {
+		cards[i] = i18n.Format(locale, "email.match_digest.profile_card",
+			p.FullName, p.ProfileID, p.Age, p.HeightCm, p.Profession, p.HomeDistrict, p.CompatibilityScore)
+	}
+
+	return i18n.Format(locale, "email.match_digest.body", fullName, strings.Join(cards, "\n\n"))
+}
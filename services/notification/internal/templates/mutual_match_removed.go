@@ -0,0 +1,7 @@
+package templates
+
+import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+
+func BuildMutualMatchRemovedBody(locale i18n.Locale, fullName, removedByFullName string) string {
+	return i18n.Format(locale, "email.mutual_match_removed.body", fullName, removedByFullName)
+}
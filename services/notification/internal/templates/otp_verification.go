@@ -1,23 +1,7 @@
 package templates
 
-import "fmt"
+import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 
-func BuildOTPVerificationBody(email, otp, expiryMinutes string) string {
-	return fmt.Sprintf(
-		`Hello %s,
-
-Your One-Time Password (OTP) for verifying your account on Qubool Kallyanam is:
-
-	%s
-
-Please enter this OTP to complete your verification. It will expire in %s minutes.
-
-If you didn't request this, you can safely ignore this email.
-
-Regards,  
-Team Qubool Kallyanam`,
-		email,
-		otp,
-		expiryMinutes,
-	)
-}
\ No newline at end of file
+func BuildOTPVerificationBody(locale i18n.Locale, email, otp string, expiryMinutes int) string {
+	return i18n.Format(locale, "email.otp.body", email, otp, expiryMinutes)
+}
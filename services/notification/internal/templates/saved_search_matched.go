@@ -0,0 +1,7 @@
+package templates
+
+import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+
+func BuildSavedSearchMatchedBody(locale i18n.Locale, fullName, searchName string, matchCount int64) string {
+	return i18n.Format(locale, "email.saved_search_matched.body", fullName, matchCount, searchName)
+}
@@ -1,20 +1,7 @@
 package templates
 
-import "fmt"
+import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 
-
-func BuildAccountDeletionBody(email string) string {
-    return fmt.Sprintf(
-        `Hello %s,
-
-We are writing to confirm that your account on Qubool Kallyanam has been successfully deleted.
-
-Thank you for having been part of our community. We are sorry to see you go, and we hope our paths cross again in the future. If you have any feedback or questions, feel free to reach out to us at support@quboolkallyanam.xyz.
-
-Wishing you all the best in your journey ahead!
-
-Warm regards,  
-Team Qubool Kallyanam`,
-        email,
-    )
+func BuildAccountDeletionBody(locale i18n.Locale, email string) string {
+	return i18n.Format(locale, "email.account_deletion.body", email)
 }
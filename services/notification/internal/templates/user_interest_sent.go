@@ -1,23 +1,7 @@
 package templates
 
-import "fmt"
+import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 
-func BuildInterestSentBody(receiverEmail string, senderProfileID int64, senderFullName string) string {
-	return fmt.Sprintf(
-		`Hello %s,
-
-You have received a new interest on Qubool Kallyanam!
-
-%s (Profile ID: %d) has expressed interest in your profile.
-
-To view their profile and respond, please log in to your Qubool Kallyanam account.
-
-If you did not expect this or need help, please contact us at support@quboolkallyanam.xyz.
-
-Warm regards,
-Team Qubool Kallyanam`,
-		receiverEmail,
-		senderFullName,
-		senderProfileID,
-	)
+func BuildInterestSentBody(locale i18n.Locale, receiverEmail string, senderProfileID int64, senderFullName string) string {
+	return i18n.Format(locale, "email.interest_sent.body", receiverEmail, senderFullName, senderProfileID)
 }
@@ -0,0 +1,12 @@
+package templates
+
+import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+
+func BuildUserPhotoRejectedBody(locale i18n.Locale, fullName string, displayOrder int32, reason string) string {
+	photo := i18n.Text(locale, "email.photo_rejected.profile_photo")
+	if displayOrder > 0 {
+		photo = i18n.Format(locale, "email.photo_rejected.additional_photo", displayOrder)
+	}
+
+	return i18n.Format(locale, "email.photo_rejected.body", fullName, photo, reason)
+}
@@ -0,0 +1,13 @@
+package templates
+
+import (
+	"strings"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
+)
+
+func BuildUserWarnedBody(locale i18n.Locale, fullName, category string) string {
+	reason := strings.ReplaceAll(category, "_", " ")
+
+	return i18n.Format(locale, "email.user_warned.body", fullName, reason)
+}
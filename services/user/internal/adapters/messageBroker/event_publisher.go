@@ -65,3 +65,83 @@ func (p *eventPublisher) PublishMutualMatchCreated(
 	}
 	return nil
 }
+
+func (p *eventPublisher) PublishMutualMatchRemoved(
+	ctx context.Context,
+	event userevents.MutualMatchRemovedEvent) error {
+
+	if err := p.messagingClient.Publish(constants.EventMutualMatchRemoved, event); err != nil {
+		p.logger.Error("failed to publish mutual match removed event",
+			zap.String(constants.UserIDS, event.RemovedByUserID.String()),
+			zap.String(constants.UserIDS, event.MatchedUserID.String()),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (p *eventPublisher) PublishUserBlockUpdated(
+	ctx context.Context,
+	event userevents.UserBlockUpdatedEvent) error {
+
+	if err := p.messagingClient.Publish(constants.EventUserBlockUpdated, event); err != nil {
+		p.logger.Error("failed to publish user block updated event",
+			zap.String(constants.UserIDS, event.BlockerUserID.String()),
+			zap.String(constants.UserIDS, event.BlockedUserID.String()),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (p *eventPublisher) PublishUserWarned(
+	ctx context.Context,
+	event userevents.UserWarnedEvent) error {
+
+	if err := p.messagingClient.Publish(constants.EventUserWarned, event); err != nil {
+		p.logger.Error("failed to publish user warned event",
+			zap.String(constants.UserIDS, event.UserID.String()),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (p *eventPublisher) PublishUserPhotoRejected(
+	ctx context.Context,
+	event userevents.UserPhotoRejectedEvent) error {
+
+	if err := p.messagingClient.Publish(constants.EventUserPhotoRejected, event); err != nil {
+		p.logger.Error("failed to publish user photo rejected event",
+			zap.String(constants.UserIDS, event.UserID.String()),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (p *eventPublisher) PublishSavedSearchMatched(
+	ctx context.Context,
+	event userevents.SavedSearchMatchedEvent) error {
+
+	if err := p.messagingClient.Publish(constants.EventSavedSearchMatched, event); err != nil {
+		p.logger.Error("failed to publish saved search matched event",
+			zap.String(constants.UserIDS, event.UserID.String()),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (p *eventPublisher) PublishMatchDigest(
+	ctx context.Context,
+	event userevents.MatchDigestEvent) error {
+
+	if err := p.messagingClient.Publish(constants.EventMatchDigest, event); err != nil {
+		p.logger.Error("failed to publish match digest event",
+			zap.String(constants.UserIDS, event.UserID.String()),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/redis"
+	mediastorage "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/mediastorage"
+)
+
+const downloadURLKeyPrefix = "photo_url:"
+
+// cachedPhotoStorage keeps signed download URLs in redis so the same photo is
+// not signed again on every request. A URL is dropped margin before it
+// expires, a client is never handed a URL that is about to stop working.
+type cachedPhotoStorage struct {
+	mediastorage.PhotoStorage
+	redisClient *redis.Client
+	margin      time.Duration
+}
+
+func NewCachedPhotoStorage(
+	photoStorage mediastorage.PhotoStorage,
+	redisClient *redis.Client,
+	margin time.Duration) mediastorage.PhotoStorage {
+
+	return &cachedPhotoStorage{
+		PhotoStorage: photoStorage,
+		redisClient:  redisClient,
+		margin:       margin,
+	}
+}
+
+// GetDownloadURL falls back to signing a new URL whenever redis fails, the
+// cache only saves work.
+func (s *cachedPhotoStorage) GetDownloadURL(ctx context.Context, objectKey string, expiry time.Duration) (string, error) {
+	ttl := expiry - s.margin
+	if ttl <= 0 {
+		return s.PhotoStorage.GetDownloadURL(ctx, objectKey, expiry)
+	}
+
+	// The expiry is part of the key, a URL signed for a shorter time is never
+	// served to a caller asking for a longer one
+	key := fmt.Sprintf("%s%d:%s", downloadURLKeyPrefix, int64(expiry.Seconds()), objectKey)
+	if url, err := s.redisClient.Get(ctx, key); err == nil && url != "" {
+		return url, nil
+	}
+
+	url, err := s.PhotoStorage.GetDownloadURL(ctx, objectKey, expiry)
+	if err != nil {
+		return "", err
+	}
+	_ = s.redisClient.Set(ctx, key, url, ttl)
+	return url, nil
+}
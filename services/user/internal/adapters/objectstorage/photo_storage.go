@@ -0,0 +1,117 @@
+package objectstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	objectstore "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/mediastorage"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/gcsutil"
+	mediastorage "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/mediastorage"
+)
+
+// photoStorageAdapter maps photo operations onto whichever object store is
+// configured (gcs, local or s3).
+type photoStorageAdapter struct {
+	store objectstore.ObjectStore
+}
+
+func NewPhotoStorageAdapter(store objectstore.ObjectStore) mediastorage.PhotoStorage {
+	return &photoStorageAdapter{
+		store: store,
+	}
+}
+
+func (a *photoStorageAdapter) GetProfilePhotoUploadURL(
+	ctx context.Context,
+	userID string,
+	contentType string,
+	expiry time.Duration) (*mediastorage.PhotoUploadURLResponse, error) {
+
+	objectKey := gcsutil.ProfilePhotoObjectKey(userID, uuid.NewString())
+
+	uploadURL, err := a.store.GetUploadURL(ctx, objectKey, contentType, expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mediastorage.PhotoUploadURLResponse{
+		UploadURL:        uploadURL,
+		ObjectKey:        objectKey,
+		ExpiresInSeconds: uint32(expiry.Seconds()),
+	}, nil
+}
+
+func (a *photoStorageAdapter) GetAdditionalPhotoUploadURL(
+	ctx context.Context,
+	userID string,
+	displayOrder int32,
+	contentType string,
+	expiry time.Duration) (*mediastorage.PhotoUploadURLResponse, error) {
+
+	objectKey := gcsutil.AdditionalPhotoObjectKey(userID, displayOrder, uuid.NewString())
+
+	uploadURL, err := a.store.GetUploadURL(ctx, objectKey, contentType, expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mediastorage.PhotoUploadURLResponse{
+		UploadURL:        uploadURL,
+		ObjectKey:        objectKey,
+		ExpiresInSeconds: uint32(expiry.Seconds()),
+	}, nil
+}
+
+func (a *photoStorageAdapter) GetDownloadURL(ctx context.Context, objectKey string, expiry time.Duration) (string, error) {
+	return a.store.GetDownloadURL(ctx, objectKey, expiry)
+}
+
+func (a *photoStorageAdapter) GetPhotoMetadata(ctx context.Context, objectKey string) (*mediastorage.PhotoMetadata, error) {
+	info, err := a.store.Stat(ctx, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return nil, nil
+	}
+	return &mediastorage.PhotoMetadata{
+		ObjectKey:   info.Key,
+		ContentType: info.ContentType,
+		Size:        info.Size,
+		CreatedAt:   info.Created,
+	}, nil
+}
+
+func (a *photoStorageAdapter) ListPhotos(
+	ctx context.Context,
+	prefix string,
+	pageSize int,
+	fn func([]mediastorage.PhotoMetadata) error) error {
+
+	return a.store.List(ctx, prefix, pageSize, func(objects []objectstore.ObjectInfo) error {
+		photos := make([]mediastorage.PhotoMetadata, 0, len(objects))
+		for _, object := range objects {
+			photos = append(photos, mediastorage.PhotoMetadata{
+				ObjectKey:   object.Key,
+				ContentType: object.ContentType,
+				Size:        object.Size,
+				CreatedAt:   object.Created,
+			})
+		}
+		return fn(photos)
+	})
+}
+
+func (a *photoStorageAdapter) DownloadPhoto(ctx context.Context, objectKey string) ([]byte, error) {
+	return a.store.Download(ctx, objectKey, constants.ImageFileMaxSize)
+}
+
+func (a *photoStorageAdapter) UploadPhoto(ctx context.Context, objectKey string, contentType string, data []byte) error {
+	return a.store.Upload(ctx, objectKey, contentType, data)
+}
+
+func (a *photoStorageAdapter) DeletePhoto(ctx context.Context, objectKey string) error {
+	return a.store.Delete(ctx, objectKey)
+}
@@ -44,6 +44,27 @@ func (r *partnerPreferencesRepository) GetPartnerPreferencesByUserProfileID(
 	return &preferences, nil
 }
 
+// GetPartnerPreferencesByUserProfileIDs skips profiles that have not set
+// their preferences.
+func (r *partnerPreferencesRepository) GetPartnerPreferencesByUserProfileIDs(
+	ctx context.Context,
+	userProfileIDs []int64) ([]*entity.PartnerPreference, error) {
+
+	var preferences []*entity.PartnerPreference
+	if len(userProfileIDs) == 0 {
+		return preferences, nil
+	}
+
+	err := r.db.GormDB.WithContext(ctx).
+		Where("user_profile_id IN ?", userProfileIDs).
+		Find(&preferences).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
 
 func (r *partnerPreferencesRepository) PatchPartnerPreferences(
 	ctx context.Context, 
@@ -60,4 +81,24 @@ func (r *partnerPreferencesRepository) PatchPartnerPreferences(
 	}
 	
 	return nil
-}
\ No newline at end of file
+}
+
+func (r *partnerPreferencesRepository) CreatePartnerPreferencesTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	preferences *entity.PartnerPreference) error {
+
+	return tx.WithContext(ctx).Create(preferences).Error
+}
+
+func (r *partnerPreferencesRepository) PatchPartnerPreferencesTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	userProfileID int64,
+	patch map[string]interface{}) error {
+
+	return tx.WithContext(ctx).
+		Model(&entity.PartnerPreference{}).
+		Where("user_profile_id = ?", userProfileID).
+		Updates(patch).Error
+}
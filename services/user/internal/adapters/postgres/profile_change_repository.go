@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type profileChangeRepository struct {
+	db *postgres.Client
+}
+
+func NewProfileChangeRepository(db *postgres.Client) repository.ProfileChangeRepository {
+	return &profileChangeRepository{db: db}
+}
+
+// The profile row is locked until the transaction ends, so concurrent edits of
+// the same profile take versions one after the other instead of racing for the
+// same one on the unique index (user_profile_id, section, version).
+func (r *profileChangeRepository) CreateProfileChangeTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	change *entity.ProfileChange) error {
+
+	var profileID int64
+	if err := tx.WithContext(ctx).
+		Model(&entity.UserProfile{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", change.UserProfileID).
+		Select("id").
+		Scan(&profileID).Error; err != nil {
+		return err
+	}
+
+	var latestVersion int32
+	if err := tx.WithContext(ctx).
+		Model(&entity.ProfileChange{}).
+		Where("user_profile_id = ? AND section = ?", change.UserProfileID, change.Section).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&latestVersion).Error; err != nil {
+		return err
+	}
+
+	change.Version = latestVersion + 1
+	return tx.WithContext(ctx).Create(change).Error
+}
+
+func (r *profileChangeRepository) GetProfileChangesSince(
+	ctx context.Context,
+	userProfileID int64,
+	section string,
+	since time.Time) ([]*entity.ProfileChange, error) {
+
+	var changes []*entity.ProfileChange
+	err := r.db.GormDB.WithContext(ctx).
+		Where("user_profile_id = ? AND section = ? AND created_at >= ?", userProfileID, section, since).
+		Order("created_at DESC").
+		Find(&changes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// Latest changes first.
+func (r *profileChangeRepository) ListProfileChanges(
+	ctx context.Context,
+	req entity.GetProfileHistoryRequest) ([]*entity.ProfileChange, int64, error) {
+
+	query := r.db.GormDB.WithContext(ctx).Model(&entity.ProfileChange{})
+	if req.UserProfileID > 0 {
+		query = query.Where("user_profile_id = ?", req.UserProfileID)
+	}
+	if req.Section != "" {
+		query = query.Where("section = ?", req.Section)
+	}
+	if req.FlaggedOnly {
+		query = query.Where("is_flagged")
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var changes []*entity.ProfileChange
+	if err := query.
+		Order("created_at DESC, id DESC").
+		Limit(req.Limit).
+		Offset(req.Offset).
+		Find(&changes).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return changes, totalCount, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type profileMatchRepository struct {
@@ -40,18 +41,14 @@ func (r *profileMatchRepository) GetExistingMatch(
 	return &match, nil
 }
 
+// CreateMatchAction reports false when the user has already acted on the
+// target, a concurrent request may have inserted the row first.
 func (r *profileMatchRepository) CreateMatchAction(
 	ctx context.Context,
 	userID uuid.UUID,
-	targetID uuid.UUID, isLiked bool) error {
+	targetID uuid.UUID, isLiked bool) (bool, error) {
 
-	match := entity.ProfileMatch{
-		UserID:   userID,
-		TargetID: targetID,
-		IsLiked:  isLiked,
-	}
-
-	return r.db.GormDB.WithContext(ctx).Create(&match).Error
+	return r.CreateMatchActionTx(ctx, r.db.GormDB, userID, targetID, isLiked)
 }
 
 func (r *profileMatchRepository) CreateMatchActionTx(
@@ -59,7 +56,7 @@ func (r *profileMatchRepository) CreateMatchActionTx(
 	tx *gorm.DB,
 	userID uuid.UUID,
 	targetID uuid.UUID,
-	isLiked bool) error {
+	isLiked bool) (bool, error) {
 
 	match := entity.ProfileMatch{
 		UserID:   userID,
@@ -67,7 +64,13 @@ func (r *profileMatchRepository) CreateMatchActionTx(
 		IsLiked:  isLiked,
 	}
 
-	return tx.WithContext(ctx).Create(&match).Error
+	result := tx.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&match)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
 }
 
 func (r *profileMatchRepository) UpdateMatchAction(
@@ -83,6 +86,7 @@ func (r *profileMatchRepository) UpdateMatchAction(
 			userID, targetID).
 		Updates(map[string]interface{}{
 			"is_liked":   isLiked,
+			"seen_at":    nil,
 			"updated_at": now,
 		}).Error
 }
@@ -101,6 +105,7 @@ func (r *profileMatchRepository) UpdateMatchActionTx(
 			userID, targetID).
 		Updates(map[string]interface{}{
 			"is_liked":   isLiked,
+			"seen_at":    nil,
 			"updated_at": now,
 		}).Error
 }
@@ -125,6 +130,61 @@ func (r *profileMatchRepository) GetMatchedProfileIDs(
 	return matchedIDs, nil
 }
 
+func (r *profileMatchRepository) GetRecommendationExcludedIDs(
+	ctx context.Context,
+	userID uuid.UUID,
+	passedBefore *time.Time) ([]uuid.UUID, error) {
+
+	query := r.db.GormDB.WithContext(ctx).
+		Table("profile_matches AS pm").
+		Joins("LEFT JOIN user_profiles up ON up.user_id = pm.target_id").
+		Where("pm.user_id = ? AND pm.is_deleted = false", userID).
+		Where("(pm.is_liked = true OR up.significantly_updated_at IS NULL OR up.significantly_updated_at <= pm.updated_at)")
+	if passedBefore != nil {
+		query = query.Where("(pm.is_liked = true OR pm.updated_at > ?)", *passedBefore)
+	}
+
+	var excludedIDs []uuid.UUID
+	if err := query.Pluck("pm.target_id", &excludedIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return excludedIDs, nil
+}
+
+func (r *profileMatchRepository) GetLatestMatchAction(
+	ctx context.Context,
+	userID uuid.UUID) (*entity.ProfileMatch, error) {
+
+	var match entity.ProfileMatch
+	err := r.db.GormDB.WithContext(ctx).
+		Where("user_id = ? AND is_deleted = false", userID).
+		Order("updated_at DESC").
+		First(&match).Error
+
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &match, nil
+}
+
+// DeleteMatchActionTx removes the row outright, the (user_id, target_id)
+// pair is unique even among soft deleted rows and the user may act again.
+func (r *profileMatchRepository) DeleteMatchActionTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	userID uuid.UUID,
+	targetID uuid.UUID) error {
+
+	return tx.WithContext(ctx).
+		Where("user_id = ? AND target_id = ?", userID, targetID).
+		Delete(&entity.ProfileMatch{}).Error
+}
+
 func (r *profileMatchRepository) GetLikedUserIDs(
 	ctx context.Context,
 	userID uuid.UUID) ([]uuid.UUID, error) {
@@ -166,3 +226,86 @@ func (r *profileMatchRepository) GetPassedUserIDs(
 
 	return passedIDs, nil
 }
+
+// pendingInterestsQuery leaves out senders whose profile is deleted or
+// hidden, so the counts match the listed interests.
+func (r *profileMatchRepository) pendingInterestsQuery(
+	ctx context.Context,
+	targetID uuid.UUID,
+	excludedIDs []uuid.UUID) *gorm.DB {
+
+	query := r.db.GormDB.WithContext(ctx).
+		Model(&entity.ProfileMatch{}).
+		Where("target_id = ? AND is_liked = true AND is_deleted = false", targetID).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM profile_matches reply
+			WHERE reply.user_id = profile_matches.target_id
+			AND reply.target_id = profile_matches.user_id
+			AND reply.is_deleted = false)`).
+		Where(`EXISTS (
+			SELECT 1 FROM user_profiles sender
+			WHERE sender.user_id = profile_matches.user_id
+			AND sender.deleted_at IS NULL
+			AND sender.is_hidden = false)`)
+	if len(excludedIDs) > 0 {
+		query = query.Where("user_id NOT IN ?", excludedIDs)
+	}
+
+	return query
+}
+
+func (r *profileMatchRepository) GetPendingInterests(
+	ctx context.Context,
+	targetID uuid.UUID,
+	excludedIDs []uuid.UUID,
+	limit, offset int) ([]*entity.ProfileMatch, int64, error) {
+
+	var total int64
+	if err := r.pendingInterestsQuery(ctx, targetID, excludedIDs).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var interests []*entity.ProfileMatch
+	err := r.pendingInterestsQuery(ctx, targetID, excludedIDs).
+		Order("updated_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&interests).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return interests, total, nil
+}
+
+func (r *profileMatchRepository) CountPendingInterests(
+	ctx context.Context,
+	targetID uuid.UUID,
+	excludedIDs []uuid.UUID) (*entity.ReceivedInterestCounts, error) {
+
+	var counts entity.ReceivedInterestCounts
+	err := r.pendingInterestsQuery(ctx, targetID, excludedIDs).
+		Select("COUNT(*) AS pending, COUNT(*) FILTER (WHERE seen_at IS NULL) AS unread").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &counts, nil
+}
+
+func (r *profileMatchRepository) MarkInterestsSeen(
+	ctx context.Context,
+	targetID uuid.UUID,
+	senderIDs []uuid.UUID,
+	seenAt time.Time) error {
+
+	if len(senderIDs) == 0 {
+		return nil
+	}
+
+	return r.db.GormDB.WithContext(ctx).
+		Model(&entity.ProfileMatch{}).
+		Where("target_id = ? AND user_id IN ? AND seen_at IS NULL", targetID, senderIDs).
+		Update("seen_at", seenAt).Error
+}
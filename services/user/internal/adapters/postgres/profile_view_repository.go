@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type profileViewRepository struct {
+	db *postgres.Client
+}
+
+func NewProfileViewRepository(db *postgres.Client) repository.ProfileViewRepository {
+	return &profileViewRepository{db: db}
+}
+
+// upsert method : one row per viewer per day, a repeat visit only refreshes viewed_at
+func (r *profileViewRepository) RecordProfileView(
+	ctx context.Context,
+	viewerID, viewedID uuid.UUID,
+	viewedAt time.Time) error {
+
+	view := &entity.ProfileView{
+		ViewerID: viewerID,
+		ViewedID: viewedID,
+		ViewDate: viewedAt.Truncate(24 * time.Hour),
+		ViewedAt: viewedAt,
+	}
+
+	return r.db.GormDB.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "viewer_id"}, {Name: "viewed_id"}, {Name: "view_date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"viewed_at"}),
+		}).
+		Create(view).Error
+}
+
+func (r *profileViewRepository) GetProfileVisitors(
+	ctx context.Context,
+	viewedID uuid.UUID,
+	excludedIDs []uuid.UUID,
+	limit, offset int) ([]*entity.ProfileVisitor, int64, error) {
+
+	baseQuery := r.db.GormDB.WithContext(ctx).
+		Model(&entity.ProfileView{}).
+		Where("viewed_id = ?", viewedID)
+
+	if len(excludedIDs) > 0 {
+		baseQuery = baseQuery.Where("viewer_id NOT IN (?)", excludedIDs)
+	}
+
+	var total int64
+	if err := baseQuery.Session(&gorm.Session{}).
+		Distinct("viewer_id").
+		Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var visitors []*entity.ProfileVisitor
+	if err := baseQuery.Session(&gorm.Session{}).
+		Select("viewer_id, MAX(viewed_at) AS last_viewed_at").
+		Group("viewer_id").
+		Order("last_viewed_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Scan(&visitors).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return visitors, total, nil
+}
+
+func (r *profileViewRepository) CountProfileViewsSince(
+	ctx context.Context,
+	viewedID uuid.UUID,
+	since time.Time) (int64, error) {
+
+	var count int64
+	err := r.db.GormDB.WithContext(ctx).
+		Model(&entity.ProfileView{}).
+		Where("viewed_id = ? AND view_date >= ?", viewedID, since.Truncate(24*time.Hour)).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
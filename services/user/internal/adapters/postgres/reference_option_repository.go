@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type referenceOptionRepository struct {
+	db *postgres.Client
+}
+
+func NewReferenceOptionRepository(db *postgres.Client) repository.ReferenceOptionRepository {
+	return &referenceOptionRepository{db: db}
+}
+
+func (r *referenceOptionRepository) CreateReferenceOption(
+	ctx context.Context,
+	option *entity.ReferenceOption) error {
+
+	return r.db.GormDB.WithContext(ctx).Create(option).Error
+}
+
+func (r *referenceOptionRepository) GetReferenceOptionByID(
+	ctx context.Context,
+	id int64) (*entity.ReferenceOption, error) {
+
+	var option entity.ReferenceOption
+	err := r.db.GormDB.WithContext(ctx).
+		Where("id = ?", id).
+		First(&option).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &option, nil
+}
+
+func (r *referenceOptionRepository) GetReferenceOption(
+	ctx context.Context,
+	taxonomy, value string) (*entity.ReferenceOption, error) {
+
+	var option entity.ReferenceOption
+	err := r.db.GormDB.WithContext(ctx).
+		Where("taxonomy = ? AND value = ?", taxonomy, value).
+		First(&option).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &option, nil
+}
+
+// Options are returned in display order, ties broken by label so the order
+// is stable for clients.
+func (r *referenceOptionRepository) ListReferenceOptions(
+	ctx context.Context,
+	taxonomy string,
+	includeInactive bool) ([]*entity.ReferenceOption, error) {
+
+	query := r.db.GormDB.WithContext(ctx).Model(&entity.ReferenceOption{})
+	if taxonomy != "" {
+		query = query.Where("taxonomy = ?", taxonomy)
+	}
+	if !includeInactive {
+		query = query.Where("is_active = ?", true)
+	}
+
+	var options []*entity.ReferenceOption
+	if err := query.
+		Order("taxonomy ASC, display_order ASC, label ASC").
+		Find(&options).Error; err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+func (r *referenceOptionRepository) UpdateReferenceOption(
+	ctx context.Context,
+	option *entity.ReferenceOption) error {
+
+	return r.db.GormDB.WithContext(ctx).
+		Model(&entity.ReferenceOption{}).
+		Where("id = ?", option.ID).
+		Updates(map[string]interface{}{
+			"label":         option.Label,
+			"display_order": option.DisplayOrder,
+			"is_active":     option.IsActive,
+			"updated_at":    option.UpdatedAt,
+		}).Error
+}
+
+func (r *referenceOptionRepository) DeleteReferenceOption(
+	ctx context.Context,
+	id int64) error {
+
+	return r.db.GormDB.WithContext(ctx).
+		Where("id = ?", id).
+		Delete(&entity.ReferenceOption{}).Error
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type reportRepository struct {
+	db *postgres.Client
+}
+
+func NewReportRepository(db *postgres.Client) repository.ReportRepository {
+	return &reportRepository{db: db}
+}
+
+func (r *reportRepository) CreateReport(
+	ctx context.Context,
+	report *entity.Report) error {
+
+	return r.db.GormDB.WithContext(ctx).Create(report).Error
+}
+
+func (r *reportRepository) GetReportByID(
+	ctx context.Context,
+	reportID int64) (*entity.Report, error) {
+
+	var report entity.Report
+	err := r.db.GormDB.WithContext(ctx).
+		Where("id = ?", reportID).
+		First(&report).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+// Pending reports are returned oldest first so that nothing waits too long in the queue,
+// resolved reports are returned latest first.
+func (r *reportRepository) GetReportsByStatus(
+	ctx context.Context,
+	status string,
+	limit, offset int) ([]*entity.Report, int64, error) {
+
+	var totalCount int64
+	query := r.db.GormDB.WithContext(ctx).
+		Model(&entity.Report{}).
+		Where("status = ?", status)
+
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "created_at DESC"
+	if status == constants.ReportStatusPending {
+		order = "created_at ASC"
+	}
+
+	var reports []*entity.Report
+	if err := query.
+		Order(order).
+		Limit(limit).
+		Offset(offset).
+		Find(&reports).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return reports, totalCount, nil
+}
+
+// ResolveReportTx only updates a pending report, false means another admin
+// resolved it first.
+func (r *reportRepository) ResolveReportTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	report *entity.Report) (bool, error) {
+
+	result := tx.WithContext(ctx).
+		Model(&entity.Report{}).
+		Where("id = ? AND status = ?", report.ID, constants.ReportStatusPending).
+		Updates(map[string]interface{}{
+			"status":      report.Status,
+			"action":      report.Action,
+			"admin_note":  report.AdminNote,
+			"resolved_by": report.ResolvedBy,
+			"resolved_at": report.ResolvedAt,
+			"updated_at":  report.UpdatedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type savedSearchRepository struct {
+	db *postgres.Client
+}
+
+func NewSavedSearchRepository(db *postgres.Client) repository.SavedSearchRepository {
+	return &savedSearchRepository{db: db}
+}
+
+func (r *savedSearchRepository) CreateSavedSearchTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	search *entity.SavedSearch) error {
+
+	return tx.WithContext(ctx).Create(search).Error
+}
+
+func (r *savedSearchRepository) CountSavedSearchesTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	userID uuid.UUID) (int64, error) {
+
+	var count int64
+	err := tx.WithContext(ctx).
+		Model(&entity.SavedSearch{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *savedSearchRepository) ListSavedSearches(
+	ctx context.Context,
+	userID uuid.UUID) ([]*entity.SavedSearch, error) {
+
+	var searches []*entity.SavedSearch
+	if err := r.db.GormDB.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&searches).Error; err != nil {
+		return nil, err
+	}
+	return searches, nil
+}
+
+func (r *savedSearchRepository) DeleteSavedSearch(
+	ctx context.Context,
+	userID uuid.UUID,
+	id int64) (bool, error) {
+
+	result := r.db.GormDB.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		Delete(&entity.SavedSearch{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetSavedSearchesDueForAlert returns the searches with alerts on that were
+// last checked before checkedBefore, the longest waiting first.
+func (r *savedSearchRepository) GetSavedSearchesDueForAlert(
+	ctx context.Context,
+	checkedBefore time.Time,
+	limit int) ([]*entity.SavedSearch, error) {
+
+	var searches []*entity.SavedSearch
+	if err := r.db.GormDB.WithContext(ctx).
+		Where("alerts_enabled = ? AND last_checked_at < ?", true, checkedBefore).
+		Order("last_checked_at ASC").
+		Limit(limit).
+		Find(&searches).Error; err != nil {
+		return nil, err
+	}
+	return searches, nil
+}
+
+func (r *savedSearchRepository) UpdateLastCheckedAt(
+	ctx context.Context,
+	id int64,
+	checkedAt time.Time) error {
+
+	return r.db.GormDB.WithContext(ctx).
+		Model(&entity.SavedSearch{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"last_checked_at": checkedAt,
+			"updated_at":      checkedAt,
+		}).Error
+}
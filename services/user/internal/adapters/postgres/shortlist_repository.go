@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type shortlistRepository struct {
+	db *postgres.Client
+}
+
+func NewShortlistRepository(db *postgres.Client) repository.ShortlistRepository {
+	return &shortlistRepository{db: db}
+}
+
+// upsert method : shortlisting an already shortlisted profile only replaces the note
+func (r *shortlistRepository) UpsertShortlist(
+	ctx context.Context,
+	userID, targetUserID uuid.UUID,
+	note string) error {
+
+	now := time.Now().UTC()
+	shortlist := &entity.Shortlist{
+		UserID:       userID,
+		TargetUserID: targetUserID,
+		Note:         note,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	return r.db.GormDB.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "target_user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"note", "updated_at"}),
+		}).
+		Create(shortlist).Error
+}
+
+func (r *shortlistRepository) DeleteShortlist(
+	ctx context.Context,
+	userID, targetUserID uuid.UUID) (bool, error) {
+
+	result := r.db.GormDB.WithContext(ctx).
+		Where("user_id = ? AND target_user_id = ?", userID, targetUserID).
+		Delete(&entity.Shortlist{})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *shortlistRepository) GetShortlist(
+	ctx context.Context,
+	userID uuid.UUID,
+	excludedIDs []uuid.UUID,
+	limit, offset int) ([]*entity.Shortlist, int64, error) {
+
+	baseQuery := r.db.GormDB.WithContext(ctx).
+		Model(&entity.Shortlist{}).
+		Where("user_id = ?", userID)
+
+	if len(excludedIDs) > 0 {
+		baseQuery = baseQuery.Where("target_user_id NOT IN (?)", excludedIDs)
+	}
+
+	var total int64
+	if err := baseQuery.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var shortlists []*entity.Shortlist
+	if err := baseQuery.
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&shortlists).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return shortlists, total, nil
+}
+
+func (r *shortlistRepository) GetShortlistedUserIDs(
+	ctx context.Context,
+	userID uuid.UUID,
+	targetUserIDs []uuid.UUID) ([]uuid.UUID, error) {
+
+	if len(targetUserIDs) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	var shortlistedIDs []uuid.UUID
+	err := r.db.GormDB.WithContext(ctx).
+		Model(&entity.Shortlist{}).
+		Where("user_id = ? AND target_user_id IN (?)", userID, targetUserIDs).
+		Pluck("target_user_id", &shortlistedIDs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return shortlistedIDs, nil
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type userBlockRepository struct {
+	db *postgres.Client
+}
+
+func NewUserBlockRepository(db *postgres.Client) repository.UserBlockRepository {
+	return &userBlockRepository{db: db}
+}
+
+// upsert method : create if not exists, reactivate if exists and deleted
+func (r *userBlockRepository) UpsertUserBlockTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	blockerID, blockedID uuid.UUID) error {
+
+	now := time.Now().UTC()
+
+	userBlock := &entity.UserBlock{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+		IsDeleted: false,
+	}
+
+	return tx.WithContext(ctx).
+		Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Assign(map[string]interface{}{
+			"is_deleted": false,
+			"deleted_at": nil,
+			"updated_at": now,
+		}).
+		FirstOrCreate(userBlock).Error
+}
+
+func (r *userBlockRepository) DeactivateUserBlock(
+	ctx context.Context,
+	blockerID, blockedID uuid.UUID) error {
+
+	now := time.Now().UTC()
+	return r.db.GormDB.WithContext(ctx).
+		Model(&entity.UserBlock{}).
+		Where("blocker_id = ? AND blocked_id = ? AND is_deleted = ?",
+			blockerID, blockedID, false).
+		Updates(map[string]interface{}{
+			"is_deleted": true,
+			"deleted_at": now,
+			"updated_at": now,
+		}).Error
+}
+
+func (r *userBlockRepository) IsBlockedEitherWay(
+	ctx context.Context,
+	userID1, userID2 uuid.UUID) (bool, error) {
+
+	var count int64
+	err := r.db.GormDB.WithContext(ctx).
+		Model(&entity.UserBlock{}).
+		Where("((blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)) AND is_deleted = ?",
+			userID1, userID2, userID2, userID1, false).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetBlockedUserIDs returns the users blocked by the given user and the users who blocked the given user
+func (r *userBlockRepository) GetBlockedUserIDs(
+	ctx context.Context,
+	userID uuid.UUID) ([]uuid.UUID, error) {
+
+	var userIDs []uuid.UUID
+
+	const sql = `
+    SELECT
+      CASE WHEN blocker_id = ? THEN blocked_id ELSE blocker_id END AS user_id
+    FROM user_blocks
+    WHERE (blocker_id = ? OR blocked_id = ?) AND is_deleted = false
+    `
+	err := r.db.GormDB.WithContext(ctx).
+		Raw(sql, userID, userID, userID).
+		Scan(&userIDs).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
@@ -2,11 +2,14 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
+	"gorm.io/gorm"
 )
 
 type userImageRepository struct {
@@ -44,6 +47,91 @@ func (r *userImageRepository) CreateUserImage(
 	return nil
 }
 
+// ReplaceUserImageTx soft deletes the image in a slot and creates its
+// replacement, the old row has to go first because of the unique slot index.
+func (r *userImageRepository) ReplaceUserImageTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	oldImageID int64,
+	userImage *entity.UserImage) error {
+
+	if err := tx.WithContext(ctx).
+		Delete(&entity.UserImage{}, oldImageID).Error; err != nil {
+		return err
+	}
+	return tx.WithContext(ctx).Create(userImage).Error
+}
+
+// ReorderUserImagesTx moves images to new slots. The unique index on
+// (user_id, display_order) is checked row by row and cannot be deferred, so
+// the moved rows are first soft deleted, which takes them out of the partial
+// index, and then restored with their new slots.
+func (r *userImageRepository) ReorderUserImagesTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	userID uuid.UUID,
+	newDisplayOrders map[int64]int16) error {
+
+	if len(newDisplayOrders) == 0 {
+		return nil
+	}
+
+	imageIDs := make([]int64, 0, len(newDisplayOrders))
+	for imageID := range newDisplayOrders {
+		imageIDs = append(imageIDs, imageID)
+	}
+
+	now := time.Now().UTC()
+	if err := tx.WithContext(ctx).
+		Model(&entity.UserImage{}).
+		Where("user_id = ? AND id IN ?", userID, imageIDs).
+		Update("deleted_at", now).Error; err != nil {
+		return err
+	}
+
+	for imageID, displayOrder := range newDisplayOrders {
+		if err := tx.WithContext(ctx).
+			Unscoped().
+			Model(&entity.UserImage{}).
+			Where("id = ? AND user_id = ?", imageID, userID).
+			Updates(map[string]interface{}{
+				"display_order": displayOrder,
+				"deleted_at":    nil,
+				"updated_at":    now,
+			}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *userImageRepository) UpdateUserImagePhotoTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	imageID int64,
+	objectKey, status string,
+	processed bool) error {
+
+	return tx.WithContext(ctx).
+		Model(&entity.UserImage{}).
+		Where("id = ?", imageID).
+		Updates(map[string]interface{}{
+			"object_key": objectKey,
+			"status":     status,
+			"processed":  processed,
+			"updated_at": time.Now().UTC(),
+		}).Error
+}
+
+func (r *userImageRepository) DeleteUserImageByIDTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	imageID int64) error {
+
+	return tx.WithContext(ctx).
+		Delete(&entity.UserImage{}, imageID).Error
+}
+
 func (r *userImageRepository) DeleteUserImage(
 	ctx context.Context, 
 	userID uuid.UUID, 
@@ -70,6 +158,9 @@ func (r *userImageRepository) GetUserImage(
 		Where("user_id = ? AND display_order = ?", userID, displayOrder).
 		First(&userImage).Error
 	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
 		return nil, err
 	}
 
@@ -92,4 +183,87 @@ func (r *userImageRepository) ListUserImages(
         return nil, err
     }
     return images, nil
-}
\ No newline at end of file
+}
+
+func (r *userImageRepository) UpdateUserImageReview(
+	ctx context.Context,
+	userID uuid.UUID,
+	displayOrder int32,
+	objectKey, status string,
+	rejectionReason *string) (bool, error) {
+
+	// Only the photo the admin reviewed, false when it was replaced, moved or
+	// already reviewed
+	now := time.Now().UTC()
+	result := r.db.GormDB.WithContext(ctx).
+		Model(&entity.UserImage{}).
+		Where("user_id = ? AND display_order = ? AND object_key = ? AND status = ?",
+			userID, displayOrder, objectKey, constants.PhotoStatusPendingReview).
+		Updates(map[string]interface{}{
+			"status":           status,
+			"rejection_reason": rejectionReason,
+			"reviewed_at":      now,
+			"updated_at":       now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Profile photos live on user_profiles and additional photos on user_images,
+// the review queue is a union of both, oldest upload first.
+const pendingPhotosQuery = `
+	SELECT up.id AS user_profile_id, up.user_id, up.profile_image_key AS object_key,
+		0 AS display_order, up.profile_image_uploaded_at AS uploaded_at
+	FROM user_profiles up
+	WHERE up.profile_image_status = ? AND up.profile_image_key <> '' AND up.deleted_at IS NULL
+	UNION ALL
+	SELECT up.id AS user_profile_id, ui.user_id, ui.object_key,
+		ui.display_order, ui.created_at AS uploaded_at
+	FROM user_images ui
+	JOIN user_profiles up ON up.user_id = ui.user_id AND up.deleted_at IS NULL
+	WHERE ui.status = ? AND ui.deleted_at IS NULL`
+
+func (r *userImageRepository) ListPendingPhotos(
+	ctx context.Context,
+	limit, offset int) ([]*entity.PendingPhoto, int64, error) {
+
+	status := constants.PhotoStatusPendingReview
+
+	var total int64
+	if err := r.db.GormDB.WithContext(ctx).
+		Raw("SELECT COUNT(*) FROM ("+pendingPhotosQuery+") AS pending", status, status).
+		Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var photos []*entity.PendingPhoto
+	if err := r.db.GormDB.WithContext(ctx).
+		Raw(pendingPhotosQuery+" ORDER BY uploaded_at ASC LIMIT ? OFFSET ?", status, status, limit, offset).
+		Scan(&photos).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return photos, total, nil
+}
+
+// GetReferencedObjectKeys returns the subset of objectKeys that belong to a
+// confirmed, not deleted additional photo.
+func (r *userImageRepository) GetReferencedObjectKeys(
+	ctx context.Context,
+	objectKeys []string) ([]string, error) {
+
+	var keys []string
+	if len(objectKeys) == 0 {
+		return keys, nil
+	}
+	err := r.db.GormDB.WithContext(ctx).
+		Model(&entity.UserImage{}).
+		Where("object_key IN ?", objectKeys).
+		Pluck("object_key", &keys).Error
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
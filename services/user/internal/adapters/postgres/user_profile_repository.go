@@ -2,14 +2,18 @@ package postgres
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"github.com/lib/pq"
 )
 
@@ -86,6 +90,26 @@ func (r *userProfileRepository) GetProfileByUserID(
 	return &profile, nil
 }
 
+// GetReferencedProfileImageKeys returns the subset of objectKeys that are
+// used as a profile photo by a live profile.
+func (r *userProfileRepository) GetReferencedProfileImageKeys(
+	ctx context.Context,
+	objectKeys []string) ([]string, error) {
+
+	var keys []string
+	if len(objectKeys) == 0 {
+		return keys, nil
+	}
+	err := r.db.GormDB.WithContext(ctx).
+		Model(&entity.UserProfile{}).
+		Where("profile_image_key IN ?", objectKeys).
+		Pluck("profile_image_key", &keys).Error
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
 func (r *userProfileRepository) UpdateUserProfile(
 	ctx context.Context,
 	userProfile *entity.UserProfile) error {
@@ -94,6 +118,78 @@ func (r *userProfileRepository) UpdateUserProfile(
 		Save(userProfile).Error
 }
 
+func (r *userProfileRepository) UpdateUserProfileTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	userProfile *entity.UserProfile) error {
+
+	return tx.WithContext(ctx).
+		Save(userProfile).Error
+}
+
+func (r *userProfileRepository) UpdateProfileImageTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	userID uuid.UUID,
+	objectKey, status string,
+	processed bool,
+	uploadedAt time.Time) error {
+
+	return tx.WithContext(ctx).
+		Model(&entity.UserProfile{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{
+			"profile_image_key":         objectKey,
+			"profile_image_status":      status,
+			"profile_image_processed":   processed,
+			"profile_image_uploaded_at": uploadedAt,
+			"updated_at":                time.Now().UTC(),
+		}).Error
+}
+
+// UpdateProfileImageReview records the review outcome only while objectKey is
+// still the profile photo and waiting for review, false means it has changed.
+func (r *userProfileRepository) UpdateProfileImageReview(
+	ctx context.Context,
+	userID uuid.UUID,
+	objectKey, status string,
+	significantlyUpdatedAt *time.Time) (bool, error) {
+
+	updates := map[string]interface{}{
+		"profile_image_status": status,
+		"updated_at":           time.Now().UTC(),
+	}
+	if significantlyUpdatedAt != nil {
+		updates["significantly_updated_at"] = *significantlyUpdatedAt
+	}
+
+	result := r.db.GormDB.WithContext(ctx).
+		Model(&entity.UserProfile{}).
+		Where("user_id = ? AND profile_image_key = ? AND profile_image_status = ?",
+			userID, objectKey, constants.PhotoStatusPendingReview).
+		Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// LockProfileByUserIDTx locks the profile row until the transaction ends, for
+// checks across rows of other tables that must not run concurrently per user.
+func (r *userProfileRepository) LockProfileByUserIDTx(
+	ctx context.Context,
+	tx *gorm.DB,
+	userID uuid.UUID) error {
+
+	var profileID int64
+	return tx.WithContext(ctx).
+		Model(&entity.UserProfile{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ?", userID).
+		Select("id").
+		Scan(&profileID).Error
+}
+
 func (r *userProfileRepository) GetUserProfileByID(
 	ctx context.Context,
 	id int64) (*entity.UserProfile, error) {
@@ -112,11 +208,33 @@ func (r *userProfileRepository) GetUserProfileByID(
 	return &profile, nil
 }
 
+func (r *userProfileRepository) GetProfilesByUserIDs(
+	ctx context.Context,
+	userIDs []uuid.UUID) ([]*entity.UserProfile, error) {
+
+	var profiles []*entity.UserProfile
+	if len(userIDs) == 0 {
+		return profiles, nil
+	}
+
+	err := r.db.GormDB.WithContext(ctx).
+		Where("user_id IN ?", userIDs).
+		Find(&profiles).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
 func (r *userProfileRepository) GetPotentialProfiles(
 	ctx context.Context,
 	userID uuid.UUID,
 	excludedIDs []uuid.UUID,
 	preferences *entity.PartnerPreference,
+	compatibleSigns []entity.HoroscopeSign,
+	completedAfter *time.Time,
+	activeSince *time.Time,
 	limit int,
 	offset int,
 	isUserBride bool) ([]*entity.UserProfile, int64, error) {
@@ -126,7 +244,8 @@ func (r *userProfileRepository) GetPotentialProfiles(
 		Model(&entity.UserProfile{}).
 		Where("user_id <> ?", userID).
 		Where("is_bride = ?", !isUserBride).
-		Where("profile_completed = ?", true) 
+		Where("profile_completed = ?", true).
+		Where("is_hidden = ?", false)
 
 	if len(excludedIDs) > 0 {
 		baseQuery = baseQuery.Where("user_id NOT IN (?)", excludedIDs)
@@ -137,6 +256,20 @@ func (r *userProfileRepository) GetPotentialProfiles(
 		baseQuery = r.applyPreferencesFilters(baseQuery, preferences)
 	}
 
+	if completedAfter != nil {
+		baseQuery = baseQuery.Where("completed_at > ?", *completedAfter)
+	}
+
+	// Dormant profiles, not logged in since activeSince, are left out
+	if activeSince != nil {
+		baseQuery = baseQuery.Where("last_login >= ?", *activeSince)
+	}
+
+	// nil means no porutham filter, an empty list means nobody qualifies
+	if compatibleSigns != nil {
+		baseQuery = r.applyHoroscopeSignsFilter(baseQuery, compatibleSigns)
+	}
+
 	// 2) Count total matches
 	var total int64
 	if err := baseQuery.
@@ -145,10 +278,17 @@ func (r *userProfileRepository) GetPotentialProfiles(
 		return nil, 0, err
 	}
 
-	// 3) Fetch paginated results
+	// 3) Fetch paginated results, the most compatible first so that the limit
+	// does not cut off highly scored profiles that logged in less recently
+	order := clause.Expr{SQL: "last_login DESC, id DESC"}
+	if preferences != nil {
+		if score, vars := preferenceMatchScore(preferences); score != "" {
+			order = clause.Expr{SQL: "(" + score + ") DESC, last_login DESC, id DESC", Vars: vars}
+		}
+	}
 	var profiles []*entity.UserProfile
 	if err := baseQuery.
-		Order("last_login DESC").
+		Clauses(clause.OrderBy{Expression: order}).
 		Limit(limit).
 		Offset(offset).
 		Find(&profiles).Error; err != nil {
@@ -158,49 +298,323 @@ func (r *userProfileRepository) GetPotentialProfiles(
 	return profiles, total, nil
 }
 
+// applyPreferencesFilters applies the must-have criteria of the preferences,
+// the nice-to-have ones are left to the compatibility score.
 func (r *userProfileRepository) applyPreferencesFilters(
 	query *gorm.DB,
 	preferences *entity.PartnerPreference) *gorm.DB {
 
 	// age filtering
-	now := time.Now().UTC()
-	maxBirthDate := now.AddDate(-int(preferences.MinAgeYears), 0, 0)
-	minBirthDate := now.AddDate(-int(preferences.MaxAgeYears)-1, 0, 0)
-	query = query.Where("date_of_birth BETWEEN ? AND ?", minBirthDate, maxBirthDate)
+	if preferences.IsMustHave(constants.MatchCriterionAge) {
+		now := time.Now().UTC()
+		maxBirthDate := now.AddDate(-int(preferences.MinAgeYears), 0, 0)
+		minBirthDate := now.AddDate(-int(preferences.MaxAgeYears)-1, 0, 0)
+		query = query.Where("date_of_birth BETWEEN ? AND ?", minBirthDate, maxBirthDate)
+	}
 
 	// Height filtering
-	query = query.Where("height_cm BETWEEN ? AND ?",
-		preferences.MinHeightCm, preferences.MaxHeightCm)
+	if preferences.IsMustHave(constants.MatchCriterionHeight) {
+		query = query.Where("height_cm BETWEEN ? AND ?",
+			preferences.MinHeightCm, preferences.MaxHeightCm)
+	}
 
 	// Physically challenged filtering
-	if !preferences.AcceptPhysicallyChallenged {
+	if !preferences.AcceptPhysicallyChallenged && preferences.IsMustHave(constants.MatchCriterionPhysicallyChallenged) {
 		query = query.Where("physically_challenged = ?", false)
 	}
 
 	// Community filtering, if "any" value is there, it will be the only value present there
-	if len(preferences.PreferredCommunities) > 0 && preferences.PreferredCommunities[0] != string(validation.CommunityAny) {
-        query = query.Where("community = ANY(?)", pq.Array(preferences.PreferredCommunities))
-    }
-
-	if len(preferences.PreferredMaritalStatus) > 0 && preferences.PreferredMaritalStatus[0] != string(validation.MaritalStatusAny) {
-        query = query.Where("marital_status = ANY(?)", pq.Array(preferences.PreferredMaritalStatus))
-    }
-
-	if len(preferences.PreferredProfessions) > 0 && preferences.PreferredProfessions[0] != string(validation.ProfessionAny) {
-        query = query.Where("profession = ANY(?)", pq.Array(preferences.PreferredProfessions))
-    }
-
-	if len(preferences.PreferredProfessionTypes) > 0 && preferences.PreferredProfessionTypes[0] != string(validation.ProfessionTypeAny) {
-        query = query.Where("profession_type = ANY(?)", pq.Array(preferences.PreferredProfessionTypes))
-    }
-
-	if len(preferences.PreferredEducationLevels) > 0 && preferences.PreferredEducationLevels[0] != string(validation.EducationLevelAny) {
-        query = query.Where("highest_education_level = ANY(?)", pq.Array(preferences.PreferredEducationLevels))
-    }
-
-	if len(preferences.PreferredHomeDistricts) > 0 && preferences.PreferredHomeDistricts[0] != string(validation.HomeDistrictAny) {
-        query = query.Where("home_district = ANY(?)", pq.Array(preferences.PreferredHomeDistricts))
-    }
-	
+	if preferences.IsMustHave(constants.MatchCriterionCommunity) &&
+		len(preferences.PreferredCommunities) > 0 && preferences.PreferredCommunities[0] != string(validation.CommunityAny) {
+		query = query.Where("community = ANY(?)", pq.Array(preferences.PreferredCommunities))
+	}
+
+	if preferences.IsMustHave(constants.MatchCriterionMaritalStatus) &&
+		len(preferences.PreferredMaritalStatus) > 0 && preferences.PreferredMaritalStatus[0] != string(validation.MaritalStatusAny) {
+		query = query.Where("marital_status = ANY(?)", pq.Array(preferences.PreferredMaritalStatus))
+	}
+
+	if preferences.IsMustHave(constants.MatchCriterionProfession) &&
+		len(preferences.PreferredProfessions) > 0 && preferences.PreferredProfessions[0] != string(validation.ProfessionAny) {
+		query = query.Where("profession = ANY(?)", pq.Array(preferences.PreferredProfessions))
+	}
+
+	if preferences.IsMustHave(constants.MatchCriterionProfessionType) &&
+		len(preferences.PreferredProfessionTypes) > 0 && preferences.PreferredProfessionTypes[0] != string(validation.ProfessionTypeAny) {
+		query = query.Where("profession_type = ANY(?)", pq.Array(preferences.PreferredProfessionTypes))
+	}
+
+	if preferences.IsMustHave(constants.MatchCriterionEducationLevel) &&
+		len(preferences.PreferredEducationLevels) > 0 && preferences.PreferredEducationLevels[0] != string(validation.EducationLevelAny) {
+		query = query.Where("highest_education_level = ANY(?)", pq.Array(preferences.PreferredEducationLevels))
+	}
+
+	if preferences.IsMustHave(constants.MatchCriterionHomeDistrict) &&
+		len(preferences.PreferredHomeDistricts) > 0 && preferences.PreferredHomeDistricts[0] != string(validation.HomeDistrictAny) {
+		query = query.Where("home_district = ANY(?)", pq.Array(preferences.PreferredHomeDistricts))
+	}
+
+	// Country filtering, profiles that have not given the location are left out
+	if preferences.IsMustHave(constants.MatchCriterionNativeCountry) &&
+		len(preferences.PreferredCountries) > 0 && preferences.PreferredCountries[0] != validation.CountryAny {
+		query = query.Where("native_country = ANY(?)", pq.Array(preferences.PreferredCountries))
+	}
+
+	if preferences.IsMustHave(constants.MatchCriterionResidenceCountry) &&
+		len(preferences.PreferredResidenceCountries) > 0 && preferences.PreferredResidenceCountries[0] != validation.CountryAny {
+		query = query.Where("residence_country = ANY(?)", pq.Array(preferences.PreferredResidenceCountries))
+	}
+
+	// Unknown dosham is treated as no dosham
+	if !preferences.AcceptDosham && preferences.IsMustHave(constants.MatchCriterionDosham) {
+		query = query.Where("COALESCE(chovva_dosham, false) = false AND COALESCE(papa_dosham, false) = false")
+	}
+
 	return query
 }
+
+// preferenceMatchScore returns an SQL expression adding up the weights of the
+// nice-to-have criteria a profile matches, along with its arguments. It is a
+// coarse version of the compatibility score, the porutham needs the horoscope
+// of both profiles and is left out. Must-have criteria are already filtered,
+// every remaining profile matches them.
+func preferenceMatchScore(preferences *entity.PartnerPreference) (string, []interface{}) {
+	var terms []string
+	var vars []interface{}
+	add := func(weight int, condition string, args ...interface{}) {
+		terms = append(terms, fmt.Sprintf("(CASE WHEN %s THEN %d ELSE 0 END)", condition, weight))
+		vars = append(vars, args...)
+	}
+	preferred := func(values []string, anyValue string) bool {
+		return len(values) > 0 && values[0] != anyValue
+	}
+
+	now := time.Now().UTC()
+	for _, w := range entity.MatchCriterionWeights {
+		if preferences.IsMustHave(w.Criterion) {
+			continue
+		}
+		switch w.Criterion {
+		case constants.MatchCriterionAge:
+			add(w.Weight, "date_of_birth BETWEEN ? AND ?",
+				now.AddDate(-int(preferences.MaxAgeYears)-1, 0, 0),
+				now.AddDate(-int(preferences.MinAgeYears), 0, 0))
+		case constants.MatchCriterionHeight:
+			add(w.Weight, "height_cm BETWEEN ? AND ?", preferences.MinHeightCm, preferences.MaxHeightCm)
+		case constants.MatchCriterionPhysicallyChallenged:
+			if !preferences.AcceptPhysicallyChallenged {
+				add(w.Weight, "COALESCE(physically_challenged, false) = false")
+			}
+		case constants.MatchCriterionCommunity:
+			if preferred(preferences.PreferredCommunities, string(validation.CommunityAny)) {
+				add(w.Weight, "community = ANY(?)", pq.Array(preferences.PreferredCommunities))
+			}
+		case constants.MatchCriterionMaritalStatus:
+			if preferred(preferences.PreferredMaritalStatus, string(validation.MaritalStatusAny)) {
+				add(w.Weight, "marital_status = ANY(?)", pq.Array(preferences.PreferredMaritalStatus))
+			}
+		case constants.MatchCriterionProfession:
+			if preferred(preferences.PreferredProfessions, string(validation.ProfessionAny)) {
+				add(w.Weight, "profession = ANY(?)", pq.Array(preferences.PreferredProfessions))
+			}
+		case constants.MatchCriterionProfessionType:
+			if preferred(preferences.PreferredProfessionTypes, string(validation.ProfessionTypeAny)) {
+				add(w.Weight, "profession_type = ANY(?)", pq.Array(preferences.PreferredProfessionTypes))
+			}
+		case constants.MatchCriterionEducationLevel:
+			if preferred(preferences.PreferredEducationLevels, string(validation.EducationLevelAny)) {
+				add(w.Weight, "highest_education_level = ANY(?)", pq.Array(preferences.PreferredEducationLevels))
+			}
+		case constants.MatchCriterionHomeDistrict:
+			if preferred(preferences.PreferredHomeDistricts, string(validation.HomeDistrictAny)) {
+				add(w.Weight, "home_district = ANY(?)", pq.Array(preferences.PreferredHomeDistricts))
+			}
+		case constants.MatchCriterionNativeCountry:
+			if preferred(preferences.PreferredCountries, validation.CountryAny) {
+				add(w.Weight, "native_country = ANY(?)", pq.Array(preferences.PreferredCountries))
+			}
+		case constants.MatchCriterionResidenceCountry:
+			if preferred(preferences.PreferredResidenceCountries, validation.CountryAny) {
+				add(w.Weight, "residence_country = ANY(?)", pq.Array(preferences.PreferredResidenceCountries))
+			}
+		case constants.MatchCriterionDosham:
+			if !preferences.AcceptDosham {
+				add(w.Weight, "COALESCE(chovva_dosham, false) = false AND COALESCE(papa_dosham, false) = false")
+			}
+		}
+	}
+
+	return strings.Join(terms, " + "), vars
+}
+
+// SearchProfiles runs an ad-hoc search, completedAfter limits it to profiles
+// filled in after the given time.
+func (r *userProfileRepository) SearchProfiles(
+	ctx context.Context,
+	userID uuid.UUID,
+	excludedIDs []uuid.UUID,
+	filters entity.SearchFilters,
+	completedAfter *time.Time,
+	limit int,
+	offset int,
+	isUserBride bool) ([]*entity.UserProfile, int64, error) {
+
+	baseQuery := r.db.GormDB.WithContext(ctx).
+		Model(&entity.UserProfile{}).
+		Where("user_id <> ?", userID).
+		Where("is_bride = ?", !isUserBride).
+		Where("profile_completed = ?", true).
+		Where("is_hidden = ?", false)
+
+	if len(excludedIDs) > 0 {
+		baseQuery = baseQuery.Where("user_id NOT IN (?)", excludedIDs)
+	}
+	if completedAfter != nil {
+		baseQuery = baseQuery.Where("completed_at > ?", *completedAfter)
+	}
+
+	baseQuery = r.applySearchFilters(baseQuery, filters)
+
+	var total int64
+	if err := baseQuery.
+		Session(&gorm.Session{}).
+		Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var profiles []*entity.UserProfile
+	if err := baseQuery.
+		Order(searchSortOrder(filters.Sort)).
+		Order("id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&profiles).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return profiles, total, nil
+}
+
+func (r *userProfileRepository) applySearchFilters(
+	query *gorm.DB,
+	filters entity.SearchFilters) *gorm.DB {
+
+	now := time.Now().UTC()
+	if filters.MinAgeYears != nil {
+		query = query.Where("date_of_birth <= ?", now.AddDate(-int(*filters.MinAgeYears), 0, 0))
+	}
+	if filters.MaxAgeYears != nil {
+		query = query.Where("date_of_birth >= ?", now.AddDate(-int(*filters.MaxAgeYears)-1, 0, 0))
+	}
+	if filters.MinHeightCm != nil {
+		query = query.Where("height_cm >= ?", *filters.MinHeightCm)
+	}
+	if filters.MaxHeightCm != nil {
+		query = query.Where("height_cm <= ?", *filters.MaxHeightCm)
+	}
+	if filters.PhysicallyChallenged != nil {
+		query = query.Where("physically_challenged = ?", *filters.PhysicallyChallenged)
+	}
+
+	// The lists are validated already, "any" is the only value when present
+	listFilters := []struct {
+		column   string
+		values   []string
+		anyValue string
+	}{
+		{"community", filters.Communities, string(validation.CommunityAny)},
+		{"marital_status", filters.MaritalStatuses, string(validation.MaritalStatusAny)},
+		{"profession", filters.Professions, string(validation.ProfessionAny)},
+		{"profession_type", filters.ProfessionTypes, string(validation.ProfessionTypeAny)},
+		{"highest_education_level", filters.EducationLevels, string(validation.EducationLevelAny)},
+		{"home_district", filters.HomeDistricts, string(validation.HomeDistrictAny)},
+		{"native_country", filters.NativeCountries, validation.CountryAny},
+		{"residence_country", filters.ResidenceCountries, validation.CountryAny},
+	}
+	for _, f := range listFilters {
+		if len(f.values) > 0 && f.values[0] != f.anyValue {
+			query = query.Where(f.column+" = ANY(?)", pq.Array(f.values))
+		}
+	}
+
+	if filters.VerifiedOnly {
+		query = query.Where("profile_image_key <> '' AND profile_image_status = ?", constants.PhotoStatusApproved)
+	}
+	if filters.HasPhoto {
+		query = query.Where(`((profile_image_key <> '' AND profile_image_status = ?) OR EXISTS (
+			SELECT 1 FROM user_images ui
+			WHERE ui.user_id = user_profiles.user_id AND ui.status = ? AND ui.deleted_at IS NULL))`,
+			constants.PhotoStatusApproved, constants.PhotoStatusApproved)
+	}
+	if filters.ActiveWithinDays > 0 {
+		query = query.Where("last_login >= ?", now.AddDate(0, 0, -filters.ActiveWithinDays))
+	}
+
+	return query
+}
+
+func searchSortOrder(sort string) string {
+	switch sort {
+	case constants.SearchSortNewest:
+		return "completed_at DESC NULLS LAST"
+	case constants.SearchSortAgeAsc:
+		return "date_of_birth DESC"
+	case constants.SearchSortAgeDesc:
+		return "date_of_birth ASC"
+	case constants.SearchSortHeightAsc:
+		return "height_cm ASC"
+	case constants.SearchSortHeightDesc:
+		return "height_cm DESC"
+	default:
+		return "last_login DESC"
+	}
+}
+
+func (r *userProfileRepository) applyHoroscopeSignsFilter(
+	query *gorm.DB,
+	signs []entity.HoroscopeSign) *gorm.DB {
+
+	if len(signs) == 0 {
+		return query.Where("1 = 0")
+	}
+
+	pairs := make([][]interface{}, len(signs))
+	for i, sign := range signs {
+		pairs[i] = []interface{}{string(sign.Nakshatra), string(sign.Rasi)}
+	}
+	return query.Where("(nakshatra, rasi) IN ?", pairs)
+}
+
+// GetProfilesDueForDigest returns the completed profiles whose daily or weekly
+// digest is due, the ones that never got one and then the longest waiting
+// first.
+func (r *userProfileRepository) GetProfilesDueForDigest(
+	ctx context.Context,
+	dailySentBefore, weeklySentBefore time.Time,
+	limit int) ([]*entity.UserProfile, error) {
+
+	var profiles []*entity.UserProfile
+	if err := r.db.GormDB.WithContext(ctx).
+		Where("profile_completed = ?", true).
+		Where("(digest_frequency = ? AND (last_digest_sent_at IS NULL OR last_digest_sent_at < ?)) OR "+
+			"(digest_frequency = ? AND (last_digest_sent_at IS NULL OR last_digest_sent_at < ?))",
+			constants.DigestFrequencyDaily, dailySentBefore,
+			constants.DigestFrequencyWeekly, weeklySentBefore).
+		Order("last_digest_sent_at ASC NULLS FIRST").
+		Limit(limit).
+		Find(&profiles).Error; err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func (r *userProfileRepository) UpdateLastDigestSentAt(
+	ctx context.Context,
+	id int64,
+	sentAt time.Time) error {
+
+	return r.db.GormDB.WithContext(ctx).
+		Model(&entity.UserProfile{}).
+		Where("id = ?", id).
+		Update("last_digest_sent_at", sentAt).Error
+}
@@ -0,0 +1,162 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/redis"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// recommendationsKeyPrefix carries the version of the cached entries, bump it
+// whenever entity.CachedRecommendation changes so that entries written by the
+// previous release are never read.
+const (
+	recommendationsKeyPrefix = "recommendations:v4:"
+	recommendedToKeyPrefix   = "recommended_to:"
+)
+
+// maxRecommendationUpdateAttempts bounds how often an update starts over
+// because the cached recommendations were written in the meantime.
+const maxRecommendationUpdateAttempts = 3
+
+type recommendationCacheRepository struct {
+	redisClient *redis.Client
+}
+
+func NewRecommendationCacheRepository(redisClient *redis.Client) repository.RecommendationCacheRepository {
+	return &recommendationCacheRepository{redisClient: redisClient}
+}
+
+func recommendationsKey(userID uuid.UUID) string {
+	return recommendationsKeyPrefix + userID.String()
+}
+
+func recommendedToKey(profileID int64) string {
+	return fmt.Sprintf("%s%d", recommendedToKeyPrefix, profileID)
+}
+
+func (r *recommendationCacheRepository) GetRecommendations(
+	ctx context.Context,
+	userID uuid.UUID) ([]entity.CachedRecommendation, error) {
+
+	data, err := r.redisClient.Get(ctx, recommendationsKey(userID))
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	recommendations := []entity.CachedRecommendation{}
+	if err := json.Unmarshal([]byte(data), &recommendations); err != nil {
+		return nil, fmt.Errorf("failed to decode cached recommendations: %w", err)
+	}
+	return recommendations, nil
+}
+
+// SetRecommendations also records the user against every recommended profile,
+// so that an update to one of them can be applied without a full rebuild.
+// Stale entries of the index are harmless, they expire with the same ttl.
+func (r *recommendationCacheRepository) SetRecommendations(
+	ctx context.Context,
+	userID uuid.UUID,
+	recommendations []entity.CachedRecommendation,
+	ttl time.Duration) error {
+
+	data, err := json.Marshal(recommendations)
+	if err != nil {
+		return fmt.Errorf("failed to encode recommendations: %w", err)
+	}
+
+	_, err = r.redisClient.Client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Set(ctx, recommendationsKey(userID), data, ttl)
+		for _, recommendation := range recommendations {
+			key := recommendedToKey(recommendation.Profile.ID)
+			pipe.SAdd(ctx, key, userID.String())
+			pipe.Expire(ctx, key, ttl)
+		}
+		return nil
+	})
+	return err
+}
+
+// UpdateRecommendations reads and writes the recommendations under WATCH, a
+// rebuild or another update landing in between makes the write fail and the
+// update start over on the new value, so neither is lost.
+func (r *recommendationCacheRepository) UpdateRecommendations(
+	ctx context.Context,
+	userID uuid.UUID,
+	update func(recommendations []entity.CachedRecommendation) bool) error {
+
+	key := recommendationsKey(userID)
+	txf := func(tx *goredis.Tx) error {
+		data, err := tx.Get(ctx, key).Result()
+		if err != nil {
+			if errors.Is(err, goredis.Nil) {
+				return nil
+			}
+			return err
+		}
+
+		recommendations := []entity.CachedRecommendation{}
+		if err := json.Unmarshal([]byte(data), &recommendations); err != nil {
+			return fmt.Errorf("failed to decode cached recommendations: %w", err)
+		}
+		if !update(recommendations) {
+			return nil
+		}
+
+		updated, err := json.Marshal(recommendations)
+		if err != nil {
+			return fmt.Errorf("failed to encode recommendations: %w", err)
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+			pipe.SetArgs(ctx, key, updated, goredis.SetArgs{Mode: "XX", KeepTTL: true})
+			return nil
+		})
+		if errors.Is(err, goredis.Nil) {
+			// Expired in the meantime
+			return nil
+		}
+		return err
+	}
+
+	for attempt := 0; attempt < maxRecommendationUpdateAttempts; attempt++ {
+		err := r.redisClient.Client.Watch(ctx, txf, key)
+		if !errors.Is(err, goredis.TxFailedErr) {
+			return err
+		}
+	}
+	return fmt.Errorf("cached recommendations kept changing: %w", goredis.TxFailedErr)
+}
+
+func (r *recommendationCacheRepository) DeleteRecommendations(ctx context.Context, userID uuid.UUID) error {
+	return r.redisClient.Del(ctx, recommendationsKey(userID))
+}
+
+func (r *recommendationCacheRepository) GetRecommendedToUserIDs(
+	ctx context.Context,
+	profileID int64) ([]uuid.UUID, error) {
+
+	members, err := r.redisClient.Client.SMembers(ctx, recommendedToKey(profileID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		userID, err := uuid.Parse(member)
+		if err != nil {
+			continue
+		}
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs, nil
+}
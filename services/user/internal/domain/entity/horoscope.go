@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/porutham"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+)
+
+// Horoscope is the astrological part of a profile. Birth time and place are
+// only returned to the owner.
+type Horoscope struct {
+	BirthTime    string `json:"birth_time,omitempty"`
+	BirthPlace   string `json:"birth_place,omitempty"`
+	Nakshatra    string `json:"nakshatra"`
+	Rasi         string `json:"rasi"`
+	ChovvaDosham *bool  `json:"chovva_dosham"`
+	PapaDosham   *bool  `json:"papa_dosham"`
+}
+
+type PoruthamCompatibility struct {
+	Score     int32    `json:"score"`
+	MaxScore  int32    `json:"max_score"`
+	Matched   []string `json:"matched"`
+	Unmatched []string `json:"unmatched"`
+	// Nil when either profile has not said whether they have dosham
+	DoshamCompatible *bool `json:"dosham_compatible"`
+}
+
+// HoroscopeSign is a birth star together with the sign it falls in, the two
+// values porutham is calculated from.
+type HoroscopeSign struct {
+	Nakshatra validation.Nakshatra
+	Rasi      validation.Rasi
+}
+
+// HasHoroscope reports whether the profile has enough details for porutham.
+func (p *UserProfile) HasHoroscope() bool {
+	return p.Nakshatra != "" && p.Rasi != ""
+}
+
+// HasDosham reports whether the profile has chovva or papa dosham, nil when
+// neither is known.
+func (p *UserProfile) HasDosham() *bool {
+	if p.ChovvaDosham == nil && p.PapaDosham == nil {
+		return nil
+	}
+	hasDosham := (p.ChovvaDosham != nil && *p.ChovvaDosham) || (p.PapaDosham != nil && *p.PapaDosham)
+	return &hasDosham
+}
+
+// PublicHoroscope returns the horoscope shown to other users, nil when the
+// profile has none.
+func (p *UserProfile) PublicHoroscope() *Horoscope {
+	if !p.HasHoroscope() && p.ChovvaDosham == nil && p.PapaDosham == nil {
+		return nil
+	}
+	return &Horoscope{
+		Nakshatra:    string(p.Nakshatra),
+		Rasi:         string(p.Rasi),
+		ChovvaDosham: p.ChovvaDosham,
+		PapaDosham:   p.PapaDosham,
+	}
+}
+
+// OwnHoroscope returns the full horoscope for the owner of the profile.
+func (p *UserProfile) OwnHoroscope() *Horoscope {
+	return &Horoscope{
+		BirthTime:    p.BirthTime,
+		BirthPlace:   p.BirthPlace,
+		Nakshatra:    string(p.Nakshatra),
+		Rasi:         string(p.Rasi),
+		ChovvaDosham: p.ChovvaDosham,
+		PapaDosham:   p.PapaDosham,
+	}
+}
+
+// NewPoruthamCompatibility calculates porutham between two profiles of
+// opposite gender, nil when either has no horoscope.
+func NewPoruthamCompatibility(p, other *UserProfile) *PoruthamCompatibility {
+	if p.IsBride == other.IsBride || !p.HasHoroscope() || !other.HasHoroscope() {
+		return nil
+	}
+
+	bride, groom := p, other
+	if !p.IsBride {
+		bride, groom = other, p
+	}
+	result := porutham.Calculate(bride.Nakshatra, bride.Rasi, groom.Nakshatra, groom.Rasi)
+
+	compatibility := &PoruthamCompatibility{
+		Score:     int32(result.Score),
+		MaxScore:  porutham.MaxScore,
+		Matched:   result.Matched,
+		Unmatched: result.Unmatched,
+	}
+	// Kerala custom pairs a profile with dosham only with another that has it
+	if pDosham, otherDosham := p.HasDosham(), other.HasDosham(); pDosham != nil && otherDosham != nil {
+		doshamCompatible := *pDosham == *otherDosham
+		compatibility.DoshamCompatible = &doshamCompatible
+	}
+	return compatibility
+}
+
+// CompatibleHoroscopeSigns returns every star and sign a partner of the
+// profile can have to reach minScore. The list is small (at most 36 entries),
+// so it can be used as a query filter.
+func CompatibleHoroscopeSigns(p *UserProfile, minScore int) []HoroscopeSign {
+	signs := []HoroscopeSign{}
+	for _, nakshatra := range validation.Nakshatras() {
+		for _, rasi := range validation.RasisOfNakshatra(nakshatra) {
+			var result porutham.Result
+			if p.IsBride {
+				result = porutham.Calculate(p.Nakshatra, p.Rasi, nakshatra, rasi)
+			} else {
+				result = porutham.Calculate(nakshatra, rasi, p.Nakshatra, p.Rasi)
+			}
+			if result.Score >= minScore {
+				signs = append(signs, HoroscopeSign{Nakshatra: nakshatra, Rasi: rasi})
+			}
+		}
+	}
+	return signs
+}
@@ -0,0 +1,80 @@
+package entity
+
+import "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+
+// Location is a country/state/district/city hierarchy. It is embedded in the
+// profile twice, for the native place and for the current residence. An empty
+// country means the location is not set.
+type Location struct {
+	Country  string `json:"country" gorm:"type:varchar(2)"`
+	State    string `json:"state" gorm:"size:100"`
+	District string `json:"district" gorm:"size:100"`
+	City     string `json:"city" gorm:"size:100"`
+}
+
+func (l Location) IsEmpty() bool {
+	return l.Country == ""
+}
+
+// Normalize returns the location in the form it is stored and compared in.
+func (l Location) Normalize() Location {
+	return Location{
+		Country:  validation.NormalizeCountryCode(l.Country),
+		State:    validation.NormalizeLocationName(l.State),
+		District: validation.NormalizeLocationName(l.District),
+		City:     validation.NormalizeLocationName(l.City),
+	}
+}
+
+// IsValid expects a normalized location.
+func (l Location) IsValid() bool {
+	return validation.IsValidLocation(l.Country, l.State, l.District, l.City)
+}
+
+// SetHomeDistrict sets the home district and keeps the native place in step
+// with it, since every home district is a district of Kerala.
+func (p *UserProfile) SetHomeDistrict(district validation.HomeDistrict) {
+	p.HomeDistrict = district
+	if district == validation.HomeDistrictAny {
+		return
+	}
+	p.NativePlace = Location{
+		Country:  validation.CountryIndia,
+		State:    validation.StateKerala,
+		District: string(district),
+		City:     p.NativePlace.City,
+	}
+}
+
+// SetNativePlace sets the native place and keeps the home district in step
+// with it, so filters on either agree.
+func (p *UserProfile) SetNativePlace(l Location) {
+	p.NativePlace = l
+	p.HomeDistrict = validation.HomeDistrictAny
+	if l.Country == validation.CountryIndia && l.State == validation.StateKerala && l.District != "" {
+		p.HomeDistrict = validation.HomeDistrict(l.District)
+	}
+}
+
+// IsNRI reports whether the profile lives outside India.
+func (p *UserProfile) IsNRI() bool {
+	return p.Residence.Country != "" && p.Residence.Country != validation.CountryIndia
+}
+
+// NativePlaceOrNil returns the native place, nil when it is not set.
+func (p *UserProfile) NativePlaceOrNil() *Location {
+	if p.NativePlace.IsEmpty() {
+		return nil
+	}
+	nativePlace := p.NativePlace
+	return &nativePlace
+}
+
+// ResidenceOrNil returns the current residence, nil when it is not set.
+func (p *UserProfile) ResidenceOrNil() *Location {
+	if p.Residence.IsEmpty() {
+		return nil
+	}
+	residence := p.Residence
+	return &residence
+}
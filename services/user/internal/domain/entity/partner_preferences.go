@@ -4,8 +4,30 @@ import (
 	"time"
 
 	"github.com/lib/pq"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 )
 
+// MatchCriterionWeights is the weight of each preference dimension in the
+// compatibility score, in the order the criteria are reported in
+var MatchCriterionWeights = []struct {
+	Criterion string
+	Weight    int
+}{
+	{constants.MatchCriterionAge, 15},
+	{constants.MatchCriterionCommunity, 12},
+	{constants.MatchCriterionMaritalStatus, 10},
+	{constants.MatchCriterionPorutham, 10},
+	{constants.MatchCriterionHeight, 8},
+	{constants.MatchCriterionEducationLevel, 8},
+	{constants.MatchCriterionProfession, 6},
+	{constants.MatchCriterionHomeDistrict, 6},
+	{constants.MatchCriterionProfessionType, 5},
+	{constants.MatchCriterionNativeCountry, 5},
+	{constants.MatchCriterionResidenceCountry, 5},
+	{constants.MatchCriterionPhysicallyChallenged, 5},
+	{constants.MatchCriterionDosham, 5},
+}
+
 type PartnerPreference struct {
 	ID            int64 `json:"id" gorm:"primaryKey"`
 	UserProfileID int64 `json:"user_profile_id" gorm:"not null;uniqueIndex"`
@@ -25,6 +47,18 @@ type PartnerPreference struct {
 	PreferredEducationLevels pq.StringArray `json:"preferred_education_levels" gorm:"type:text[];not null;default:'{}'"`
 	PreferredHomeDistricts   pq.StringArray `json:"preferred_home_districts" gorm:"type:text[];not null;default:'{}'"`
 
+	// Country codes, matched against the native place and the current residence
+	PreferredCountries          pq.StringArray `json:"preferred_countries" gorm:"type:text[];not null;default:'{any}'"`
+	PreferredResidenceCountries pq.StringArray `json:"preferred_residence_countries" gorm:"type:text[];not null;default:'{any}'"`
+
+	// 0 means no porutham filter, profiles without horoscope are then included
+	MinPoruthamScore int16 `json:"min_porutham_score" gorm:"type:smallint;not null;default:0"`
+	AcceptDosham     bool  `json:"accept_dosham" gorm:"not null;default:true"`
+
+	// Dimensions applied as hard filters, the others only add to the
+	// compatibility score. Values are constants.MatchCriterion*
+	MustHaveCriteria pq.StringArray `json:"must_have_criteria" gorm:"type:text[];not null;default:'{}'"`
+
 	CreatedAt time.Time `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
 	UpdatedAt time.Time `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
 
@@ -36,6 +70,16 @@ func (PartnerPreference) TableName() string {
 	return "partner_preferences"
 }
 
+// IsMustHave reports whether the criterion is applied as a hard filter.
+func (p *PartnerPreference) IsMustHave(criterion string) bool {
+	for _, c := range p.MustHaveCriteria {
+		if c == criterion {
+			return true
+		}
+	}
+	return false
+}
+
 
 
 	
@@ -57,4 +101,11 @@ type UpdateUserPartnerPreferencesRequest struct {
 	AcceptAllProfessionTypes   *bool     `json:"accept_all_profession_types,omitempty"`
 	AcceptAllEducationLevels   *bool     `json:"accept_all_education_levels,omitempty"`
 	AcceptAllHomeDistricts     *bool     `json:"accept_all_home_districts,omitempty"`
+	PreferredCountries          *[]string `json:"preferred_countries,omitempty"`
+	PreferredResidenceCountries *[]string `json:"preferred_residence_countries,omitempty"`
+	MinPoruthamScore           *int16    `json:"min_porutham_score,omitempty"`
+	AcceptDosham               *bool     `json:"accept_dosham,omitempty"`
+	MustHaveCriteria           *[]string `json:"must_have_criteria,omitempty"` // an empty list clears them
+
+	ActorRole string `json:"-"` // role of the caller, recorded in the change history
 }
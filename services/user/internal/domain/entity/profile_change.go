@@ -0,0 +1,191 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// ProfileChange is one versioned edit of a profile or of its partner
+// preferences. Versions count up per profile and section, starting at 1.
+type ProfileChange struct {
+	ID            int64          `json:"id" gorm:"primaryKey;autoIncrement"`
+	UserID        uuid.UUID      `json:"user_id" gorm:"type:uuid;not null"`
+	UserProfileID int64          `json:"user_profile_id" gorm:"not null"`
+	Section       string         `json:"section" gorm:"type:varchar(30);not null"`
+	Version       int32          `json:"version" gorm:"not null"`
+	Changes       []FieldChange  `json:"changes" gorm:"type:jsonb;serializer:json;not null"`
+	ActorID       uuid.UUID      `json:"actor_id" gorm:"type:uuid;not null"`
+	ActorRole     string         `json:"actor_role" gorm:"type:varchar(20);not null"`
+	IsFlagged     bool           `json:"is_flagged" gorm:"not null;default:false"`
+	FlagReasons   pq.StringArray `json:"flag_reasons" gorm:"type:text[];not null;default:'{}'"`
+	CreatedAt     time.Time      `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (ProfileChange) TableName() string {
+	return "profile_changes"
+}
+
+// FieldChange is the old and new value of one column. Values are kept as
+// display strings, an empty string means the field was not set.
+type FieldChange struct {
+	Field    string `json:"field"`
+	OldValue string `json:"old_value"`
+	NewValue string `json:"new_value"`
+}
+
+// Touches reports whether the change edited an already set value of field,
+// filling in a field for the first time is not counted.
+func (c *ProfileChange) Touches(field string) bool {
+	for _, fc := range c.Changes {
+		if fc.Field == field && fc.OldValue != "" {
+			return true
+		}
+	}
+	return false
+}
+
+type GetProfileHistoryRequest struct {
+	UserProfileID int64 // 0 for every profile
+	Section       string
+	FlaggedOnly   bool
+	Limit         int
+	Offset        int
+}
+
+// DiffSnapshots returns the fields whose value differs between two
+// snapshots, sorted by field name.
+func DiffSnapshots(before, after map[string]string) []FieldChange {
+	changes := make([]FieldChange, 0)
+	for field, newValue := range after {
+		if oldValue := before[field]; oldValue != newValue {
+			changes = append(changes, FieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+// significantProfileFields are the HistorySnapshot fields users decide on,
+// a change to one of them is worth a second look from those who passed.
+var significantProfileFields = map[string]bool{
+	"marital_status":          true,
+	"profession":              true,
+	"profession_type":         true,
+	"highest_education_level": true,
+	"home_district":           true,
+	"native_place":            true,
+	"residence":               true,
+}
+
+// IsSignificantChange reports whether any of the changes is to a field users
+// decide on.
+func IsSignificantChange(changes []FieldChange) bool {
+	for _, change := range changes {
+		if significantProfileFields[change.Field] {
+			return true
+		}
+	}
+	return false
+}
+
+// HistorySnapshot returns the user editable fields of the profile keyed by
+// column name, the way they are recorded in the change history.
+func (p *UserProfile) HistorySnapshot() map[string]string {
+	dateOfBirth := ""
+	if !p.DateOfBirth.IsZero() {
+		dateOfBirth = p.DateOfBirth.Format("2006-01-02")
+	}
+	heightCm := ""
+	if p.HeightCm > 0 {
+		heightCm = strconv.Itoa(int(p.HeightCm))
+	}
+
+	return map[string]string{
+		"is_bride":                strconv.FormatBool(p.IsBride),
+		"full_name":               p.FullName,
+		"date_of_birth":           dateOfBirth,
+		"height_cm":               heightCm,
+		"physically_challenged":   strconv.FormatBool(p.PhysicallyChallenged),
+		"is_hidden":               strconv.FormatBool(p.IsHidden),
+		"community":               string(p.Community),
+		"marital_status":          string(p.MaritalStatus),
+		"profession":              string(p.Profession),
+		"profession_type":         string(p.ProfessionType),
+		"highest_education_level": string(p.HighestEducationLevel),
+		"home_district":           string(p.HomeDistrict),
+		"native_place":            p.NativePlace.historyValue(),
+		"residence":               p.Residence.historyValue(),
+		"preferred_language":      p.PreferredLanguage,
+		"digest_frequency":        p.DigestFrequency,
+		"birth_time":              p.BirthTime,
+		"birth_place":             p.BirthPlace,
+		"nakshatra":               string(p.Nakshatra),
+		"rasi":                    string(p.Rasi),
+		"chovva_dosham":           optionalBoolValue(p.ChovvaDosham),
+		"papa_dosham":             optionalBoolValue(p.PapaDosham),
+	}
+}
+
+// HistorySnapshot returns the partner preferences keyed by column name, the
+// way they are recorded in the change history.
+func (p *PartnerPreference) HistorySnapshot() map[string]string {
+	return map[string]string{
+		"min_age_years":                 strconv.Itoa(int(p.MinAgeYears)),
+		"max_age_years":                 strconv.Itoa(int(p.MaxAgeYears)),
+		"min_height_cm":                 strconv.Itoa(int(p.MinHeightCm)),
+		"max_height_cm":                 strconv.Itoa(int(p.MaxHeightCm)),
+		"accept_physically_challenged":  strconv.FormatBool(p.AcceptPhysicallyChallenged),
+		"preferred_communities":         strings.Join(p.PreferredCommunities, ","),
+		"preferred_marital_status":      strings.Join(p.PreferredMaritalStatus, ","),
+		"preferred_professions":         strings.Join(p.PreferredProfessions, ","),
+		"preferred_profession_types":    strings.Join(p.PreferredProfessionTypes, ","),
+		"preferred_education_levels":    strings.Join(p.PreferredEducationLevels, ","),
+		"preferred_home_districts":      strings.Join(p.PreferredHomeDistricts, ","),
+		"preferred_countries":           strings.Join(p.PreferredCountries, ","),
+		"preferred_residence_countries": strings.Join(p.PreferredResidenceCountries, ","),
+		"min_porutham_score":            strconv.Itoa(int(p.MinPoruthamScore)),
+		"accept_dosham":                 strconv.FormatBool(p.AcceptDosham),
+		"must_have_criteria":            strings.Join(p.MustHaveCriteria, ","),
+	}
+}
+
+// HistoryValue formats a value of a partner preference patch the way
+// PartnerPreference.HistorySnapshot does.
+func HistoryValue(value interface{}) string {
+	switch v := value.(type) {
+	case pq.StringArray:
+		return strings.Join(v, ",")
+	case []string:
+		return strings.Join(v, ",")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (l Location) historyValue() string {
+	if l.IsEmpty() {
+		return ""
+	}
+	parts := []string{l.Country}
+	for _, part := range []string{l.State, l.District, l.City} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+func optionalBoolValue(b *bool) string {
+	if b == nil {
+		return ""
+	}
+	return strconv.FormatBool(*b)
+}
@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSignificantChange(t *testing.T) {
+	tests := []struct {
+		name    string
+		changes []FieldChange
+		want    bool
+	}{
+		{
+			name:    "no changes",
+			changes: []FieldChange{},
+			want:    false,
+		},
+		{
+			name: "only fields users do not decide on",
+			changes: []FieldChange{
+				{Field: "full_name", OldValue: "Ameen", NewValue: "Muhammed Ameen"},
+				{Field: "is_hidden", OldValue: "false", NewValue: "true"},
+			},
+			want: false,
+		},
+		{
+			name: "profession changed",
+			changes: []FieldChange{
+				{Field: "profession", OldValue: "student", NewValue: "engineer"},
+			},
+			want: true,
+		},
+		{
+			name: "residence set for the first time",
+			changes: []FieldChange{
+				{Field: "full_name", OldValue: "Ameen", NewValue: "Muhammed Ameen"},
+				{Field: "residence", OldValue: "", NewValue: "AE/dubai"},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsSignificantChange(tt.changes))
+		})
+	}
+}
@@ -13,6 +13,7 @@ type ProfileMatch struct {
 	IsLiked   bool      `gorm:"not null;default:false"`
 	IsDeleted bool      `gorm:"not null;default:false"`
 	DeletedAt *time.Time
+	SeenAt    *time.Time
 	CreatedAt time.Time `gorm:"type:timestamptz;not null;default:now()"`
 	UpdatedAt time.Time `gorm:"type:timestamptz;not null;default:now()"`
 }
@@ -20,3 +21,15 @@ type ProfileMatch struct {
 func (ProfileMatch) TableName() string {
 	return "profile_matches"
 }
+
+// ReceivedInterest is a pending like from a user the receiver has not acted on
+type ReceivedInterest struct {
+	Profile    *UserProfileResponse
+	ReceivedAt time.Time
+	IsNew      bool
+}
+
+type ReceivedInterestCounts struct {
+	Pending int64
+	Unread  int64
+}
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProfileView struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement"`
+	ViewerID  uuid.UUID `gorm:"type:uuid;not null"`
+	ViewedID  uuid.UUID `gorm:"type:uuid;not null"`
+	ViewDate  time.Time `gorm:"type:date;not null"`
+	ViewedAt  time.Time `gorm:"not null;default:now()"`
+	CreatedAt time.Time `gorm:"not null;default:now()"`
+}
+
+func (ProfileView) TableName() string {
+	return "profile_views"
+}
+
+// ProfileVisitor is a distinct viewer along with their latest visit
+type ProfileVisitor struct {
+	ViewerID     uuid.UUID
+	LastViewedAt time.Time
+}
+
+type ProfileVisitorResponse struct {
+	Profile      *UserProfileResponse
+	LastViewedAt time.Time
+}
+
+type ProfileViewStats struct {
+	ViewsLast7Days  int64
+	ViewsLast30Days int64
+}
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CachedRecommendation is one entry of a user's precomputed recommendations.
+// The photo URL, the shortlist flag and the activity badge change
+// independently of the ranking, they are added when the entry is served.
+// RankScore is the compatibility score with the activity boost at the time
+// the entry was ranked, the order of the entries follows it until they are
+// ranked again. Removed marks a profile the user has acted on, or that no longer qualifies,
+// it is not served but keeps its place for page cursors pointing at it.
+type CachedRecommendation struct {
+	UserID    uuid.UUID           `json:"user_id"`
+	ImageKey  string              `json:"image_key,omitempty"`
+	LastLogin time.Time           `json:"last_login"`
+	RankScore int32               `json:"rank_score"`
+	Removed   bool                `json:"removed,omitempty"`
+	Profile   UserProfileResponse `json:"profile"`
+}
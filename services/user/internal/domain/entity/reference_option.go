@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+// ReferenceOption is one value of an admin-managed taxonomy such as
+// profession. Value is what profiles and preferences store, Label is what
+// clients show. Inactive options are hidden from clients and rejected on
+// new input, but profiles that already use them keep them.
+type ReferenceOption struct {
+	ID           int64     `json:"id" gorm:"primaryKey;autoIncrement"`
+	Taxonomy     string    `json:"taxonomy" gorm:"type:varchar(50);not null;uniqueIndex:idx_reference_options_taxonomy_value"`
+	Value        string    `json:"value" gorm:"type:varchar(100);not null;uniqueIndex:idx_reference_options_taxonomy_value"`
+	Label        string    `json:"label" gorm:"size:200;not null"`
+	DisplayOrder int32     `json:"display_order" gorm:"not null;default:0"`
+	IsActive     bool      `json:"is_active" gorm:"not null;default:true"`
+	CreatedAt    time.Time `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt    time.Time `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (ReferenceOption) TableName() string {
+	return "reference_options"
+}
+
+type CreateReferenceOptionRequest struct {
+	Taxonomy     string
+	Value        string
+	Label        string
+	DisplayOrder int32
+}
+
+type UpdateReferenceOptionRequest struct {
+	Label        *string
+	DisplayOrder *int32
+	IsActive     *bool
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Report struct {
+	ID                int64      `gorm:"primaryKey;autoIncrement"`
+	ReporterID        uuid.UUID  `gorm:"type:uuid;not null"`
+	TargetUserID      uuid.UUID  `gorm:"type:uuid;not null"`
+	TargetProfileID   int64      `gorm:"not null"`
+	TargetType        string     `gorm:"type:varchar(20);not null"`
+	PhotoDisplayOrder *int16     `gorm:"type:smallint"`
+	PhotoObjectKey    *string    `gorm:"type:varchar(500)"`
+	MessageID         *string    `gorm:"type:varchar(64)"`
+	MessageContent    *string    `gorm:"type:text"`
+	Category          string     `gorm:"type:varchar(50);not null"`
+	Description       string     `gorm:"type:text;not null;default:''"`
+	Status            string     `gorm:"type:varchar(20);not null;default:'pending'"`
+	Action            *string    `gorm:"type:varchar(20)"`
+	AdminNote         *string    `gorm:"type:text"`
+	ResolvedBy        *uuid.UUID `gorm:"type:uuid"`
+	ResolvedAt        *time.Time `gorm:"type:timestamptz"`
+	CreatedAt         time.Time  `gorm:"not null;default:now()"`
+	UpdatedAt         time.Time  `gorm:"not null;default:now()"`
+}
+
+func (Report) TableName() string {
+	return "reports"
+}
+
+type CreateReportRequest struct {
+	TargetProfileID   int64
+	TargetType        string
+	PhotoDisplayOrder *int16
+	Category          string
+	Description       string
+}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchFilters is an ad-hoc profile search, independent of the partner
+// preferences. Unset fields do not filter, list fields use the "any"
+// semantics of the preference lists.
+type SearchFilters struct {
+	MinAgeYears *int16 `json:"min_age_years,omitempty"`
+	MaxAgeYears *int16 `json:"max_age_years,omitempty"`
+	MinHeightCm *int16 `json:"min_height_cm,omitempty"`
+	MaxHeightCm *int16 `json:"max_height_cm,omitempty"`
+
+	PhysicallyChallenged *bool `json:"physically_challenged,omitempty"`
+
+	Communities        []string `json:"communities,omitempty"`
+	MaritalStatuses    []string `json:"marital_statuses,omitempty"`
+	Professions        []string `json:"professions,omitempty"`
+	ProfessionTypes    []string `json:"profession_types,omitempty"`
+	EducationLevels    []string `json:"education_levels,omitempty"`
+	HomeDistricts      []string `json:"home_districts,omitempty"`
+	NativeCountries    []string `json:"native_countries,omitempty"`
+	ResidenceCountries []string `json:"residence_countries,omitempty"`
+
+	HasPhoto         bool `json:"has_photo,omitempty"`          // any approved photo, profile or additional
+	VerifiedOnly     bool `json:"verified_only,omitempty"`      // profile photo approved by a moderator
+	ActiveWithinDays int  `json:"active_within_days,omitempty"` // last login within the given days, 0 for any
+
+	Sort string `json:"sort,omitempty"` // constants.SearchSort*, default recently active
+}
+
+// SavedSearch is a search a user keeps to run again. With alerts on, the
+// user is emailed when profiles completed after LastCheckedAt match it.
+type SavedSearch struct {
+	ID            int64         `json:"id" gorm:"primaryKey;autoIncrement"`
+	UserID        uuid.UUID     `json:"user_id" gorm:"type:uuid;not null;index"`
+	Name          string        `json:"name" gorm:"size:100;not null"`
+	Filters       SearchFilters `json:"filters" gorm:"type:jsonb;serializer:json;not null"`
+	AlertsEnabled bool          `json:"alerts_enabled" gorm:"not null;default:true"`
+	LastCheckedAt time.Time     `json:"last_checked_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	CreatedAt     time.Time     `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt     time.Time     `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (SavedSearch) TableName() string {
+	return "saved_searches"
+}
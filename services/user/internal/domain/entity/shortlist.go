@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Shortlist struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement"`
+	UserID       uuid.UUID `gorm:"type:uuid;not null"`
+	TargetUserID uuid.UUID `gorm:"type:uuid;not null"`
+	Note         string    `gorm:"type:text;not null;default:''"`
+	CreatedAt    time.Time `gorm:"not null;default:now()"`
+	UpdatedAt    time.Time `gorm:"not null;default:now()"`
+}
+
+func (Shortlist) TableName() string {
+	return "shortlists"
+}
+
+type ShortlistedProfileResponse struct {
+	Profile       *UserProfileResponse
+	Note          string
+	ShortlistedAt time.Time
+}
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserBlock struct {
+	ID        int64      `gorm:"primaryKey;autoIncrement"`
+	BlockerID uuid.UUID  `gorm:"type:uuid;not null"`
+	BlockedID uuid.UUID  `gorm:"type:uuid;not null"`
+	IsDeleted bool       `gorm:"not null;default:false"`
+	DeletedAt *time.Time `gorm:"type:timestamptz"`
+	CreatedAt time.Time  `gorm:"not null;default:now()"`
+	UpdatedAt time.Time  `gorm:"not null;default:now()"`
+}
+
+func (UserBlock) TableName() string {
+	return "user_blocks"
+}
@@ -4,19 +4,62 @@ import (
     "time"
 
     "github.com/google/uuid"
+    "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+    "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/gcsutil"
     "gorm.io/gorm"
 )
 
 type UserImage struct {
-    ID           int64           `gorm:"primaryKey;autoIncrement"`
-    UserID       uuid.UUID      `gorm:"type:uuid;not null;index:idx_user_images_user_id"`
-    ObjectKey    string         `gorm:"type:varchar(500);not null"`
-    DisplayOrder int16          `gorm:"type:smallint;not null;check:display_order >= 1 AND display_order <= 3;index:uniq_active_img_order,unique"`
-    CreatedAt    time.Time      `gorm:"not null;default:now()"`
-    UpdatedAt    time.Time      `gorm:"not null;default:now()"`
-    DeletedAt    gorm.DeletedAt `gorm:"index"`  
+    ID              int64          `gorm:"primaryKey;autoIncrement"`
+    UserID          uuid.UUID      `gorm:"type:uuid;not null;index:idx_user_images_user_id"`
+    ObjectKey       string         `gorm:"type:varchar(500);not null"`
+    DisplayOrder    int16          `gorm:"type:smallint;not null;check:display_order >= 1 AND display_order <= 9;index:uniq_active_img_order,unique"`
+    Processed       bool           `gorm:"not null;default:false"`
+    Status          string         `gorm:"type:varchar(20);not null;default:'approved'"`
+    RejectionReason *string        `gorm:"type:text"`
+    ReviewedAt      *time.Time     `gorm:"type:timestamptz"`
+    CreatedAt       time.Time      `gorm:"not null;default:now()"`
+    UpdatedAt       time.Time      `gorm:"not null;default:now()"`
+    DeletedAt       gorm.DeletedAt `gorm:"index"`
 }
 
 func (UserImage) TableName() string {
     return "user_images"
 }
+
+func (i UserImage) IsApproved() bool {
+    return i.Status == constants.PhotoStatusApproved
+}
+
+// KeyForSize returns the object key of the photo in the given size.
+func (i UserImage) KeyForSize(size string) string {
+    return sizedPhotoKey(i.ObjectKey, i.Processed, size)
+}
+
+// Photos uploaded before processing was added only exist as the original upload.
+func sizedPhotoKey(objectKey string, processed bool, size string) string {
+    if objectKey == "" || !processed {
+        return objectKey
+    }
+    return gcsutil.SizedObjectKey(objectKey, size)
+}
+
+// PendingPhoto is an entry in the admin photo review queue.
+// DisplayOrder 0 is the profile photo, 1..constants.MaxAdditionalPhotoSlots
+// are additional photos.
+type PendingPhoto struct {
+    UserProfileID int64
+    UserID        uuid.UUID
+    ObjectKey     string
+    DisplayOrder  int16
+    UploadedAt    time.Time
+}
+
+type PendingPhotoResponse struct {
+    UserProfileID int64
+    UserID        uuid.UUID
+    ObjectKey     string
+    DisplayOrder  int16
+    PhotoURL      string
+    UploadedAt    time.Time
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
 	"gorm.io/gorm"
 )
@@ -19,6 +21,7 @@ type UserProfile struct {
 	HeightCm             int16     `json:"height_cm" gorm:"type:smallint;not null;check:height_cm > 0"`
 	PhysicallyChallenged bool      `json:"physically_challenged" gorm:"not null;default:false"`
 	ProfileCompleted     bool      `json:"profile_completed" gorm:"not null;default:false"`
+	IsHidden             bool      `json:"is_hidden" gorm:"not null;default:false"`
 
 	Community             validation.Community      `json:"community" gorm:"type:varchar(255)"`
 	MaritalStatus         validation.MaritalStatus  `json:"marital_status" gorm:"type:varchar(255)"`
@@ -27,11 +30,38 @@ type UserProfile struct {
 	HighestEducationLevel validation.EducationLevel `json:"highest_education_level" gorm:"type:varchar(255)"`
 	HomeDistrict          validation.HomeDistrict   `json:"home_district" gorm:"type:varchar(255)"`
 
-	ProfileImageKey string         `json:"profile_image_key" gorm:"size:255"`
-	LastLogin       time.Time      `json:"last_login" gorm:"not null;default:CURRENT_TIMESTAMP"`
-	CreatedAt       time.Time      `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
-	UpdatedAt       time.Time      `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
-	DeletedAt       gorm.DeletedAt `gorm:"index"            json:"deleted_at,omitempty"`
+	// Location, stored as native_* and residence_* columns
+	NativePlace Location `json:"native_place" gorm:"embedded;embeddedPrefix:native_"`
+	Residence   Location `json:"residence" gorm:"embedded;embeddedPrefix:residence_"`
+
+	// Language of emails sent to the user, see pkg/i18n
+	PreferredLanguage string `json:"preferred_language" gorm:"type:varchar(5);not null;default:'en'"`
+
+	// Match digest email, constants.DigestFrequency*
+	DigestFrequency  string     `json:"digest_frequency" gorm:"type:varchar(10);not null;default:'off'"`
+	LastDigestSentAt *time.Time `json:"last_digest_sent_at" gorm:"type:timestamptz"`
+
+	// Last change users decide on, see IsSignificantChange. Passes made before it
+	// no longer hide the profile from recommendations
+	SignificantlyUpdatedAt *time.Time `json:"significantly_updated_at" gorm:"type:timestamptz"`
+
+	// Horoscope, all optional
+	BirthTime    string               `json:"birth_time" gorm:"type:varchar(5)"`
+	BirthPlace   string               `json:"birth_place" gorm:"size:200"`
+	Nakshatra    validation.Nakshatra `json:"nakshatra" gorm:"type:varchar(50);index:idx_user_profiles_nakshatra_rasi"`
+	Rasi         validation.Rasi      `json:"rasi" gorm:"type:varchar(50);index:idx_user_profiles_nakshatra_rasi"`
+	ChovvaDosham *bool                `json:"chovva_dosham"`
+	PapaDosham   *bool                `json:"papa_dosham"`
+
+	ProfileImageKey        string         `json:"profile_image_key" gorm:"size:255"`
+	ProfileImageStatus     string         `json:"profile_image_status" gorm:"type:varchar(20);not null;default:'approved'"`
+	ProfileImageProcessed  bool           `json:"profile_image_processed" gorm:"not null;default:false"`
+	ProfileImageUploadedAt *time.Time     `json:"profile_image_uploaded_at" gorm:"type:timestamptz"`
+	LastLogin              time.Time      `json:"last_login" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	CompletedAt            *time.Time     `json:"completed_at" gorm:"type:timestamptz"` // first time the profile was filled in
+	CreatedAt              time.Time      `json:"created_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	UpdatedAt              time.Time      `json:"updated_at" gorm:"not null;default:CURRENT_TIMESTAMP"`
+	DeletedAt              gorm.DeletedAt `gorm:"index"            json:"deleted_at,omitempty"`
 
 	// Association
 	PartnerPreference PartnerPreference `json:"partner_preference,omitempty" gorm:"foreignKey:UserProfileID"`
@@ -41,6 +71,39 @@ func (UserProfile) TableName() string {
 	return "user_profiles"
 }
 
+// PublicProfileImageKey returns the profile image key in the given size only
+// once the photo is approved, so pending or rejected photos are never served
+// to other users.
+func (p *UserProfile) PublicProfileImageKey(size string) string {
+	if p.ProfileImageStatus != constants.PhotoStatusApproved {
+		return ""
+	}
+	return p.ProfileImageKeyForSize(size)
+}
+
+// ProfileImageKeyForSize returns the profile image key in the given size,
+// regardless of review status. Used for the owner and admins.
+func (p *UserProfile) ProfileImageKeyForSize(size string) string {
+	return sizedPhotoKey(p.ProfileImageKey, p.ProfileImageProcessed, size)
+}
+
+// Locale returns the language emails to this user should be written in.
+func (p *UserProfile) Locale() string {
+	return string(i18n.Resolve(p.PreferredLanguage))
+}
+
+// ActivityStatus returns the activity badge of a profile last logged in at
+// lastLogin, empty when it was not active in the last week.
+func ActivityStatus(lastLogin, now time.Time) string {
+	switch since := now.Sub(lastLogin); {
+	case since < 24*time.Hour:
+		return constants.ActivityStatusActiveToday
+	case since < 7*24*time.Hour:
+		return constants.ActivityStatusActiveThisWeek
+	}
+	return ""
+}
+
 // This should be similar to the proto file code.
 type UpdateUserProfileRequest struct {
 	IsBride               *bool   `json:"is_bride"`
@@ -54,7 +117,23 @@ type UpdateUserProfileRequest struct {
 	ProfessionType        *string `json:"profession_type"`
 	HighestEducationLevel *string `json:"highest_education_level"`
 	HomeDistrict          *string `json:"home_district"`
+	BirthTime             *string `json:"birth_time"`
+	BirthPlace            *string `json:"birth_place"`
+	Nakshatra             *string `json:"nakshatra"`
+	Rasi                  *string `json:"rasi"`
+	ChovvaDosham          *bool   `json:"chovva_dosham"`
+	PapaDosham            *bool   `json:"papa_dosham"`
 	ProfileCompleted      *bool   `json:"profile_completed"`
+	IsHidden              *bool   `json:"is_hidden"`
+
+	// A location with an empty country clears it
+	NativePlace *Location `json:"native_place"`
+	Residence   *Location `json:"residence"`
+
+	PreferredLanguage *string `json:"preferred_language"`
+	DigestFrequency   *string `json:"digest_frequency"`
+	RequestLocale     string  `json:"-"` // language of the request, adopted on the first update
+	ActorRole         string  `json:"-"` // role of the caller, recorded in the change history
 }
 
 type UserProfileResponse struct {
@@ -66,4 +145,27 @@ type UserProfileResponse struct {
 	MaritalStatus     string  `json:"marital_status"`
 	Profession        string  `json:"profession"`
 	HomeDistrict      string  `json:"home_district"`
+	IsShortlisted     bool    `json:"is_shortlisted"`
+
+	NativePlace *Location `json:"native_place,omitempty"`
+	Residence   *Location `json:"residence,omitempty"`
+	IsNRI       bool      `json:"is_nri"`
+
+	Horoscope *Horoscope             `json:"horoscope,omitempty"`
+	Porutham  *PoruthamCompatibility `json:"porutham,omitempty"`
+
+	// Recommendations only, how well the profile fits the viewer's preferences
+	// and which of the preferences the viewer restricted it satisfies
+	CompatibilityScore int32    `json:"compatibility_score,omitempty"`
+	MatchedCriteria    []string `json:"matched_criteria,omitempty"`
+	UnmatchedCriteria  []string `json:"unmatched_criteria,omitempty"`
+	// Whether the viewer fits the profile's own partner preferences
+	MatchesTheirPreferences bool `json:"matches_their_preferences,omitempty"`
+	// Recommendations only, the viewer passed on the profile before
+	PreviouslyPassed bool `json:"previously_passed,omitempty"`
+	// Active today or this week, see ActivityStatus
+	ActivityStatus string `json:"activity_status,omitempty"`
+
+	PreferredLanguage string `json:"preferred_language,omitempty"` // own profile only
+	DigestFrequency   string `json:"digest_frequency,omitempty"`   // own profile only
 }
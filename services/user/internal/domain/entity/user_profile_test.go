@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+)
+
+func TestActivityStatus(t *testing.T) {
+	now := time.Date(2025, 8, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		lastLogin time.Time
+		want      string
+	}{
+		{name: "just now", lastLogin: now, want: constants.ActivityStatusActiveToday},
+		{name: "within a day", lastLogin: now.Add(-23*time.Hour - 59*time.Minute), want: constants.ActivityStatusActiveToday},
+		{name: "exactly a day ago", lastLogin: now.Add(-24 * time.Hour), want: constants.ActivityStatusActiveThisWeek},
+		{name: "within a week", lastLogin: now.Add(-6 * 24 * time.Hour), want: constants.ActivityStatusActiveThisWeek},
+		{name: "exactly a week ago", lastLogin: now.Add(-7 * 24 * time.Hour), want: ""},
+		{name: "long ago", lastLogin: now.AddDate(-1, 0, 0), want: ""},
+		{name: "never logged in", lastLogin: time.Time{}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ActivityStatus(tt.lastLogin, now))
+		})
+	}
+}
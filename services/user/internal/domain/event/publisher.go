@@ -10,4 +10,10 @@ type EventPublisher interface {
 	PublishUserProfileUpdated(ctx context.Context, event userevents.UserProfileUpdatedEvent) error
 	PublishUserInterestSent(ctx context.Context, event userevents.UserInterestSentEvent) error
 	PublishMutualMatchCreated(ctx context.Context, event userevents.MutualMatchCreatedEvent) error
+	PublishMutualMatchRemoved(ctx context.Context, event userevents.MutualMatchRemovedEvent) error
+	PublishUserBlockUpdated(ctx context.Context, event userevents.UserBlockUpdatedEvent) error
+	PublishUserWarned(ctx context.Context, event userevents.UserWarnedEvent) error
+	PublishUserPhotoRejected(ctx context.Context, event userevents.UserPhotoRejectedEvent) error
+	PublishSavedSearchMatched(ctx context.Context, event userevents.SavedSearchMatchedEvent) error
+	PublishMatchDigest(ctx context.Context, event userevents.MatchDigestEvent) error
 }
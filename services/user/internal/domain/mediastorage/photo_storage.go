@@ -11,6 +11,14 @@ type PhotoUploadURLResponse struct {
 	ExpiresInSeconds uint32
 }
 
+// PhotoMetadata describes a stored photo object as reported by the storage backend.
+type PhotoMetadata struct {
+	ObjectKey   string
+	ContentType string
+	Size        int64
+	CreatedAt   time.Time
+}
+
 type PhotoStorage interface {
 	GetProfilePhotoUploadURL(
 		ctx context.Context,
@@ -24,5 +32,11 @@ type PhotoStorage interface {
 		contentType string,
 		expiry time.Duration) (*PhotoUploadURLResponse, error)
 	GetDownloadURL(ctx context.Context, objectKey string, expiry time.Duration) (string, error)
+	// GetPhotoMetadata returns nil when the object does not exist.
+	GetPhotoMetadata(ctx context.Context, objectKey string) (*PhotoMetadata, error)
+	// ListPhotos calls fn with the photos under prefix, at most pageSize at a time.
+	ListPhotos(ctx context.Context, prefix string, pageSize int, fn func([]PhotoMetadata) error) error
+	DownloadPhoto(ctx context.Context, objectKey string) ([]byte, error)
+	UploadPhoto(ctx context.Context, objectKey string, contentType string, data []byte) error
 	DeletePhoto(ctx context.Context, objectKey string) error
 }
@@ -4,10 +4,14 @@ import (
 	"context"
 
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"gorm.io/gorm"
 )
 
 type PartnerPreferencesRepository interface {
 	CreatePartnerPreferences(ctx context.Context, preferences *entity.PartnerPreference) error
 	GetPartnerPreferencesByUserProfileID(ctx context.Context, userProfileID int64) (*entity.PartnerPreference, error)
+	GetPartnerPreferencesByUserProfileIDs(ctx context.Context, userProfileIDs []int64) ([]*entity.PartnerPreference, error)
 	PatchPartnerPreferences(ctx context.Context, userProfileID int64, patch map[string]interface{}) error
+	CreatePartnerPreferencesTx(ctx context.Context, tx *gorm.DB, preferences *entity.PartnerPreference) error
+	PatchPartnerPreferencesTx(ctx context.Context, tx *gorm.DB, userProfileID int64, patch map[string]interface{}) error
 }
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+type ProfileChangeRepository interface {
+	// CreateProfileChangeTx assigns the next version of the profile section
+	CreateProfileChangeTx(ctx context.Context, tx *gorm.DB, change *entity.ProfileChange) error
+	GetProfileChangesSince(ctx context.Context, userProfileID int64, section string, since time.Time) ([]*entity.ProfileChange, error)
+	ListProfileChanges(ctx context.Context, req entity.GetProfileHistoryRequest) ([]*entity.ProfileChange, int64, error)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
@@ -10,11 +11,24 @@ import (
 
 type ProfileMatchRepository interface {
 	GetExistingMatch(ctx context.Context, userID uuid.UUID, targetID uuid.UUID) (*entity.ProfileMatch, error)
-	CreateMatchAction(ctx context.Context, userID uuid.UUID, targetID uuid.UUID, isLiked bool) error
-	CreateMatchActionTx(ctx context.Context, tx *gorm.DB, userID uuid.UUID, targetID uuid.UUID, isLiked bool) error
+	// CreateMatchAction reports false when the user has already acted on the target.
+	CreateMatchAction(ctx context.Context, userID uuid.UUID, targetID uuid.UUID, isLiked bool) (bool, error)
+	CreateMatchActionTx(ctx context.Context, tx *gorm.DB, userID uuid.UUID, targetID uuid.UUID, isLiked bool) (bool, error)
 	UpdateMatchAction(ctx context.Context, userID uuid.UUID, targetID uuid.UUID, isLiked bool) error
 	UpdateMatchActionTx(ctx context.Context, tx *gorm.DB, userID uuid.UUID, targetID uuid.UUID, isLiked bool) error
 	GetMatchedProfileIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	// GetRecommendationExcludedIDs returns the liked profiles and the passed
+	// ones that have not resurfaced. A pass resurfaces once the profile has
+	// significantly changed since, or when it was made before passedBefore.
+	GetRecommendationExcludedIDs(ctx context.Context, userID uuid.UUID, passedBefore *time.Time) ([]uuid.UUID, error)
+	// GetLatestMatchAction returns nil when the user has not acted on anyone.
+	GetLatestMatchAction(ctx context.Context, userID uuid.UUID) (*entity.ProfileMatch, error)
+	DeleteMatchActionTx(ctx context.Context, tx *gorm.DB, userID uuid.UUID, targetID uuid.UUID) error
 	GetLikedUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
 	GetPassedUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	// GetPendingInterests returns the likes targetID received from users it
+	// has not liked or passed yet, newest first.
+	GetPendingInterests(ctx context.Context, targetID uuid.UUID, excludedIDs []uuid.UUID, limit, offset int) ([]*entity.ProfileMatch, int64, error)
+	CountPendingInterests(ctx context.Context, targetID uuid.UUID, excludedIDs []uuid.UUID) (*entity.ReceivedInterestCounts, error)
+	MarkInterestsSeen(ctx context.Context, targetID uuid.UUID, senderIDs []uuid.UUID, seenAt time.Time) error
 }
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+type ProfileViewRepository interface {
+	RecordProfileView(ctx context.Context,
+		viewerID, viewedID uuid.UUID,
+		viewedAt time.Time) error
+	GetProfileVisitors(ctx context.Context,
+		viewedID uuid.UUID,
+		excludedIDs []uuid.UUID,
+		limit, offset int) ([]*entity.ProfileVisitor, int64, error)
+	CountProfileViewsSince(ctx context.Context,
+		viewedID uuid.UUID,
+		since time.Time) (int64, error)
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+// RecommendationCacheRepository keeps the ranked recommendations of each user,
+// along with which users every profile is currently recommended to.
+type RecommendationCacheRepository interface {
+	// GetRecommendations returns nil when nothing is cached for the user.
+	GetRecommendations(ctx context.Context,
+		userID uuid.UUID) ([]entity.CachedRecommendation, error)
+	SetRecommendations(ctx context.Context,
+		userID uuid.UUID,
+		recommendations []entity.CachedRecommendation,
+		ttl time.Duration) error
+	// UpdateRecommendations lets update edit the cached recommendations of the
+	// user in place, update reports whether it changed any. The expiry is
+	// kept and nothing is done when none are cached.
+	UpdateRecommendations(ctx context.Context,
+		userID uuid.UUID,
+		update func(recommendations []entity.CachedRecommendation) bool) error
+	DeleteRecommendations(ctx context.Context,
+		userID uuid.UUID) error
+	GetRecommendedToUserIDs(ctx context.Context,
+		profileID int64) ([]uuid.UUID, error)
+}
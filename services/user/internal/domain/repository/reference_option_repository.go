@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+type ReferenceOptionRepository interface {
+	CreateReferenceOption(ctx context.Context, option *entity.ReferenceOption) error
+	GetReferenceOptionByID(ctx context.Context, id int64) (*entity.ReferenceOption, error)
+	GetReferenceOption(ctx context.Context, taxonomy, value string) (*entity.ReferenceOption, error)
+	// An empty taxonomy lists every taxonomy
+	ListReferenceOptions(ctx context.Context, taxonomy string, includeInactive bool) ([]*entity.ReferenceOption, error)
+	UpdateReferenceOption(ctx context.Context, option *entity.ReferenceOption) error
+	DeleteReferenceOption(ctx context.Context, id int64) error
+}
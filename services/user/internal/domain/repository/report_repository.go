@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+type ReportRepository interface {
+	CreateReport(ctx context.Context, report *entity.Report) error
+	GetReportByID(ctx context.Context, reportID int64) (*entity.Report, error)
+	GetReportsByStatus(ctx context.Context, status string, limit, offset int) ([]*entity.Report, int64, error)
+	ResolveReportTx(ctx context.Context, tx *gorm.DB, report *entity.Report) (bool, error)
+}
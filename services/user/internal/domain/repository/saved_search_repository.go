@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+type SavedSearchRepository interface {
+	CreateSavedSearchTx(ctx context.Context,
+		tx *gorm.DB,
+		search *entity.SavedSearch) error
+	CountSavedSearchesTx(ctx context.Context,
+		tx *gorm.DB,
+		userID uuid.UUID) (int64, error)
+	ListSavedSearches(ctx context.Context,
+		userID uuid.UUID) ([]*entity.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context,
+		userID uuid.UUID,
+		id int64) (bool, error)
+	GetSavedSearchesDueForAlert(ctx context.Context,
+		checkedBefore time.Time,
+		limit int) ([]*entity.SavedSearch, error)
+	UpdateLastCheckedAt(ctx context.Context,
+		id int64,
+		checkedAt time.Time) error
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+type ShortlistRepository interface {
+	UpsertShortlist(ctx context.Context,
+		userID, targetUserID uuid.UUID,
+		note string) error
+	DeleteShortlist(ctx context.Context,
+		userID, targetUserID uuid.UUID) (bool, error)
+	GetShortlist(ctx context.Context,
+		userID uuid.UUID,
+		excludedIDs []uuid.UUID,
+		limit, offset int) ([]*entity.Shortlist, int64, error)
+	GetShortlistedUserIDs(ctx context.Context,
+		userID uuid.UUID,
+		targetUserIDs []uuid.UUID) ([]uuid.UUID, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type UserBlockRepository interface {
+	UpsertUserBlockTx(ctx context.Context, tx *gorm.DB, blockerID, blockedID uuid.UUID) error
+	DeactivateUserBlock(ctx context.Context, blockerID, blockedID uuid.UUID) error
+	IsBlockedEitherWay(ctx context.Context, userID1, userID2 uuid.UUID) (bool, error)
+	GetBlockedUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
@@ -5,12 +5,20 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"gorm.io/gorm"
 )
 
 type UserImageRepository interface {
 	DisplayOrderOccupied(ctx context.Context, userID uuid.UUID, displayOrder int32) (bool, error)
 	CreateUserImage(ctx context.Context, userImage *entity.UserImage) error
+	ReplaceUserImageTx(ctx context.Context, tx *gorm.DB, oldImageID int64, userImage *entity.UserImage) error
+	ReorderUserImagesTx(ctx context.Context, tx *gorm.DB, userID uuid.UUID, newDisplayOrders map[int64]int16) error
+	UpdateUserImagePhotoTx(ctx context.Context, tx *gorm.DB, imageID int64, objectKey, status string, processed bool) error
+	DeleteUserImageByIDTx(ctx context.Context, tx *gorm.DB, imageID int64) error
 	DeleteUserImage(ctx context.Context, userID uuid.UUID, displayOrder int32) error
 	GetUserImage(ctx context.Context, userID uuid.UUID, displayOrder int32) (*entity.UserImage, error)
 	ListUserImages(ctx context.Context, userID uuid.UUID) ([]entity.UserImage, error)
+	UpdateUserImageReview(ctx context.Context, userID uuid.UUID, displayOrder int32, objectKey, status string, rejectionReason *string) (bool, error)
+	ListPendingPhotos(ctx context.Context, limit, offset int) ([]*entity.PendingPhoto, int64, error)
+	GetReferencedObjectKeys(ctx context.Context, objectKeys []string) ([]string, error)
 }
@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"gorm.io/gorm"
 )
 
 type UserProfileRepository interface {
@@ -20,13 +22,50 @@ type UserProfileRepository interface {
 		userID uuid.UUID) (*entity.UserProfile, error)
 	UpdateUserProfile(ctx context.Context,
 		userProfile *entity.UserProfile) error
+	UpdateUserProfileTx(ctx context.Context,
+		tx *gorm.DB,
+		userProfile *entity.UserProfile) error
+	UpdateProfileImageTx(ctx context.Context,
+		tx *gorm.DB,
+		userID uuid.UUID,
+		objectKey, status string,
+		processed bool,
+		uploadedAt time.Time) error
+	UpdateProfileImageReview(ctx context.Context,
+		userID uuid.UUID,
+		objectKey, status string,
+		significantlyUpdatedAt *time.Time) (bool, error)
+	LockProfileByUserIDTx(ctx context.Context,
+		tx *gorm.DB,
+		userID uuid.UUID) error
 	GetUserProfileByID(ctx context.Context,
 		id int64) (*entity.UserProfile, error)
+	GetProfilesByUserIDs(ctx context.Context,
+		userIDs []uuid.UUID) ([]*entity.UserProfile, error)
 	GetPotentialProfiles(ctx context.Context,
 		userID uuid.UUID,
 		excludedIDs []uuid.UUID,
 		preferences *entity.PartnerPreference,
+		compatibleSigns []entity.HoroscopeSign,
+		completedAfter *time.Time,
+		activeSince *time.Time,
+		limit int,
+		offset int,
+		isUserBride bool) ([]*entity.UserProfile, int64, error)
+	SearchProfiles(ctx context.Context,
+		userID uuid.UUID,
+		excludedIDs []uuid.UUID,
+		filters entity.SearchFilters,
+		completedAfter *time.Time,
 		limit int,
 		offset int,
 		isUserBride bool) ([]*entity.UserProfile, int64, error)
+	GetReferencedProfileImageKeys(ctx context.Context,
+		objectKeys []string) ([]string, error)
+	GetProfilesDueForDigest(ctx context.Context,
+		dailySentBefore, weeklySentBefore time.Time,
+		limit int) ([]*entity.UserProfile, error)
+	UpdateLastDigestSentAt(ctx context.Context,
+		id int64,
+		sentAt time.Time) error
 }
@@ -0,0 +1,60 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+)
+
+func (u *matchMakingUsecase) AddToShortlist(
+	ctx context.Context,
+	userID uuid.UUID,
+	targetProfileID int64,
+	note string) error {
+
+	note = strings.TrimSpace(note)
+	if len(note) > constants.MaxShortlistNoteLength {
+		return apperrors.ErrShortlistNoteTooLong
+	}
+
+	userProfile, err := u.userProfileRepository.GetProfileByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error retrieving user profile: %w", err)
+	}
+	if userProfile == nil {
+		return apperrors.ErrUserProfileNotFound
+	}
+
+	targetProfile, err := u.userProfileRepository.GetUserProfileByID(ctx, targetProfileID)
+	if err != nil {
+		return fmt.Errorf("error retrieving target profile: %w", err)
+	}
+	if targetProfile == nil {
+		return apperrors.ErrUserProfileNotFound
+	}
+	if targetProfile.UserID == userID {
+		return apperrors.ErrCannotShortlistSelf
+	}
+	if targetProfile.IsBride == userProfile.IsBride {
+		return apperrors.ErrSameGenderProfileAccessDenied
+	}
+
+	isBlocked, err := u.userBlockRepository.IsBlockedEitherWay(ctx, userID, targetProfile.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to check user block: %w", err)
+	}
+	if isBlocked {
+		return apperrors.ErrProfileBlocked
+	}
+
+	// Unlike a "like", shortlisting is private and does not notify the other user
+	if err := u.shortlistRepository.UpsertShortlist(ctx, userID, targetProfile.UserID, note); err != nil {
+		return fmt.Errorf("could not add profile to shortlist: %w", err)
+	}
+
+	return nil
+}
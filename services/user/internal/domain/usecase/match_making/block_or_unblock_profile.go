@@ -0,0 +1,69 @@
+package matchmaking
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	userevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/user"
+	"gorm.io/gorm"
+)
+
+func (u *matchMakingUsecase) BlockOrUnblockProfile(
+	ctx context.Context,
+	userID uuid.UUID,
+	targetProfileID int64,
+	shouldBlock bool) (bool, error) {
+
+	targetProfile, err := u.userProfileRepository.GetUserProfileByID(ctx, targetProfileID)
+	if err != nil {
+		return false, fmt.Errorf("error retrieving target profile: %w", err)
+	}
+	if targetProfile == nil {
+		return false, apperrors.ErrUserProfileNotFound
+	}
+	if targetProfile.UserID == userID {
+		return false, apperrors.ErrCannotBlockSelf
+	}
+
+	if shouldBlock {
+		// Ensure user id on left always less than right, used for mutual match only
+		userID1, userID2 := userID, targetProfile.UserID
+		if bytes.Compare(userID1[:], userID2[:]) > 0 {
+			userID1, userID2 = userID2, userID1
+		}
+
+		if txErr := u.transactionManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+			if err := u.userBlockRepository.UpsertUserBlockTx(ctx, tx, userID, targetProfile.UserID); err != nil {
+				return err
+			}
+			// A block always dissolves an existing mutual match
+			return u.mutualMatchRepository.DeactivateMutualMatchTx(ctx, tx, userID1, userID2)
+		}); txErr != nil {
+			return false, fmt.Errorf("could not block profile: %w", txErr)
+		}
+	} else {
+		if err := u.userBlockRepository.DeactivateUserBlock(ctx, userID, targetProfile.UserID); err != nil {
+			return false, fmt.Errorf("could not unblock profile: %w", err)
+		}
+	}
+
+	// Blocks are rare, both users are simply ranked again
+	_ = u.recommendationCache.DeleteRecommendations(ctx, userID)
+	_ = u.recommendationCache.DeleteRecommendations(ctx, targetProfile.UserID)
+
+	// Chat service keeps its own copy of the block list
+	if err := u.eventPublisher.PublishUserBlockUpdated(ctx, userevents.UserBlockUpdatedEvent{
+		BlockerUserID: userID,
+		BlockedUserID: targetProfile.UserID,
+		IsBlocked:     shouldBlock,
+		UpdatedAt:     time.Now().UTC(),
+	}); err != nil {
+		// no need to return error here, just log it in event publisher side.
+	}
+
+	return true, nil
+}
@@ -0,0 +1,171 @@
+package matchmaking
+
+import (
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/ageutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+// Dimensions checked the other way round as well, whether the user fits what
+// the candidate is looking for
+var reciprocalMatchCriteria = []string{
+	constants.MatchCriterionAge,
+	constants.MatchCriterionHeight,
+	constants.MatchCriterionCommunity,
+	constants.MatchCriterionMaritalStatus,
+	constants.MatchCriterionHomeDistrict,
+}
+
+type compatibilityScore struct {
+	Score     int32 // 0 to 100
+	Matched   []string
+	Unmatched []string
+}
+
+// scoreCompatibility scores a candidate against the user's partner
+// preferences. Dimensions the user has left open ("any", accepting everyone)
+// do not count either way, a user with no restrictions at all scores every
+// candidate 100.
+func scoreCompatibility(
+	user *entity.UserProfile,
+	preferences *entity.PartnerPreference,
+	candidate *entity.UserProfile) compatibilityScore {
+
+	result := compatibilityScore{Matched: []string{}, Unmatched: []string{}}
+	applicableWeight, matchedWeight := 0, 0
+
+	for _, w := range entity.MatchCriterionWeights {
+		applicable, matched := matchCriterion(w.Criterion, user, preferences, candidate)
+		if !applicable {
+			continue
+		}
+		applicableWeight += w.Weight
+		if matched {
+			matchedWeight += w.Weight
+			result.Matched = append(result.Matched, w.Criterion)
+		} else {
+			result.Unmatched = append(result.Unmatched, w.Criterion)
+		}
+	}
+
+	result.Score = 100
+	if applicableWeight > 0 {
+		result.Score = int32(matchedWeight * 100 / applicableWeight)
+	}
+	return result
+}
+
+type reciprocalMatch struct {
+	Matches  bool // the user fits every dimension the candidate restricted
+	Excluded bool // the user fails a dimension the candidate marked as must-have
+}
+
+// matchReciprocal checks the user against the candidate's preferences, a
+// candidate without preferences has not excluded anyone.
+func matchReciprocal(
+	user *entity.UserProfile,
+	candidate *entity.UserProfile,
+	candidatePreferences *entity.PartnerPreference) reciprocalMatch {
+
+	result := reciprocalMatch{Matches: true}
+	if candidatePreferences == nil {
+		return result
+	}
+
+	for _, criterion := range reciprocalMatchCriteria {
+		applicable, matched := matchCriterion(criterion, candidate, candidatePreferences, user)
+		if !applicable || matched {
+			continue
+		}
+		result.Matches = false
+		if candidatePreferences.IsMustHave(criterion) {
+			result.Excluded = true
+		}
+	}
+	return result
+}
+
+// isRecommendable repeats the filters of GetPotentialProfiles for a single
+// candidate, blocks and earlier match actions aside.
+func isRecommendable(
+	user *entity.UserProfile,
+	preferences *entity.PartnerPreference,
+	candidate *entity.UserProfile,
+	activeSince *time.Time) bool {
+
+	if !candidate.ProfileCompleted || candidate.IsHidden || candidate.IsBride == user.IsBride {
+		return false
+	}
+	if activeSince != nil && candidate.LastLogin.Before(*activeSince) {
+		return false
+	}
+	for _, criterion := range preferences.MustHaveCriteria {
+		applicable, matched := matchCriterion(criterion, user, preferences, candidate)
+		if applicable && !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// matchCriterion reports whether the preferences restrict the criterion at
+// all, and if so whether the candidate satisfies it.
+func matchCriterion(
+	criterion string,
+	user *entity.UserProfile,
+	preferences *entity.PartnerPreference,
+	candidate *entity.UserProfile) (applicable bool, matched bool) {
+
+	switch criterion {
+	case constants.MatchCriterionAge:
+		age := int16(ageutil.CalculateAge(candidate.DateOfBirth))
+		return true, age >= preferences.MinAgeYears && age <= preferences.MaxAgeYears
+	case constants.MatchCriterionHeight:
+		return true, candidate.HeightCm >= preferences.MinHeightCm && candidate.HeightCm <= preferences.MaxHeightCm
+	case constants.MatchCriterionPhysicallyChallenged:
+		return !preferences.AcceptPhysicallyChallenged, !candidate.PhysicallyChallenged
+	case constants.MatchCriterionCommunity:
+		return matchPreferredValue(preferences.PreferredCommunities, string(candidate.Community), string(validation.CommunityAny))
+	case constants.MatchCriterionMaritalStatus:
+		return matchPreferredValue(preferences.PreferredMaritalStatus, string(candidate.MaritalStatus), string(validation.MaritalStatusAny))
+	case constants.MatchCriterionProfession:
+		return matchPreferredValue(preferences.PreferredProfessions, string(candidate.Profession), string(validation.ProfessionAny))
+	case constants.MatchCriterionProfessionType:
+		return matchPreferredValue(preferences.PreferredProfessionTypes, string(candidate.ProfessionType), string(validation.ProfessionTypeAny))
+	case constants.MatchCriterionEducationLevel:
+		return matchPreferredValue(preferences.PreferredEducationLevels, string(candidate.HighestEducationLevel), string(validation.EducationLevelAny))
+	case constants.MatchCriterionHomeDistrict:
+		return matchPreferredValue(preferences.PreferredHomeDistricts, string(candidate.HomeDistrict), string(validation.HomeDistrictAny))
+	case constants.MatchCriterionNativeCountry:
+		return matchPreferredValue(preferences.PreferredCountries, candidate.NativePlace.Country, validation.CountryAny)
+	case constants.MatchCriterionResidenceCountry:
+		return matchPreferredValue(preferences.PreferredResidenceCountries, candidate.Residence.Country, validation.CountryAny)
+	case constants.MatchCriterionPorutham:
+		// Without the user's own horoscope the score cannot be calculated
+		if preferences.MinPoruthamScore <= 0 || !user.HasHoroscope() {
+			return false, false
+		}
+		compatibility := entity.NewPoruthamCompatibility(user, candidate)
+		return true, compatibility != nil && compatibility.Score >= int32(preferences.MinPoruthamScore)
+	case constants.MatchCriterionDosham:
+		// Unknown dosham is treated as no dosham, the same as the filter
+		hasDosham := candidate.HasDosham()
+		return !preferences.AcceptDosham, hasDosham == nil || !*hasDosham
+	}
+	return false, false
+}
+
+func matchPreferredValue(preferred []string, value, anyValue string) (applicable bool, matched bool) {
+	if len(preferred) == 0 || preferred[0] == anyValue {
+		return false, false
+	}
+	for _, p := range preferred {
+		if p == value {
+			return true, true
+		}
+	}
+	return true, false
+}
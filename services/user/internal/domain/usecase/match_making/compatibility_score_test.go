@@ -0,0 +1,182 @@
+package matchmaking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func testProfile(age int, heightCm int16, community string) *entity.UserProfile {
+	return &entity.UserProfile{
+		DateOfBirth:   time.Now().UTC().AddDate(-age, 0, -1),
+		HeightCm:      heightCm,
+		Community:     validation.Community(community),
+		MaritalStatus: validation.MaritalStatus("never_married"),
+	}
+}
+
+// testPreferences restricts only age and height, the dimensions every
+// preference has
+func testPreferences() *entity.PartnerPreference {
+	return &entity.PartnerPreference{
+		MinAgeYears:                25,
+		MaxAgeYears:                32,
+		MinHeightCm:                150,
+		MaxHeightCm:                180,
+		AcceptPhysicallyChallenged: true,
+		AcceptDosham:               true,
+	}
+}
+
+func TestScoreCompatibility(t *testing.T) {
+	user := testProfile(30, 170, "sunni")
+
+	tests := []struct {
+		name          string
+		preferences   func(p *entity.PartnerPreference)
+		candidate     *entity.UserProfile
+		wantScore     int32
+		wantMatched   []string
+		wantUnmatched []string
+	}{
+		{
+			name:        "every restricted dimension matches",
+			candidate:   testProfile(28, 160, "sunni"),
+			wantScore:   100,
+			wantMatched: []string{constants.MatchCriterionAge, constants.MatchCriterionHeight},
+		},
+		{
+			name:          "age out of range",
+			candidate:     testProfile(40, 160, "sunni"),
+			wantScore:     34, // 8 of 23
+			wantMatched:   []string{constants.MatchCriterionHeight},
+			wantUnmatched: []string{constants.MatchCriterionAge},
+		},
+		{
+			name: "preferred community matches",
+			preferences: func(p *entity.PartnerPreference) {
+				p.PreferredCommunities = []string{"sunni", "shafi"}
+			},
+			candidate:     testProfile(40, 160, "sunni"),
+			wantScore:     57, // 20 of 35
+			wantMatched:   []string{constants.MatchCriterionCommunity, constants.MatchCriterionHeight},
+			wantUnmatched: []string{constants.MatchCriterionAge},
+		},
+		{
+			name: "any community is not counted",
+			preferences: func(p *entity.PartnerPreference) {
+				p.PreferredCommunities = []string{string(validation.CommunityAny)}
+			},
+			candidate:   testProfile(28, 160, "mujahid"),
+			wantScore:   100,
+			wantMatched: []string{constants.MatchCriterionAge, constants.MatchCriterionHeight},
+		},
+		{
+			name: "physically challenged candidate not accepted",
+			preferences: func(p *entity.PartnerPreference) {
+				p.AcceptPhysicallyChallenged = false
+			},
+			candidate: func() *entity.UserProfile {
+				candidate := testProfile(28, 160, "sunni")
+				candidate.PhysicallyChallenged = true
+				return candidate
+			}(),
+			wantScore:     82, // 23 of 28
+			wantMatched:   []string{constants.MatchCriterionAge, constants.MatchCriterionHeight},
+			wantUnmatched: []string{constants.MatchCriterionPhysicallyChallenged},
+		},
+		{
+			name: "minimum porutham is skipped without the user's horoscope",
+			preferences: func(p *entity.PartnerPreference) {
+				p.MinPoruthamScore = 6
+			},
+			candidate:   testProfile(28, 160, "sunni"),
+			wantScore:   100,
+			wantMatched: []string{constants.MatchCriterionAge, constants.MatchCriterionHeight},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preferences := testPreferences()
+			if tt.preferences != nil {
+				tt.preferences(preferences)
+			}
+
+			got := scoreCompatibility(user, preferences, tt.candidate)
+
+			assert.Equal(t, tt.wantScore, got.Score)
+			assert.ElementsMatch(t, tt.wantMatched, got.Matched)
+			assert.ElementsMatch(t, tt.wantUnmatched, got.Unmatched)
+		})
+	}
+}
+
+func TestMatchReciprocal(t *testing.T) {
+	user := testProfile(30, 170, "sunni")
+	candidate := testProfile(28, 160, "sunni")
+
+	tests := []struct {
+		name         string
+		preferences  func(p *entity.PartnerPreference)
+		noPreference bool
+		wantMatches  bool
+		wantExcluded bool
+	}{
+		{
+			name:         "candidate without preferences",
+			noPreference: true,
+			wantMatches:  true,
+		},
+		{
+			name:        "user fits the candidate's preferences",
+			wantMatches: true,
+		},
+		{
+			name: "user fails a nice-to-have dimension",
+			preferences: func(p *entity.PartnerPreference) {
+				p.PreferredCommunities = []string{"mujahid"}
+			},
+			wantMatches: false,
+		},
+		{
+			name: "user fails a must-have dimension",
+			preferences: func(p *entity.PartnerPreference) {
+				p.MaxAgeYears = 28
+				p.MustHaveCriteria = []string{constants.MatchCriterionAge}
+			},
+			wantMatches:  false,
+			wantExcluded: true,
+		},
+		{
+			name: "dimensions outside the reciprocal check are ignored",
+			preferences: func(p *entity.PartnerPreference) {
+				p.PreferredProfessions = []string{"doctor"}
+				p.MustHaveCriteria = []string{constants.MatchCriterionProfession}
+			},
+			wantMatches: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var preferences *entity.PartnerPreference
+			if !tt.noPreference {
+				preferences = testPreferences()
+				if tt.preferences != nil {
+					tt.preferences(preferences)
+				}
+			}
+
+			got := matchReciprocal(user, candidate, preferences)
+
+			assert.Equal(t, tt.wantMatches, got.Matches)
+			assert.Equal(t, tt.wantExcluded, got.Excluded)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+func (u *matchMakingUsecase) CreateSavedSearch(
+	ctx context.Context,
+	userID uuid.UUID,
+	name string,
+	filters entity.SearchFilters,
+	alertsEnabled bool) (*entity.SavedSearch, error) {
+
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > constants.MaxSavedSearchNameLength {
+		return nil, apperrors.ErrInvalidSavedSearchName
+	}
+
+	filters, err := normalizeSearchFilters(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	// Alerts only report profiles completed after the search was saved
+	now := time.Now().UTC()
+	search := &entity.SavedSearch{
+		UserID:        userID,
+		Name:          name,
+		Filters:       filters,
+		AlertsEnabled: alertsEnabled,
+		LastCheckedAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	// The profile row is locked so concurrent requests cannot both pass the
+	// limit check
+	err = u.transactionManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		if err := u.userProfileRepository.LockProfileByUserIDTx(ctx, tx, userID); err != nil {
+			return fmt.Errorf("failed to lock user profile: %w", err)
+		}
+		count, err := u.savedSearchRepository.CountSavedSearchesTx(ctx, tx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to count saved searches: %w", err)
+		}
+		if count >= constants.MaxSavedSearchesPerUser {
+			return apperrors.ErrSavedSearchLimitReached
+		}
+		if err := u.savedSearchRepository.CreateSavedSearchTx(ctx, tx, search); err != nil {
+			return fmt.Errorf("failed to create saved search: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return search, nil
+}
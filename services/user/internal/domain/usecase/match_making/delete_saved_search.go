@@ -0,0 +1,24 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+)
+
+func (u *matchMakingUsecase) DeleteSavedSearch(
+	ctx context.Context,
+	userID uuid.UUID,
+	savedSearchID int64) error {
+
+	deleted, err := u.savedSearchRepository.DeleteSavedSearch(ctx, userID, savedSearchID)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	if !deleted {
+		return apperrors.ErrSavedSearchNotFound
+	}
+	return nil
+}
@@ -91,19 +91,27 @@ func (u *matchMakingUsecase) GetProfilesByMatchAction(ctx context.Context,
 	userProfileResponses := make([]*entity.UserProfileResponse, len(profiles))
 	for i, profile := range profiles {
 		age := ageutil.CalculateAge(profile.DateOfBirth)
-		profilePictureURL, err := u.photoStorage.GetDownloadURL(ctx, profile.ProfileImageKey, u.config.MediaStorage.URLExpiry)
-		if err != nil {
-			return nil, nil, err
+		// Only approved photos are served to other users
+		var profilePictureURL *string
+		if imageKey := profile.PublicProfileImageKey(constants.ImageSizeThumbnail); imageKey != "" {
+			url, err := u.photoStorage.GetDownloadURL(ctx, imageKey, u.config.MediaStorage.URLExpiry)
+			if err != nil {
+				return nil, nil, err
+			}
+			profilePictureURL = &url
 		}
 		userProfileResponses[i] = &entity.UserProfileResponse{
 			ID:                profile.ID,
 			FullName:          profile.FullName,
-			ProfilePictureURL: &profilePictureURL,
+			ProfilePictureURL: profilePictureURL,
 			Age:               int32(age),
 			HeightCm:          int32(profile.HeightCm),
 			MaritalStatus:     string(profile.MaritalStatus),
 			Profession:        string(profile.Profession),
 			HomeDistrict:      string(profile.HomeDistrict),
+			NativePlace:       profile.NativePlaceOrNil(),
+			Residence:         profile.ResidenceOrNil(),
+			IsNRI:             profile.IsNRI(),
 		}
 	}
 
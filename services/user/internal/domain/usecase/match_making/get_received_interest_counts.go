@@ -0,0 +1,26 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func (u *matchMakingUsecase) GetReceivedInterestCounts(
+	ctx context.Context,
+	userID uuid.UUID) (*entity.ReceivedInterestCounts, error) {
+
+	blockedIDs, err := u.userBlockRepository.GetBlockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blocked user IDs: %w", err)
+	}
+
+	counts, err := u.profileMatchRepository.CountPendingInterests(ctx, userID, blockedIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count received interests: %w", err)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,109 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/ageutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pagination"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+// GetReceivedInterests lists the pending likes the user received, the ones
+// on the returned page are marked seen and no longer count as unread.
+func (u *matchMakingUsecase) GetReceivedInterests(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset int,
+) ([]*entity.ReceivedInterest, *pagination.PaginationData, error) {
+
+	if limit <= 0 {
+		limit = constants.DefaultPaginationLimit
+	}
+	if limit > constants.MaxPaginationLimit {
+		limit = constants.MaxPaginationLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Likes from either side of a block are not shown
+	blockedIDs, err := u.userBlockRepository.GetBlockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get blocked user IDs: %w", err)
+	}
+
+	interests, totalCount, err := u.profileMatchRepository.GetPendingInterests(ctx, userID, blockedIDs, limit, offset)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get received interests: %w", err)
+	}
+
+	senderIDs := make([]uuid.UUID, 0, len(interests))
+	for _, interest := range interests {
+		senderIDs = append(senderIDs, interest.UserID)
+	}
+	senderProfiles, err := u.userProfileRepository.GetProfilesByUserIDs(ctx, senderIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get sender profiles: %w", err)
+	}
+	profilesByUserID := make(map[uuid.UUID]*entity.UserProfile, len(senderProfiles))
+	for _, profile := range senderProfiles {
+		profilesByUserID[profile.UserID] = profile
+	}
+
+	now := time.Now().UTC()
+	responses := make([]*entity.ReceivedInterest, 0, len(interests))
+	unseenIDs := make([]uuid.UUID, 0, len(interests))
+	for _, interest := range interests {
+		profile, ok := profilesByUserID[interest.UserID]
+		if !ok {
+			continue // sender deleted their account after the page was read
+		}
+
+		var pictureURL *string
+		if imageKey := profile.PublicProfileImageKey(constants.ImageSizeThumbnail); imageKey != "" {
+			url, err := u.photoStorage.GetDownloadURL(ctx, imageKey, u.config.MediaStorage.URLExpiry)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get profile image URL: %w", err)
+			}
+			pictureURL = &url
+		}
+
+		if interest.SeenAt == nil {
+			unseenIDs = append(unseenIDs, interest.UserID)
+		}
+
+		responses = append(responses, &entity.ReceivedInterest{
+			Profile: &entity.UserProfileResponse{
+				ID:                profile.ID,
+				FullName:          profile.FullName,
+				ProfilePictureURL: pictureURL,
+				Age:               int32(ageutil.CalculateAge(profile.DateOfBirth)),
+				HeightCm:          int32(profile.HeightCm),
+				MaritalStatus:     string(profile.MaritalStatus),
+				Profession:        string(profile.Profession),
+				HomeDistrict:      string(profile.HomeDistrict),
+				NativePlace:       profile.NativePlaceOrNil(),
+				Residence:         profile.ResidenceOrNil(),
+				IsNRI:             profile.IsNRI(),
+				ActivityStatus:    entity.ActivityStatus(profile.LastLogin, now),
+			},
+			ReceivedAt: interest.UpdatedAt,
+			IsNew:      interest.SeenAt == nil,
+		})
+	}
+
+	if err := u.profileMatchRepository.MarkInterestsSeen(ctx, userID, unseenIDs, now); err != nil {
+		return nil, nil, fmt.Errorf("failed to mark received interests seen: %w", err)
+	}
+
+	return responses, &pagination.PaginationData{
+		TotalCount: totalCount,
+		Limit:      limit,
+		Offset:     offset,
+		HasMore:    int64(offset+limit) < totalCount,
+	}, nil
+}
@@ -0,0 +1,20 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func (u *matchMakingUsecase) GetSavedSearches(
+	ctx context.Context,
+	userID uuid.UUID) ([]*entity.SavedSearch, error) {
+
+	searches, err := u.savedSearchRepository.ListSavedSearches(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+	return searches, nil
+}
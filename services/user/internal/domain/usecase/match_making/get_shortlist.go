@@ -0,0 +1,85 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/ageutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pagination"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func (u *matchMakingUsecase) GetShortlist(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset int) ([]*entity.ShortlistedProfileResponse, *pagination.PaginationData, error) {
+
+	if limit <= 0 {
+		limit = constants.DefaultPaginationLimit
+	}
+	if limit > constants.MaxPaginationLimit {
+		limit = constants.MaxPaginationLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Profiles blocked after being shortlisted are kept but not listed
+	blockedIDs, err := u.userBlockRepository.GetBlockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get blocked user IDs: %w", err)
+	}
+
+	entries, totalCount, err := u.shortlistRepository.GetShortlist(ctx, userID, blockedIDs, limit, offset)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get shortlist: %w", err)
+	}
+
+	responses := make([]*entity.ShortlistedProfileResponse, 0, len(entries))
+	for _, entry := range entries {
+		profile, err := u.userProfileRepository.GetProfileByUserID(ctx, entry.TargetUserID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get shortlisted profile: %w", err)
+		}
+		if profile == nil {
+			continue // shortlisted user has since deleted their account
+		}
+
+		var pictureURL *string
+		if imageKey := profile.PublicProfileImageKey(constants.ImageSizeThumbnail); imageKey != "" {
+			url, err := u.photoStorage.GetDownloadURL(ctx, imageKey, u.config.MediaStorage.URLExpiry)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get profile image URL: %w", err)
+			}
+			pictureURL = &url
+		}
+
+		responses = append(responses, &entity.ShortlistedProfileResponse{
+			Profile: &entity.UserProfileResponse{
+				ID:                profile.ID,
+				FullName:          profile.FullName,
+				ProfilePictureURL: pictureURL,
+				Age:               int32(ageutil.CalculateAge(profile.DateOfBirth)),
+				HeightCm:          int32(profile.HeightCm),
+				MaritalStatus:     string(profile.MaritalStatus),
+				Profession:        string(profile.Profession),
+				HomeDistrict:      string(profile.HomeDistrict),
+				NativePlace:       profile.NativePlaceOrNil(),
+				Residence:         profile.ResidenceOrNil(),
+				IsNRI:             profile.IsNRI(),
+				IsShortlisted:     true,
+			},
+			Note:          entry.Note,
+			ShortlistedAt: entry.CreatedAt,
+		})
+	}
+
+	return responses, &pagination.PaginationData{
+		TotalCount: totalCount,
+		Limit:      limit,
+		Offset:     offset,
+		HasMore:    int64(offset+limit) < totalCount,
+	}, nil
+}
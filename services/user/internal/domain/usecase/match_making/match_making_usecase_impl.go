@@ -7,6 +7,7 @@ import (
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/mediastorage"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/usecase"
+	"go.uber.org/zap"
 )
 
 type matchMakingUsecase struct {
@@ -14,10 +15,15 @@ type matchMakingUsecase struct {
 	partnerPreferencesRepository repository.PartnerPreferencesRepository
 	profileMatchRepository       repository.ProfileMatchRepository
 	mutualMatchRepository        repository.MutualMatchRepository
+	userBlockRepository          repository.UserBlockRepository
+	shortlistRepository          repository.ShortlistRepository
+	savedSearchRepository        repository.SavedSearchRepository
+	recommendationCache          repository.RecommendationCacheRepository
 	transactionManager           *postgres.TransactionManager
 	photoStorage                 mediastorage.PhotoStorage
 	config                       *config.Config
 	eventPublisher               event.EventPublisher
+	logger                       *zap.Logger
 }
 
 func NewMatchMakingUsecase(
@@ -25,19 +31,29 @@ func NewMatchMakingUsecase(
 	partnerPreferencesRepository repository.PartnerPreferencesRepository,
 	profileMatchRepository repository.ProfileMatchRepository,
 	mutualMatchRepository repository.MutualMatchRepository,
+	userBlockRepository repository.UserBlockRepository,
+	shortlistRepository repository.ShortlistRepository,
+	savedSearchRepository repository.SavedSearchRepository,
+	recommendationCache repository.RecommendationCacheRepository,
 	transactionManager *postgres.TransactionManager,
 	photoStorage mediastorage.PhotoStorage,
 	config *config.Config,
 	eventPublisher event.EventPublisher,
+	logger *zap.Logger,
 ) usecase.MatchMakingUsecase {
 	return &matchMakingUsecase{
 		userProfileRepository:        userProfileRepository,
 		partnerPreferencesRepository: partnerPreferencesRepository,
 		profileMatchRepository:       profileMatchRepository,
 		mutualMatchRepository:        mutualMatchRepository,
+		userBlockRepository:          userBlockRepository,
+		shortlistRepository:          shortlistRepository,
+		savedSearchRepository:        savedSearchRepository,
+		recommendationCache:          recommendationCache,
 		transactionManager:           transactionManager,
 		photoStorage:                 photoStorage,
 		config:                       config,
 		eventPublisher:               eventPublisher,
+		logger:                       logger,
 	}
 }
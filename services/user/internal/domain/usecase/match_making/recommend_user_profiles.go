@@ -2,6 +2,10 @@ package matchmaking
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
@@ -9,12 +13,14 @@ import (
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/ageutil"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pagination"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
 )
 
 func (u *matchMakingUsecase) RecommendUserProfiles(
 	ctx context.Context,
 	userID uuid.UUID,
-	limit, offset int) ([]*entity.UserProfileResponse, *pagination.PaginationData, error) {
+	limit, offset int,
+	cursor string) ([]*entity.UserProfileResponse, *pagination.PaginationData, error) {
 
 	if limit <= 0 {
 		limit = constants.DefaultPaginationLimit
@@ -26,6 +32,14 @@ func (u *matchMakingUsecase) RecommendUserProfiles(
 		offset = 0
 	}
 
+	var after *recommendationCursor
+	if cursor != "" {
+		after = &recommendationCursor{}
+		if err := pagination.DecodeCursor(cursor, after); err != nil {
+			return nil, nil, apperrors.ErrInvalidPaginationCursor
+		}
+	}
+
 	userProfile, err := u.userProfileRepository.GetProfileByUserID(ctx, userID)
 	if err != nil {
 		return nil, nil, err
@@ -35,66 +49,384 @@ func (u *matchMakingUsecase) RecommendUserProfiles(
 		return nil, nil, apperrors.ErrUserNotFound
 	}
 
-	partnerPreference, err := u.partnerPreferencesRepository.GetPartnerPreferencesByUserProfileID(ctx, userProfile.ID)
+	recommendations, err := u.getRecommendations(ctx, userProfile)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if partnerPreference == nil {
-		return nil, nil, apperrors.ErrPartnerPreferencesNotFound
-	}
-
-	excludedIDs, err := u.profileMatchRepository.GetMatchedProfileIDs(ctx, userID)
+	result, paginationData, err := pageRecommendations(recommendations, after, limit, offset)
 	if err != nil {
 		return nil, nil, err
 	}
-	excludedIDs = append(excludedIDs, userID)
+	if len(result) == 0 {
+		return []*entity.UserProfileResponse{}, paginationData, nil
+	}
 
-	potentialProfiles, totalCount, err := u.userProfileRepository.GetPotentialProfiles(ctx,
-		userID,
-		excludedIDs,
-		partnerPreference,
-		limit,
-		offset,
-		userProfile.IsBride)
+	// Shortlisting does not hide a profile from recommendations, it is only marked
+	resultUserIDs := make([]uuid.UUID, len(result))
+	for i, recommendation := range result {
+		resultUserIDs[i] = recommendation.UserID
+	}
+	shortlistedIDs, err := u.shortlistRepository.GetShortlistedUserIDs(ctx, userID, resultUserIDs)
 	if err != nil {
 		return nil, nil, err
 	}
-
-	result := potentialProfiles
-
-	if len(result) == 0 {
-		return []*entity.UserProfileResponse{}, &pagination.PaginationData{
-			TotalCount: totalCount,
-			Limit:      limit,
-			Offset:     offset,
-			HasMore:    false,
-		}, nil
+	shortlisted := make(map[uuid.UUID]struct{}, len(shortlistedIDs))
+	for _, id := range shortlistedIDs {
+		shortlisted[id] = struct{}{}
 	}
 
+	now := time.Now().UTC()
 	recommendedProfiles := make([]*entity.UserProfileResponse, len(result))
-	for i, profile := range result {
-		age := ageutil.CalculateAge(profile.DateOfBirth)
-		profilePictureURL, err := u.photoStorage.GetDownloadURL(ctx, profile.ProfileImageKey, u.config.MediaStorage.URLExpiry)
-		if err != nil {
-			return nil, nil, err
+	for i, recommendation := range result {
+		profile := recommendation.Profile
+		_, profile.IsShortlisted = shortlisted[recommendation.UserID]
+		profile.ActivityStatus = entity.ActivityStatus(recommendation.LastLogin, now)
+		if recommendation.ImageKey != "" {
+			url, err := u.photoStorage.GetDownloadURL(ctx, recommendation.ImageKey, u.config.MediaStorage.URLExpiry)
+			if err != nil {
+				return nil, nil, err
+			}
+			profile.ProfilePictureURL = &url
 		}
-		recommendedProfiles[i] = &entity.UserProfileResponse{
-			ID:                profile.ID,
-			FullName:          profile.FullName,
-			ProfilePictureURL: &profilePictureURL,
-			Age:               int32(age),
-			HeightCm:          int32(profile.HeightCm),
-			MaritalStatus:     string(profile.MaritalStatus),
-			Profession:        string(profile.Profession),
-			HomeDistrict:      string(profile.HomeDistrict),
+		recommendedProfiles[i] = &profile
+	}
+
+	return recommendedProfiles, paginationData, nil
+}
+
+// recommendationCursor points at the last profile of a page. The cached
+// recommendations keep their order until they are ranked again, so the next
+// page continues right after that profile however its score or activity has
+// changed since. Unlike an offset it keeps its place when profiles before it
+// are liked or passed.
+type recommendationCursor struct {
+	ProfileID int64 `json:"p"`
+}
+
+// pageRecommendations returns the page of recommendations after the cursor,
+// or at offset without one, leaving out removed entries. A cursor whose
+// profile is no longer in the recommendations belongs to an earlier ranking
+// and cannot be continued.
+func pageRecommendations(
+	recommendations []entity.CachedRecommendation,
+	after *recommendationCursor,
+	limit, offset int) ([]entity.CachedRecommendation, *pagination.PaginationData, error) {
+
+	if after != nil {
+		index := slices.IndexFunc(recommendations, func(r entity.CachedRecommendation) bool {
+			return r.Profile.ID == after.ProfileID
+		})
+		if index < 0 {
+			return nil, nil, apperrors.ErrInvalidPaginationCursor
+		}
+		offset = index + 1
+		for _, recommendation := range recommendations[:index+1] {
+			if recommendation.Removed {
+				offset--
+			}
 		}
 	}
+	recommendations = slices.DeleteFunc(slices.Clone(recommendations), func(r entity.CachedRecommendation) bool {
+		return r.Removed
+	})
+
+	totalCount := int64(len(recommendations))
+	var result []entity.CachedRecommendation
+	if offset < len(recommendations) {
+		result = recommendations[offset:min(offset+limit, len(recommendations))]
+	}
 
-	return recommendedProfiles, &pagination.PaginationData{
+	paginationData := &pagination.PaginationData{
 		TotalCount: totalCount,
 		Limit:      limit,
 		Offset:     offset,
 		HasMore:    int64(offset+limit) < totalCount,
-	}, nil
+	}
+	if paginationData.HasMore && len(result) > 0 {
+		nextCursor, err := pagination.EncodeCursor(recommendationCursor{ProfileID: result[len(result)-1].Profile.ID})
+		if err != nil {
+			return nil, nil, err
+		}
+		paginationData.NextCursor = nextCursor
+	}
+	return result, paginationData, nil
+}
+
+// getRecommendations serves the user's ranked recommendations from the cache
+// and ranks the candidates again only when nothing is cached. A failing
+// redis costs the ranking work, it does not fail the request.
+func (u *matchMakingUsecase) getRecommendations(
+	ctx context.Context,
+	userProfile *entity.UserProfile) ([]entity.CachedRecommendation, error) {
+
+	recommendations, err := u.recommendationCache.GetRecommendations(ctx, userProfile.UserID)
+	if err == nil && recommendations != nil {
+		return recommendations, nil
+	}
+	return u.precomputeRecommendations(ctx, userProfile)
+}
+
+// precomputeRecommendations ranks the user's candidates and caches them for
+// the configured ttl, a ttl of zero disables the cache.
+func (u *matchMakingUsecase) precomputeRecommendations(
+	ctx context.Context,
+	userProfile *entity.UserProfile) ([]entity.CachedRecommendation, error) {
+
+	partnerPreference, err := u.partnerPreferencesRepository.GetPartnerPreferencesByUserProfileID(ctx, userProfile.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if partnerPreference == nil {
+		return nil, apperrors.ErrPartnerPreferencesNotFound
+	}
+
+	candidates, err := u.rankCandidates(ctx, userProfile, partnerPreference, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	recommendations := make([]entity.CachedRecommendation, len(candidates))
+	for i, candidate := range candidates {
+		recommendations[i] = candidate.toCachedRecommendation()
+	}
+
+	if ttl := u.config.Recommendations.CacheTTL; ttl > 0 {
+		if err := u.recommendationCache.SetRecommendations(ctx, userProfile.UserID, recommendations, ttl); err != nil {
+			// no need to fail, the candidates are ranked again on the next request
+			u.logger.Warn("failed to cache recommendations",
+				zap.String("user_id", userProfile.UserID.String()),
+				zap.Error(err))
+		}
+	}
+
+	return recommendations, nil
+}
+
+// Points added to the compatibility score when ranking, among similarly
+// compatible profiles the recently active ones come first
+const (
+	activeTodayRankBoost    = 10
+	activeThisWeekRankBoost = 5
+)
+
+func activityRankBoost(lastLogin, now time.Time) int32 {
+	switch entity.ActivityStatus(lastLogin, now) {
+	case constants.ActivityStatusActiveToday:
+		return activeTodayRankBoost
+	case constants.ActivityStatusActiveThisWeek:
+		return activeThisWeekRankBoost
+	}
+	return 0
+}
+
+type rankedCandidate struct {
+	profile          *entity.UserProfile
+	score            compatibilityScore
+	rankScore        int32 // score boosted for recent activity
+	reciprocal       reciprocalMatch
+	previouslyPassed bool
+}
+
+func newRankedCandidate(
+	user *entity.UserProfile,
+	preferences *entity.PartnerPreference,
+	candidate *entity.UserProfile,
+	reciprocal reciprocalMatch,
+	previouslyPassed bool,
+	now time.Time) rankedCandidate {
+
+	score := scoreCompatibility(user, preferences, candidate)
+	return rankedCandidate{
+		profile:          candidate,
+		score:            score,
+		rankScore:        score.Score + activityRankBoost(candidate.LastLogin, now),
+		reciprocal:       reciprocal,
+		previouslyPassed: previouslyPassed,
+	}
+}
+
+func (c rankedCandidate) toCachedRecommendation() entity.CachedRecommendation {
+	profile := c.profile
+	return entity.CachedRecommendation{
+		UserID: profile.UserID,
+		// Only approved photos are served to other users
+		ImageKey:  profile.PublicProfileImageKey(constants.ImageSizeThumbnail),
+		LastLogin: profile.LastLogin,
+		RankScore: c.rankScore,
+		Profile: entity.UserProfileResponse{
+			ID:            profile.ID,
+			FullName:      profile.FullName,
+			Age:           int32(ageutil.CalculateAge(profile.DateOfBirth)),
+			HeightCm:      int32(profile.HeightCm),
+			MaritalStatus: string(profile.MaritalStatus),
+			Profession:    string(profile.Profession),
+			HomeDistrict:  string(profile.HomeDistrict),
+			NativePlace:   profile.NativePlaceOrNil(),
+			Residence:     profile.ResidenceOrNil(),
+			IsNRI:         profile.IsNRI(),
+
+			CompatibilityScore:      c.score.Score,
+			MatchedCriteria:         c.score.Matched,
+			UnmatchedCriteria:       c.score.Unmatched,
+			MatchesTheirPreferences: c.reciprocal.Matches,
+			PreviouslyPassed:        c.previouslyPassed,
+		},
+	}
+}
+
+// recommendationKey is the sort key of a recommendation, it orders every
+// candidate the same way on each ranking.
+type recommendationKey struct {
+	Matches   bool
+	Score     int32 // rank score
+	LastLogin int64 // unix microseconds
+	ProfileID int64
+}
+
+func (c rankedCandidate) key() recommendationKey {
+	return recommendationKey{
+		Matches:   c.reciprocal.Matches,
+		Score:     c.rankScore,
+		LastLogin: c.profile.LastLogin.UnixMicro(),
+		ProfileID: c.profile.ID,
+	}
+}
+
+// before reports whether k is ranked ahead of other: two-way matches first,
+// then by the activity boosted score, the most recently active and finally
+// the newest profile.
+func (k recommendationKey) before(other recommendationKey) bool {
+	if k.Matches != other.Matches {
+		return k.Matches
+	}
+	if k.Score != other.Score {
+		return k.Score > other.Score
+	}
+	if k.LastLogin != other.LastLogin {
+		return k.LastLogin > other.LastLogin
+	}
+	return k.ProfileID > other.ProfileID
+}
+
+// rankCandidates returns the profiles to recommend to the user, two-way
+// matches first and then by compatibility score boosted for recent activity.
+// Dormant profiles are left out. completedAfter limits them to profiles
+// filled in after the given time.
+func (u *matchMakingUsecase) rankCandidates(
+	ctx context.Context,
+	userProfile *entity.UserProfile,
+	partnerPreference *entity.PartnerPreference,
+	completedAfter *time.Time) ([]rankedCandidate, error) {
+
+	excludedIDs, err := u.recommendationExcludedUserIDs(ctx, userProfile.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Passed profiles that are not excluded have resurfaced, they are marked
+	passedIDs, err := u.profileMatchRepository.GetPassedUserIDs(ctx, userProfile.UserID)
+	if err != nil {
+		return nil, err
+	}
+	passed := make(map[uuid.UUID]struct{}, len(passedIDs))
+	for _, id := range passedIDs {
+		passed[id] = struct{}{}
+	}
+
+	// A minimum porutham score needs the user's own horoscope, without it the
+	// score cannot be calculated and the filter is skipped. As a nice-to-have
+	// porutham only adds to the compatibility score
+	var compatibleSigns []entity.HoroscopeSign
+	if partnerPreference.MinPoruthamScore > 0 && userProfile.HasHoroscope() &&
+		partnerPreference.IsMustHave(constants.MatchCriterionPorutham) {
+		compatibleSigns = entity.CompatibleHoroscopeSigns(userProfile, int(partnerPreference.MinPoruthamScore))
+	}
+
+	// Must-have criteria are filtered in the query, which also orders the
+	// profiles by a coarse compatibility score so that the most compatible
+	// ones make the candidate limit. They are then ranked by the full score here
+	maxCandidates := u.config.Recommendations.MaxCandidates
+	if maxCandidates <= 0 {
+		maxCandidates = constants.MaxPaginationLimit
+	}
+	candidates, _, err := u.userProfileRepository.GetPotentialProfiles(ctx,
+		userProfile.UserID,
+		excludedIDs,
+		partnerPreference,
+		compatibleSigns,
+		completedAfter,
+		u.dormantCutoff(),
+		maxCandidates,
+		0,
+		userProfile.IsBride)
+	if err != nil {
+		return nil, err
+	}
+
+	candidateIDs := make([]int64, len(candidates))
+	for i, candidate := range candidates {
+		candidateIDs[i] = candidate.ID
+	}
+	candidatePreferences, err := u.partnerPreferencesRepository.GetPartnerPreferencesByUserProfileIDs(ctx, candidateIDs)
+	if err != nil {
+		return nil, err
+	}
+	preferencesByProfileID := make(map[int64]*entity.PartnerPreference, len(candidatePreferences))
+	for _, preferences := range candidatePreferences {
+		preferencesByProfileID[preferences.UserProfileID] = preferences
+	}
+
+	// Candidates whose must-haves the user fails would never consider the
+	// user, they are left out the same way the user's own must-haves are
+	now := time.Now().UTC()
+	ranked := make([]rankedCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		match := matchReciprocal(userProfile, candidate, preferencesByProfileID[candidate.ID])
+		if match.Excluded {
+			continue
+		}
+		_, previouslyPassed := passed[candidate.UserID]
+		ranked = append(ranked, newRankedCandidate(userProfile, partnerPreference, candidate, match, previouslyPassed, now))
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		return ranked[i].key().before(ranked[j].key())
+	})
+
+	return ranked, nil
+}
+
+// recommendationExcludedUserIDs differs from searchExcludedUserIDs in letting
+// passed profiles resurface after the configured time or a significant
+// profile update.
+func (u *matchMakingUsecase) recommendationExcludedUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	var passedBefore *time.Time
+	if resurfaceAfter := u.config.Recommendations.PassResurfaceAfter; resurfaceAfter > 0 {
+		cutoff := time.Now().UTC().Add(-resurfaceAfter)
+		passedBefore = &cutoff
+	}
+
+	excludedIDs, err := u.profileMatchRepository.GetRecommendationExcludedIDs(ctx, userID, passedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matched profile IDs: %w", err)
+	}
+	blockedIDs, err := u.userBlockRepository.GetBlockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blocked user IDs: %w", err)
+	}
+	excludedIDs = append(excludedIDs, blockedIDs...)
+	return append(excludedIDs, userID), nil
+}
+
+// dormantCutoff returns the last login time before which profiles are
+// dormant and not recommended, nil when dormant profiles are kept.
+func (u *matchMakingUsecase) dormantCutoff() *time.Time {
+	dormantAfter := u.config.Recommendations.DormantAfter
+	if dormantAfter <= 0 {
+		return nil
+	}
+	cutoff := time.Now().UTC().Add(-dormantAfter)
+	return &cutoff
 }
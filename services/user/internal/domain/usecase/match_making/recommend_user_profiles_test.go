@@ -0,0 +1,220 @@
+package matchmaking
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pagination"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func testRecommendations(profileIDs ...int64) []entity.CachedRecommendation {
+	lastLogin := time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC)
+	recommendations := make([]entity.CachedRecommendation, len(profileIDs))
+	for i, id := range profileIDs {
+		recommendations[i] = entity.CachedRecommendation{
+			LastLogin: lastLogin,
+			Profile:   entity.UserProfileResponse{ID: id, CompatibilityScore: 80},
+		}
+	}
+	return recommendations
+}
+
+func pageProfileIDs(page []entity.CachedRecommendation) []int64 {
+	ids := make([]int64, len(page))
+	for i, recommendation := range page {
+		ids[i] = recommendation.Profile.ID
+	}
+	return ids
+}
+
+func TestPageRecommendations(t *testing.T) {
+	tests := []struct {
+		name string
+		// between runs between the first and the second page
+		between    func(recommendations []entity.CachedRecommendation) []entity.CachedRecommendation
+		wantSecond []int64
+		wantErr    error
+	}{
+		{
+			name:       "second page continues after the first",
+			wantSecond: []int64{7, 6},
+		},
+		{
+			name: "last login changes between pages",
+			between: func(recommendations []entity.CachedRecommendation) []entity.CachedRecommendation {
+				for i := range recommendations {
+					recommendations[i].LastLogin = time.Date(2025, 8, 2, int(recommendations[i].Profile.ID), 0, 0, 0, time.UTC)
+				}
+				return recommendations
+			},
+			wantSecond: []int64{7, 6},
+		},
+		{
+			name: "profile of the cursor acted on",
+			between: func(recommendations []entity.CachedRecommendation) []entity.CachedRecommendation {
+				recommendations[2].Removed = true
+				return recommendations
+			},
+			wantSecond: []int64{7, 6},
+		},
+		{
+			name: "profiles on both sides of the cursor acted on",
+			between: func(recommendations []entity.CachedRecommendation) []entity.CachedRecommendation {
+				recommendations[0].Removed = true
+				recommendations[3].Removed = true
+				return recommendations
+			},
+			wantSecond: []int64{6, 5},
+		},
+		{
+			name: "profile before the cursor gone after ranking again",
+			between: func(recommendations []entity.CachedRecommendation) []entity.CachedRecommendation {
+				return slices.Delete(recommendations, 0, 1)
+			},
+			wantSecond: []int64{7, 6},
+		},
+		{
+			name: "profile of the cursor gone after ranking again",
+			between: func(recommendations []entity.CachedRecommendation) []entity.CachedRecommendation {
+				return testRecommendations(10, 7, 6, 5)
+			},
+			wantErr: apperrors.ErrInvalidPaginationCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recommendations := testRecommendations(10, 9, 8, 7, 6, 5)
+
+			first, data, err := pageRecommendations(recommendations, nil, 3, 0)
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{10, 9, 8}, pageProfileIDs(first))
+			assert.True(t, data.HasMore)
+
+			var after recommendationCursor
+			assert.NoError(t, pagination.DecodeCursor(data.NextCursor, &after))
+
+			if tt.between != nil {
+				recommendations = tt.between(recommendations)
+			}
+			second, _, err := pageRecommendations(recommendations, &after, 2, 0)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSecond, pageProfileIDs(second))
+		})
+	}
+}
+
+func TestPageRecommendationsSkipsRemoved(t *testing.T) {
+	recommendations := testRecommendations(4, 3, 2, 1)
+	recommendations[1].Removed = true
+
+	page, data, err := pageRecommendations(recommendations, nil, 2, 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 2}, pageProfileIDs(page))
+	assert.Equal(t, int64(3), data.TotalCount)
+	assert.True(t, data.HasMore)
+}
+
+func TestPageRecommendationsLastPage(t *testing.T) {
+	first, data, err := pageRecommendations(testRecommendations(3, 2, 1), nil, 2, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 1}, pageProfileIDs(first))
+	assert.False(t, data.HasMore)
+	assert.Empty(t, data.NextCursor)
+	assert.Equal(t, int64(3), data.TotalCount)
+}
+
+func TestRecommendationKeyBefore(t *testing.T) {
+	base := recommendationKey{Matches: false, Score: 70, LastLogin: 1000, ProfileID: 10}
+
+	tests := []struct {
+		name  string
+		key   recommendationKey
+		other recommendationKey
+		want  bool
+	}{
+		{
+			name:  "two-way match first despite a lower score",
+			key:   recommendationKey{Matches: true, Score: 10, LastLogin: 1, ProfileID: 1},
+			other: base,
+			want:  true,
+		},
+		{
+			name:  "one-way match after a two-way match",
+			key:   base,
+			other: recommendationKey{Matches: true, Score: 10, LastLogin: 1, ProfileID: 1},
+			want:  false,
+		},
+		{
+			name:  "higher score first",
+			key:   recommendationKey{Score: 71, LastLogin: 1, ProfileID: 1},
+			other: base,
+			want:  true,
+		},
+		{
+			name:  "equal score, more recently active first",
+			key:   recommendationKey{Score: 70, LastLogin: 1001, ProfileID: 1},
+			other: base,
+			want:  true,
+		},
+		{
+			name:  "equal score and activity, newest profile first",
+			key:   recommendationKey{Score: 70, LastLogin: 1000, ProfileID: 11},
+			other: base,
+			want:  true,
+		},
+		{
+			name:  "a key is not before itself",
+			key:   base,
+			other: base,
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.key.before(tt.other))
+		})
+	}
+}
+
+func TestNewRankedCandidateActivityBoost(t *testing.T) {
+	now := time.Date(2025, 8, 18, 12, 0, 0, 0, time.UTC)
+	user := testProfile(30, 170, "sunni")
+
+	tests := []struct {
+		name          string
+		lastLogin     time.Time
+		wantRankScore int32
+	}{
+		{name: "active today", lastLogin: now.Add(-time.Hour), wantRankScore: 100 + activeTodayRankBoost},
+		{name: "active this week", lastLogin: now.Add(-3 * 24 * time.Hour), wantRankScore: 100 + activeThisWeekRankBoost},
+		{name: "inactive", lastLogin: now.AddDate(0, -1, 0), wantRankScore: 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			candidate := testProfile(28, 160, "sunni")
+			candidate.LastLogin = tt.lastLogin
+
+			ranked := newRankedCandidate(user, testPreferences(), candidate, reciprocalMatch{}, false, now)
+			cached := ranked.toCachedRecommendation()
+
+			assert.Equal(t, int32(100), cached.Profile.CompatibilityScore)
+			assert.Equal(t, tt.wantRankScore, cached.RankScore)
+			assert.Equal(t, tt.wantRankScore, ranked.key().Score)
+		})
+	}
+}
@@ -5,11 +5,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	userevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/user"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
 	"gorm.io/gorm"
 )
 
@@ -19,6 +21,23 @@ func (u *matchMakingUsecase) RecordMatchAction(
 	targetProfileID int64,
 	action string) (bool, error) {
 
+	recorded, err := u.recordMatchAction(ctx, userID, targetProfileID, action)
+	if err != nil {
+		return false, err
+	}
+
+	// A profile once acted on is no longer recommended to the user
+	u.removeCachedRecommendation(ctx, userID, targetProfileID)
+
+	return recorded, nil
+}
+
+func (u *matchMakingUsecase) recordMatchAction(
+	ctx context.Context,
+	userID uuid.UUID,
+	targetProfileID int64,
+	action string) (bool, error) {
+
 	senderProfile, err := u.userProfileRepository.GetProfileByUserID(ctx, userID)
 	if err != nil {
 		return false, fmt.Errorf("error retrieving sender profile: %w", err)
@@ -38,6 +57,14 @@ func (u *matchMakingUsecase) RecordMatchAction(
 		return false, apperrors.ErrInvalidMatchAction
 	}
 
+	isBlocked, err := u.userBlockRepository.IsBlockedEitherWay(ctx, userID, targetProfile.UserID)
+	if err != nil {
+		return false, fmt.Errorf("error checking user block: %w", err)
+	}
+	if isBlocked {
+		return false, apperrors.ErrProfileBlocked
+	}
+
 	var matchAction constants.MatchAction
 	switch action {
 	case string(constants.MatchActionLike):
@@ -74,10 +101,12 @@ func (u *matchMakingUsecase) RecordMatchAction(
 					return err
 				}
 				// Handle current user's action
-				return u.upsertProfileMatchAction(ctx, tx, userID, targetProfile.UserID, false)
+				_, err := u.upsertProfileMatchAction(ctx, tx, userID, targetProfile.UserID, false)
+				return err
 			}); txErr != nil {
 				return false, fmt.Errorf("could not deactivate mutual match: %w", txErr)
 			}
+			u.publishMutualMatchRemoved(ctx, senderProfile, targetProfile)
 			return true, nil
 		}
 	}
@@ -90,25 +119,38 @@ func (u *matchMakingUsecase) RecordMatchAction(
 
 	if existingReverseMatch != nil && existingReverseMatch.IsLiked && matchAction == constants.MatchActionLike {
 		// Create mutual match and handle current user's action
+		var liked bool
 		if txErr := u.transactionManager.WithTransaction(ctx, func(tx *gorm.DB) error {
 			// Create or reactivate mutual match
 			if err := u.mutualMatchRepository.UpsertMutualMatchTx(ctx, tx, userID1, userID2); err != nil {
 				return err
 			}
 			// Handle current user's action
-			return u.upsertProfileMatchAction(ctx, tx, userID, targetProfile.UserID, true)
+			var err error
+			liked, err = u.upsertProfileMatchAction(ctx, tx, userID, targetProfile.UserID, true)
+			return err
 		}); txErr != nil {
 			return false, fmt.Errorf("error creating mutual match: %w", txErr)
 		}
 
+		// A concurrent request recorded the like first and announces the match
+		if !liked {
+			return true, nil
+		}
+
 		// Publish mutual match event
 		if err := u.eventPublisher.PublishMutualMatchCreated(ctx, userevents.MutualMatchCreatedEvent{
+			User1ID:        senderProfile.UserID,
+			User2ID:        targetProfile.UserID,
 			User1Email:     senderProfile.Email,
 			User1ProfileID: senderProfile.ID,
 			User1FullName:  senderProfile.FullName,
 			User2Email:     targetProfile.Email,
 			User2ProfileID: targetProfile.ID,
 			User2FullName:  targetProfile.FullName,
+			User1Locale:    senderProfile.Locale(),
+			User2Locale:    targetProfile.Locale(),
+			MatchedAt:      time.Now().UTC(),
 		}); err != nil {
 			// no need to return error here, just log it in event publisher side.
 		}
@@ -117,16 +159,18 @@ func (u *matchMakingUsecase) RecordMatchAction(
 	}
 
 	// Handle regular action (no mutual match created)
-	if err := u.upsertProfileMatchAction(ctx, nil, userID, targetProfile.UserID, matchAction == constants.MatchActionLike); err != nil {
+	recorded, err := u.upsertProfileMatchAction(ctx, nil, userID, targetProfile.UserID, matchAction == constants.MatchActionLike)
+	if err != nil {
 		return false, err
 	}
 
-	if matchAction == constants.MatchActionLike {
+	if recorded && matchAction == constants.MatchActionLike {
 		// Publish like event
 		if err := u.eventPublisher.PublishUserInterestSent(ctx, userevents.UserInterestSentEvent{
 			ReceiverEmail:   targetProfile.Email,
 			SenderProfileID: senderProfile.ID,
 			SenderName:      senderProfile.FullName,
+			ReceiverLocale:  targetProfile.Locale(),
 		}); err != nil {
 			// no need to return error here, just log it in event publisher side.
 		}
@@ -135,20 +179,21 @@ func (u *matchMakingUsecase) RecordMatchAction(
 	return true, nil
 }
 
-// Helper method to upsert profile match action (create new or update existing)
-func (u *matchMakingUsecase) upsertProfileMatchAction(ctx context.Context, tx *gorm.DB, userID, targetUserID uuid.UUID, isLiked bool) error {
+// Helper method to upsert profile match action (create new or update existing).
+// Reports false when a concurrent request created the action first.
+func (u *matchMakingUsecase) upsertProfileMatchAction(ctx context.Context, tx *gorm.DB, userID, targetUserID uuid.UUID, isLiked bool) (bool, error) {
 	existingMatch, err := u.profileMatchRepository.GetExistingMatch(ctx, userID, targetUserID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		return fmt.Errorf("error retrieving existing user action: %w", err)
+		return false, fmt.Errorf("error retrieving existing user action: %w", err)
 	}
 
 	if existingMatch != nil {
 		// Update existing action
 		if tx != nil {
-			return u.profileMatchRepository.UpdateMatchActionTx(ctx, tx, userID, targetUserID, isLiked)
+			return true, u.profileMatchRepository.UpdateMatchActionTx(ctx, tx, userID, targetUserID, isLiked)
 		}
 
-		return u.profileMatchRepository.UpdateMatchAction(ctx, userID, targetUserID, isLiked)
+		return true, u.profileMatchRepository.UpdateMatchAction(ctx, userID, targetUserID, isLiked)
 	} else {
 		// Create new action
 		if tx != nil {
@@ -157,3 +202,19 @@ func (u *matchMakingUsecase) upsertProfileMatchAction(ctx context.Context, tx *g
 		return u.profileMatchRepository.CreateMatchAction(ctx, userID, targetUserID, isLiked)
 	}
 }
+
+// publishMutualMatchRemoved tells the chat service to make the conversation
+// read-only and the matched user that the match has ended.
+func (u *matchMakingUsecase) publishMutualMatchRemoved(ctx context.Context, removedBy, matched *entity.UserProfile) {
+	if err := u.eventPublisher.PublishMutualMatchRemoved(ctx, userevents.MutualMatchRemovedEvent{
+		RemovedByUserID:     removedBy.UserID,
+		RemovedByFullName:   removedBy.FullName,
+		MatchedUserID:       matched.UserID,
+		MatchedUserEmail:    matched.Email,
+		MatchedUserFullName: matched.FullName,
+		MatchedUserLocale:   matched.Locale(),
+		RemovedAt:           time.Now().UTC(),
+	}); err != nil {
+		// no need to return error here, just log it in event publisher side.
+	}
+}
@@ -0,0 +1,139 @@
+package matchmaking
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
+)
+
+// RefreshRecommendations applies an update of the user's profile or partner
+// preferences to the cached recommendations. The user's own recommendations
+// are ranked again, and the user is scored again in the recommendations of
+// everyone the profile is currently recommended to. Users the profile newly
+// qualifies for see it once their cache expires.
+func (u *matchMakingUsecase) RefreshRecommendations(ctx context.Context, userID uuid.UUID) error {
+	userProfile, err := u.userProfileRepository.GetProfileByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if userProfile == nil {
+		return apperrors.ErrUserProfileNotFound
+	}
+
+	if _, err := u.precomputeRecommendations(ctx, userProfile); err != nil &&
+		!errors.Is(err, apperrors.ErrPartnerPreferencesNotFound) {
+		return err
+	}
+
+	viewerIDs, err := u.recommendationCache.GetRecommendedToUserIDs(ctx, userProfile.ID)
+	if err != nil {
+		return err
+	}
+	if len(viewerIDs) == 0 {
+		return nil
+	}
+
+	partnerPreference, err := u.partnerPreferencesRepository.GetPartnerPreferencesByUserProfileID(ctx, userProfile.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, viewerID := range viewerIDs {
+		if err := u.refreshCachedRecommendation(ctx, viewerID, userProfile, partnerPreference); err != nil {
+			// Ranking again is better than serving the old entry until it expires
+			u.logger.Warn("failed to rescore cached recommendation",
+				zap.String("user_id", viewerID.String()),
+				zap.Int64("profile_id", userProfile.ID),
+				zap.Error(err))
+			if err := u.recommendationCache.DeleteRecommendations(ctx, viewerID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// refreshCachedRecommendation scores the candidate again for one viewer. The
+// entry keeps its place until the viewer's recommendations are ranked again,
+// so that page cursors keep theirs. It is marked removed once the viewer's
+// must-haves, or the candidate's own, rule the pair out.
+func (u *matchMakingUsecase) refreshCachedRecommendation(
+	ctx context.Context,
+	viewerID uuid.UUID,
+	candidate *entity.UserProfile,
+	candidatePreference *entity.PartnerPreference) error {
+
+	viewer, err := u.userProfileRepository.GetProfileByUserID(ctx, viewerID)
+	if err != nil {
+		return err
+	}
+	if viewer == nil {
+		return u.recommendationCache.DeleteRecommendations(ctx, viewerID)
+	}
+	viewerPreference, err := u.partnerPreferencesRepository.GetPartnerPreferencesByUserProfileID(ctx, viewer.ID)
+	if err != nil {
+		return err
+	}
+	if viewerPreference == nil {
+		return u.recommendationCache.DeleteRecommendations(ctx, viewerID)
+	}
+
+	recommendable := false
+	var match reciprocalMatch
+	if isRecommendable(viewer, viewerPreference, candidate, u.dormantCutoff()) {
+		match = matchReciprocal(viewer, candidate, candidatePreference)
+		recommendable = !match.Excluded
+	}
+
+	return u.recommendationCache.UpdateRecommendations(ctx, viewerID, func(recommendations []entity.CachedRecommendation) bool {
+		for i, recommendation := range recommendations {
+			if recommendation.Profile.ID != candidate.ID || recommendation.Removed {
+				continue
+			}
+			if !recommendable {
+				recommendations[i].Removed = true
+				return true
+			}
+			ranked := newRankedCandidate(viewer, viewerPreference, candidate, match,
+				recommendation.Profile.PreviouslyPassed, time.Now().UTC())
+			recommendations[i] = ranked.toCachedRecommendation()
+			return true
+		}
+		return false
+	})
+}
+
+// removeCachedRecommendation marks a profile the user has acted on as removed
+// from the user's cached recommendations. When the cache cannot be updated it
+// is dropped as a whole, ranking again is better than recommending the
+// profile until the cache expires.
+func (u *matchMakingUsecase) removeCachedRecommendation(ctx context.Context, userID uuid.UUID, profileID int64) {
+	err := u.recommendationCache.UpdateRecommendations(ctx, userID, func(recommendations []entity.CachedRecommendation) bool {
+		for i, recommendation := range recommendations {
+			if recommendation.Profile.ID == profileID && !recommendation.Removed {
+				recommendations[i].Removed = true
+				return true
+			}
+		}
+		return false
+	})
+	if err == nil {
+		return
+	}
+
+	u.logger.Warn("failed to remove cached recommendation",
+		zap.String("user_id", userID.String()),
+		zap.Int64("profile_id", profileID),
+		zap.Error(err))
+	if err := u.recommendationCache.DeleteRecommendations(ctx, userID); err != nil {
+		u.logger.Warn("failed to drop cached recommendations",
+			zap.String("user_id", userID.String()),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,33 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+)
+
+func (u *matchMakingUsecase) RemoveFromShortlist(
+	ctx context.Context,
+	userID uuid.UUID,
+	targetProfileID int64) error {
+
+	targetProfile, err := u.userProfileRepository.GetUserProfileByID(ctx, targetProfileID)
+	if err != nil {
+		return fmt.Errorf("error retrieving target profile: %w", err)
+	}
+	if targetProfile == nil {
+		return apperrors.ErrUserProfileNotFound
+	}
+
+	removed, err := u.shortlistRepository.DeleteShortlist(ctx, userID, targetProfile.UserID)
+	if err != nil {
+		return fmt.Errorf("could not remove profile from shortlist: %w", err)
+	}
+	if !removed {
+		return apperrors.ErrShortlistEntryNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+)
+
+// RespondToInterest accepts or declines a pending like from senderProfileID.
+// Accepting likes the sender back, which creates the mutual match, declining
+// passes on them. Either way the interest leaves the inbox.
+func (u *matchMakingUsecase) RespondToInterest(
+	ctx context.Context,
+	userID uuid.UUID,
+	senderProfileID int64,
+	accept bool) error {
+
+	senderProfile, err := u.userProfileRepository.GetUserProfileByID(ctx, senderProfileID)
+	if err != nil {
+		return fmt.Errorf("error retrieving sender profile: %w", err)
+	}
+	if senderProfile == nil {
+		return apperrors.ErrInterestNotFound
+	}
+
+	interest, err := u.profileMatchRepository.GetExistingMatch(ctx, senderProfile.UserID, userID)
+	if err != nil {
+		return fmt.Errorf("error retrieving received interest: %w", err)
+	}
+	if interest == nil || !interest.IsLiked {
+		return apperrors.ErrInterestNotFound
+	}
+
+	response, err := u.profileMatchRepository.GetExistingMatch(ctx, userID, senderProfile.UserID)
+	if err != nil {
+		return fmt.Errorf("error retrieving existing user action: %w", err)
+	}
+	if response != nil {
+		return apperrors.ErrInterestNotFound
+	}
+
+	action := string(constants.MatchActionPass)
+	if accept {
+		action = string(constants.MatchActionLike)
+	}
+
+	if _, err := u.RecordMatchAction(ctx, userID, senderProfileID, action); err != nil {
+		return err
+	}
+
+	return nil
+}
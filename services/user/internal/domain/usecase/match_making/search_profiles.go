@@ -0,0 +1,205 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/ageutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pagination"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func (u *matchMakingUsecase) SearchProfiles(
+	ctx context.Context,
+	userID uuid.UUID,
+	filters entity.SearchFilters,
+	limit, offset int) ([]*entity.UserProfileResponse, *pagination.PaginationData, error) {
+
+	if limit <= 0 {
+		limit = constants.DefaultPaginationLimit
+	}
+	if limit > constants.MaxPaginationLimit {
+		limit = constants.MaxPaginationLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	filters, err := normalizeSearchFilters(filters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userProfile, err := u.userProfileRepository.GetProfileByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+	if userProfile == nil {
+		return nil, nil, apperrors.ErrUserProfileNotFound
+	}
+
+	excludedIDs, err := u.searchExcludedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	profiles, totalCount, err := u.userProfileRepository.SearchProfiles(ctx,
+		userID, excludedIDs, filters, nil, limit, offset, userProfile.IsBride)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to search profiles: %w", err)
+	}
+
+	paginationData := &pagination.PaginationData{
+		TotalCount: totalCount,
+		Limit:      limit,
+		Offset:     offset,
+		HasMore:    int64(offset+limit) < totalCount,
+	}
+	if len(profiles) == 0 {
+		return []*entity.UserProfileResponse{}, paginationData, nil
+	}
+
+	resultUserIDs := make([]uuid.UUID, len(profiles))
+	for i, profile := range profiles {
+		resultUserIDs[i] = profile.UserID
+	}
+	shortlistedIDs, err := u.shortlistRepository.GetShortlistedUserIDs(ctx, userID, resultUserIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get shortlisted user IDs: %w", err)
+	}
+	shortlisted := make(map[uuid.UUID]struct{}, len(shortlistedIDs))
+	for _, id := range shortlistedIDs {
+		shortlisted[id] = struct{}{}
+	}
+
+	now := time.Now().UTC()
+	results := make([]*entity.UserProfileResponse, len(profiles))
+	for i, profile := range profiles {
+		_, isShortlisted := shortlisted[profile.UserID]
+		var profilePictureURL *string
+		if imageKey := profile.PublicProfileImageKey(constants.ImageSizeThumbnail); imageKey != "" {
+			url, err := u.photoStorage.GetDownloadURL(ctx, imageKey, u.config.MediaStorage.URLExpiry)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get profile image URL: %w", err)
+			}
+			profilePictureURL = &url
+		}
+		results[i] = &entity.UserProfileResponse{
+			ID:                profile.ID,
+			FullName:          profile.FullName,
+			ProfilePictureURL: profilePictureURL,
+			Age:               int32(ageutil.CalculateAge(profile.DateOfBirth)),
+			HeightCm:          int32(profile.HeightCm),
+			MaritalStatus:     string(profile.MaritalStatus),
+			Profession:        string(profile.Profession),
+			HomeDistrict:      string(profile.HomeDistrict),
+			NativePlace:       profile.NativePlaceOrNil(),
+			Residence:         profile.ResidenceOrNil(),
+			IsNRI:             profile.IsNRI(),
+			IsShortlisted:     isShortlisted,
+			ActivityStatus:    entity.ActivityStatus(profile.LastLogin, now),
+		}
+	}
+
+	return results, paginationData, nil
+}
+
+// searchExcludedUserIDs returns the profiles searches never show, the ones
+// already acted on, blocked ones and the user's own.
+func (u *matchMakingUsecase) searchExcludedUserIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	excludedIDs, err := u.profileMatchRepository.GetMatchedProfileIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get matched profile IDs: %w", err)
+	}
+	blockedIDs, err := u.userBlockRepository.GetBlockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blocked user IDs: %w", err)
+	}
+	excludedIDs = append(excludedIDs, blockedIDs...)
+	return append(excludedIDs, userID), nil
+}
+
+// normalizeSearchFilters validates the filters and returns them in the form
+// they are queried and saved in.
+func normalizeSearchFilters(filters entity.SearchFilters) (entity.SearchFilters, error) {
+	if filters.MinAgeYears != nil && !validation.IsValidAge(int(*filters.MinAgeYears)) ||
+		filters.MaxAgeYears != nil && !validation.IsValidAge(int(*filters.MaxAgeYears)) ||
+		filters.MinAgeYears != nil && filters.MaxAgeYears != nil && *filters.MinAgeYears > *filters.MaxAgeYears {
+		return filters, apperrors.ErrInvalidAgeRange
+	}
+	if filters.MinHeightCm != nil && !validation.IsValidHeight(int(*filters.MinHeightCm)) ||
+		filters.MaxHeightCm != nil && !validation.IsValidHeight(int(*filters.MaxHeightCm)) ||
+		filters.MinHeightCm != nil && filters.MaxHeightCm != nil && *filters.MinHeightCm > *filters.MaxHeightCm {
+		return filters, apperrors.ErrInvalidHeightRange
+	}
+	if filters.ActiveWithinDays < 0 || filters.ActiveWithinDays > constants.MaxSearchActiveWithinDays {
+		return filters, apperrors.ErrInvalidActiveWithinDays
+	}
+	if !validation.IsValidSearchSort(filters.Sort) {
+		return filters, apperrors.ErrInvalidSearchSort
+	}
+
+	if len(filters.Communities) > 0 {
+		communities, err := validation.ParsePreferredCommunities(filters.Communities)
+		if err != nil {
+			return filters, apperrors.ErrInvalidCommunity
+		}
+		filters.Communities = validation.CommunitiesToStrings(communities)
+	}
+	if len(filters.MaritalStatuses) > 0 {
+		maritalStatuses, err := validation.ParsePreferredMaritalStatuses(filters.MaritalStatuses)
+		if err != nil {
+			return filters, apperrors.ErrInvalidMaritalStatus
+		}
+		filters.MaritalStatuses = validation.MaritalStatusesToStrings(maritalStatuses)
+	}
+	if len(filters.Professions) > 0 {
+		professions, err := validation.ParsePreferredProfessions(filters.Professions)
+		if err != nil {
+			return filters, apperrors.ErrInvalidProfession
+		}
+		filters.Professions = validation.ProfessionsToStrings(professions)
+	}
+	if len(filters.ProfessionTypes) > 0 {
+		professionTypes, err := validation.ParsePreferredProfessionTypes(filters.ProfessionTypes)
+		if err != nil {
+			return filters, apperrors.ErrInvalidProfessionType
+		}
+		filters.ProfessionTypes = validation.ProfessionTypesToStrings(professionTypes)
+	}
+	if len(filters.EducationLevels) > 0 {
+		educationLevels, err := validation.ParsePreferredEducationLevels(filters.EducationLevels)
+		if err != nil {
+			return filters, apperrors.ErrInvalidEducationLevel
+		}
+		filters.EducationLevels = validation.EducationLevelsToStrings(educationLevels)
+	}
+	if len(filters.HomeDistricts) > 0 {
+		homeDistricts, err := validation.ParsePreferredHomeDistricts(filters.HomeDistricts)
+		if err != nil {
+			return filters, apperrors.ErrInvalidHomeDistrict
+		}
+		filters.HomeDistricts = validation.HomeDistrictsToStrings(homeDistricts)
+	}
+	if len(filters.NativeCountries) > 0 {
+		countries, err := validation.ParsePreferredCountries(filters.NativeCountries)
+		if err != nil {
+			return filters, apperrors.ErrInvalidCountry
+		}
+		filters.NativeCountries = countries
+	}
+	if len(filters.ResidenceCountries) > 0 {
+		countries, err := validation.ParsePreferredCountries(filters.ResidenceCountries)
+		if err != nil {
+			return filters, apperrors.ErrInvalidCountry
+		}
+		filters.ResidenceCountries = countries
+	}
+
+	return filters, nil
+}
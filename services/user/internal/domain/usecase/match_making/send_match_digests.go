@@ -0,0 +1,114 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	userevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/user"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/ageutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
+)
+
+const (
+	matchDigestDailyPeriod  = 24 * time.Hour
+	matchDigestWeeklyPeriod = 7 * 24 * time.Hour
+)
+
+// SendMatchDigests publishes a digest of the top new recommendations to the
+// users whose daily or weekly digest is due. It returns the number of
+// digests published. A user that fails is logged and skipped, their digest is
+// retried on the next run.
+func (u *matchMakingUsecase) SendMatchDigests(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	profiles, err := u.userProfileRepository.GetProfilesDueForDigest(ctx,
+		now.Add(-matchDigestDailyPeriod),
+		now.Add(-matchDigestWeeklyPeriod),
+		u.config.MatchDigests.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get profiles due for digest: %w", err)
+	}
+
+	sent := 0
+	for _, profile := range profiles {
+		published, err := u.sendMatchDigest(ctx, profile, now)
+		if err != nil {
+			u.logger.Error("failed to send match digest",
+				zap.String("user_id", profile.UserID.String()),
+				zap.Error(err))
+			continue
+		}
+		if published {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// sendMatchDigest covers the profiles completed since the user's last digest,
+// or over the last period for a first digest. Users without partner
+// preferences or new recommendations are marked as sent without an email, a
+// failed publish is retried on the next run.
+func (u *matchMakingUsecase) sendMatchDigest(
+	ctx context.Context,
+	userProfile *entity.UserProfile,
+	now time.Time) (bool, error) {
+
+	since := now.Add(-matchDigestWeeklyPeriod)
+	if userProfile.DigestFrequency == constants.DigestFrequencyDaily {
+		since = now.Add(-matchDigestDailyPeriod)
+	}
+	if userProfile.LastDigestSentAt != nil {
+		since = *userProfile.LastDigestSentAt
+	}
+
+	partnerPreference, err := u.partnerPreferencesRepository.GetPartnerPreferencesByUserProfileID(ctx, userProfile.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get partner preferences: %w", err)
+	}
+
+	var candidates []rankedCandidate
+	if partnerPreference != nil {
+		candidates, err = u.rankCandidates(ctx, userProfile, partnerPreference, &since)
+		if err != nil {
+			return false, fmt.Errorf("failed to rank digest candidates: %w", err)
+		}
+	}
+	if len(candidates) > constants.MaxMatchDigestProfiles {
+		candidates = candidates[:constants.MaxMatchDigestProfiles]
+	}
+
+	if len(candidates) > 0 {
+		digestProfiles := make([]userevents.MatchDigestProfile, len(candidates))
+		for i, candidate := range candidates {
+			digestProfiles[i] = userevents.MatchDigestProfile{
+				ProfileID:          candidate.profile.ID,
+				FullName:           candidate.profile.FullName,
+				Age:                int32(ageutil.CalculateAge(candidate.profile.DateOfBirth)),
+				HeightCm:           int32(candidate.profile.HeightCm),
+				Profession:         string(candidate.profile.Profession),
+				HomeDistrict:       string(candidate.profile.HomeDistrict),
+				CompatibilityScore: candidate.score.Score,
+			}
+		}
+		if err := u.eventPublisher.PublishMatchDigest(ctx, userevents.MatchDigestEvent{
+			UserID:    userProfile.UserID,
+			Email:     userProfile.Email,
+			FullName:  userProfile.FullName,
+			Frequency: userProfile.DigestFrequency,
+			Profiles:  digestProfiles,
+			Locale:    userProfile.Locale(),
+		}); err != nil {
+			return false, fmt.Errorf("failed to publish %s event: %w", constants.EventMatchDigest, err)
+		}
+	}
+
+	if err := u.userProfileRepository.UpdateLastDigestSentAt(ctx, userProfile.ID, now); err != nil {
+		return false, fmt.Errorf("failed to update last digest time: %w", err)
+	}
+
+	return len(candidates) > 0, nil
+}
@@ -0,0 +1,89 @@
+package matchmaking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	userevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/user"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
+)
+
+// SendSavedSearchAlerts checks the saved searches with alerts on that have
+// not been checked for an alert interval, and publishes an alert for each one
+// that new profiles match. It returns the number of alerts published. A search
+// that fails is logged and skipped, it is retried on the next run.
+func (u *matchMakingUsecase) SendSavedSearchAlerts(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	searches, err := u.savedSearchRepository.GetSavedSearchesDueForAlert(ctx,
+		now.Add(-u.config.SavedSearches.AlertInterval),
+		u.config.SavedSearches.AlertBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get saved searches due for alert: %w", err)
+	}
+
+	sent := 0
+	for _, search := range searches {
+		alerted, err := u.checkSavedSearch(ctx, search, now)
+		if err != nil {
+			u.logger.Error("failed to check saved search",
+				zap.Int64("saved_search_id", search.ID),
+				zap.String("user_id", search.UserID.String()),
+				zap.Error(err))
+			continue
+		}
+		if alerted {
+			sent++
+		}
+	}
+
+	return sent, nil
+}
+
+// checkSavedSearch is only marked as checked once the alert is published, a
+// failed publish is retried on the next run.
+func (u *matchMakingUsecase) checkSavedSearch(
+	ctx context.Context,
+	search *entity.SavedSearch,
+	now time.Time) (bool, error) {
+
+	owner, err := u.userProfileRepository.GetProfileByUserID(ctx, search.UserID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get saved search owner: %w", err)
+	}
+
+	var matchCount int64
+	if owner != nil && owner.ProfileCompleted {
+		excludedIDs, err := u.searchExcludedUserIDs(ctx, search.UserID)
+		if err != nil {
+			return false, err
+		}
+		lastCheckedAt := search.LastCheckedAt
+		_, matchCount, err = u.userProfileRepository.SearchProfiles(ctx,
+			search.UserID, excludedIDs, search.Filters, &lastCheckedAt, 1, 0, owner.IsBride)
+		if err != nil {
+			return false, fmt.Errorf("failed to run saved search: %w", err)
+		}
+	}
+
+	if matchCount > 0 {
+		if err := u.eventPublisher.PublishSavedSearchMatched(ctx, userevents.SavedSearchMatchedEvent{
+			UserID:     owner.UserID,
+			Email:      owner.Email,
+			FullName:   owner.FullName,
+			SearchName: search.Name,
+			MatchCount: matchCount,
+			Locale:     owner.Locale(),
+		}); err != nil {
+			return false, fmt.Errorf("failed to publish %s event: %w", constants.EventSavedSearchMatched, err)
+		}
+	}
+
+	if err := u.savedSearchRepository.UpdateLastCheckedAt(ctx, search.ID, now); err != nil {
+		return false, fmt.Errorf("failed to update saved search: %w", err)
+	}
+
+	return matchCount > 0, nil
+}
@@ -0,0 +1,89 @@
+package matchmaking
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+// UndoLastMatchAction removes the user's latest like or pass, the profile is
+// recommended again as if it was never acted on. Undoing a like also ends the
+// mutual match it created, the same way a pass does. A mutual match ended by
+// the pass stays ended, a new like restores it.
+func (u *matchMakingUsecase) UndoLastMatchAction(
+	ctx context.Context,
+	userID uuid.UUID) (int64, string, error) {
+
+	lastAction, err := u.profileMatchRepository.GetLatestMatchAction(ctx, userID)
+	if err != nil {
+		return 0, "", fmt.Errorf("error retrieving latest match action: %w", err)
+	}
+	if lastAction == nil {
+		return 0, "", apperrors.ErrNoMatchActionToUndo
+	}
+	if window := u.config.MatchActions.UndoWindow; window > 0 && time.Since(lastAction.UpdatedAt) > window {
+		return 0, "", apperrors.ErrNoMatchActionToUndo
+	}
+
+	targetProfile, err := u.userProfileRepository.GetProfileByUserID(ctx, lastAction.TargetID)
+	if err != nil {
+		return 0, "", fmt.Errorf("error retrieving target profile: %w", err)
+	}
+	if targetProfile == nil {
+		return 0, "", apperrors.ErrUserProfileNotFound
+	}
+
+	// Ensure user id on left always less than right, used for mutual match only
+	userID1, userID2 := userID, lastAction.TargetID
+	if bytes.Compare(userID1[:], userID2[:]) > 0 {
+		userID1, userID2 = userID2, userID1
+	}
+
+	// The matched user is told when undoing the like ends an active match
+	var userProfile *entity.UserProfile
+	if lastAction.IsLiked {
+		existingMutual, err := u.mutualMatchRepository.GetMutualMatch(ctx, userID1, userID2)
+		if err != nil {
+			return 0, "", fmt.Errorf("error retrieving existing mutual match: %w", err)
+		}
+		if existingMutual != nil {
+			userProfile, err = u.userProfileRepository.GetProfileByUserID(ctx, userID)
+			if err != nil {
+				return 0, "", fmt.Errorf("error retrieving user profile: %w", err)
+			}
+		}
+	}
+
+	if txErr := u.transactionManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		if err := u.profileMatchRepository.DeleteMatchActionTx(ctx, tx, userID, lastAction.TargetID); err != nil {
+			return err
+		}
+		if !lastAction.IsLiked {
+			return nil
+		}
+		return u.mutualMatchRepository.DeactivateMutualMatchTx(ctx, tx, userID1, userID2)
+	}); txErr != nil {
+		return 0, "", fmt.Errorf("could not undo match action: %w", txErr)
+	}
+
+	if userProfile != nil {
+		u.publishMutualMatchRemoved(ctx, userProfile, targetProfile)
+	}
+
+	// The profile is back among the candidates, the user is simply ranked again
+	_ = u.recommendationCache.DeleteRecommendations(ctx, userID)
+
+	action := string(constants.MatchActionPass)
+	if lastAction.IsLiked {
+		action = string(constants.MatchActionLike)
+	}
+
+	return targetProfile.ID, action, nil
+}
@@ -11,13 +11,57 @@ import (
 type MatchMakingUsecase interface {
 	RecommendUserProfiles(ctx context.Context,
 		userID uuid.UUID,
-		limit, offset int) ([]*entity.UserProfileResponse, *pagination.PaginationData, error)
+		limit, offset int,
+		cursor string) ([]*entity.UserProfileResponse, *pagination.PaginationData, error)
+	RefreshRecommendations(ctx context.Context,
+		userID uuid.UUID) error
 	RecordMatchAction(ctx context.Context,
 		userID uuid.UUID,
 		targetProfileID int64,
 		action string) (bool, error)
+	UndoLastMatchAction(ctx context.Context,
+		userID uuid.UUID) (int64, string, error)
 	GetProfilesByMatchAction(ctx context.Context,
 		userID uuid.UUID,
 		action string,
 		limit, offset int) ([]*entity.UserProfileResponse, *pagination.PaginationData, error)
+	GetReceivedInterests(ctx context.Context,
+		userID uuid.UUID,
+		limit, offset int) ([]*entity.ReceivedInterest, *pagination.PaginationData, error)
+	GetReceivedInterestCounts(ctx context.Context,
+		userID uuid.UUID) (*entity.ReceivedInterestCounts, error)
+	RespondToInterest(ctx context.Context,
+		userID uuid.UUID,
+		senderProfileID int64,
+		accept bool) error
+	BlockOrUnblockProfile(ctx context.Context,
+		userID uuid.UUID,
+		targetProfileID int64,
+		shouldBlock bool) (bool, error)
+	AddToShortlist(ctx context.Context,
+		userID uuid.UUID,
+		targetProfileID int64,
+		note string) error
+	RemoveFromShortlist(ctx context.Context,
+		userID uuid.UUID,
+		targetProfileID int64) error
+	GetShortlist(ctx context.Context,
+		userID uuid.UUID,
+		limit, offset int) ([]*entity.ShortlistedProfileResponse, *pagination.PaginationData, error)
+	SearchProfiles(ctx context.Context,
+		userID uuid.UUID,
+		filters entity.SearchFilters,
+		limit, offset int) ([]*entity.UserProfileResponse, *pagination.PaginationData, error)
+	CreateSavedSearch(ctx context.Context,
+		userID uuid.UUID,
+		name string,
+		filters entity.SearchFilters,
+		alertsEnabled bool) (*entity.SavedSearch, error)
+	GetSavedSearches(ctx context.Context,
+		userID uuid.UUID) ([]*entity.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context,
+		userID uuid.UUID,
+		savedSearchID int64) error
+	SendSavedSearchAlerts(ctx context.Context) (int, error)
+	SendMatchDigests(ctx context.Context) (int, error)
 }
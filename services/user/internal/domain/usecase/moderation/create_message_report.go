@@ -0,0 +1,45 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+// CreateMessageReport stores a chat message report, chat service validates the report
+// before publishing it so only the sender profile is looked up here.
+func (u *moderationUsecase) CreateMessageReport(
+	ctx context.Context,
+	reporterID, senderID uuid.UUID,
+	messageID, messageContent, category, description string) error {
+
+	senderProfile, err := u.userProfileRepository.GetProfileByUserID(ctx, senderID)
+	if err != nil {
+		return fmt.Errorf("error retrieving sender profile: %w", err)
+	}
+	if senderProfile == nil {
+		return apperrors.ErrUserProfileNotFound
+	}
+
+	report := &entity.Report{
+		ReporterID:      reporterID,
+		TargetUserID:    senderID,
+		TargetProfileID: senderProfile.ID,
+		TargetType:      constants.ReportTargetMessage,
+		MessageID:       &messageID,
+		MessageContent:  &messageContent,
+		Category:        category,
+		Description:     description,
+		Status:          constants.ReportStatusPending,
+	}
+
+	if err := u.reportRepository.CreateReport(ctx, report); err != nil {
+		return fmt.Errorf("failed to create message report: %w", err)
+	}
+
+	return nil
+}
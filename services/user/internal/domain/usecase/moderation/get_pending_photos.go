@@ -0,0 +1,53 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pagination"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func (u *moderationUsecase) GetPendingPhotos(
+	ctx context.Context,
+	limit, offset int) ([]*entity.PendingPhotoResponse, *pagination.PaginationData, error) {
+
+	if limit <= 0 {
+		limit = constants.DefaultPaginationLimit
+	}
+	if limit > constants.MaxPaginationLimit {
+		limit = constants.MaxPaginationLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	photos, totalCount, err := u.userImageRepository.ListPendingPhotos(ctx, limit, offset)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list pending photos: %w", err)
+	}
+
+	responses := make([]*entity.PendingPhotoResponse, 0, len(photos))
+	for _, photo := range photos {
+		url, err := u.photoStorage.GetDownloadURL(ctx, photo.ObjectKey, u.config.MediaStorage.URLExpiry)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate download URL: %w", err)
+		}
+		responses = append(responses, &entity.PendingPhotoResponse{
+			UserProfileID: photo.UserProfileID,
+			UserID:        photo.UserID,
+			ObjectKey:     photo.ObjectKey,
+			DisplayOrder:  photo.DisplayOrder,
+			PhotoURL:      url,
+			UploadedAt:    photo.UploadedAt,
+		})
+	}
+
+	return responses, &pagination.PaginationData{
+		TotalCount: totalCount,
+		Limit:      limit,
+		Offset:     offset,
+		HasMore:    int64(offset+limit) < totalCount,
+	}, nil
+}
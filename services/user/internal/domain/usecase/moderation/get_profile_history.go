@@ -0,0 +1,55 @@
+package moderation
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pagination"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+// GetProfileHistory lists profile and partner preference changes, latest
+// first. Without a profile ID it lists changes of every profile, which is
+// mostly useful together with FlaggedOnly.
+func (u *moderationUsecase) GetProfileHistory(
+	ctx context.Context,
+	req entity.GetProfileHistoryRequest) ([]*entity.ProfileChange, *pagination.PaginationData, error) {
+
+	if !validation.IsValidProfileChangeSection(req.Section) {
+		return nil, nil, apperrors.ErrInvalidProfileChangeSection
+	}
+
+	if req.UserProfileID > 0 {
+		profile, err := u.userProfileRepository.GetUserProfileByID(ctx, req.UserProfileID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if profile == nil {
+			return nil, nil, apperrors.ErrUserProfileNotFound
+		}
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = constants.DefaultPaginationLimit
+	}
+	if req.Limit > constants.MaxPaginationLimit {
+		req.Limit = constants.MaxPaginationLimit
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	changes, totalCount, err := u.profileChangeRepository.ListProfileChanges(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return changes, &pagination.PaginationData{
+		TotalCount: totalCount,
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+		HasMore:    int64(req.Offset+req.Limit) < totalCount,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func (u *moderationUsecase) GetReport(
+	ctx context.Context,
+	reportID int64) (*entity.Report, error) {
+
+	report, err := u.reportRepository.GetReportByID(ctx, reportID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving report: %w", err)
+	}
+	if report == nil {
+		return nil, apperrors.ErrReportNotFound
+	}
+
+	return report, nil
+}
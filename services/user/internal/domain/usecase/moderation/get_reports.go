@@ -0,0 +1,46 @@
+package moderation
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pagination"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func (u *moderationUsecase) GetReports(
+	ctx context.Context,
+	status string,
+	limit, offset int) ([]*entity.Report, *pagination.PaginationData, error) {
+
+	if status == "" {
+		status = constants.ReportStatusPending
+	}
+	if !validation.IsValidReportStatus(status) {
+		return nil, nil, apperrors.ErrInvalidReportStatus
+	}
+
+	if limit <= 0 {
+		limit = constants.DefaultPaginationLimit
+	}
+	if limit > constants.MaxPaginationLimit {
+		limit = constants.MaxPaginationLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	reports, totalCount, err := u.reportRepository.GetReportsByStatus(ctx, status, limit, offset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reports, &pagination.PaginationData{
+		TotalCount: totalCount,
+		Limit:      limit,
+		Offset:     offset,
+		HasMore:    int64(offset+limit) < totalCount,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package moderation
+
+import (
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/config"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/event"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/mediastorage"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/usecase"
+)
+
+type moderationUsecase struct {
+	reportRepository        repository.ReportRepository
+	profileChangeRepository repository.ProfileChangeRepository
+	userProfileRepository   repository.UserProfileRepository
+	userImageRepository     repository.UserImageRepository
+	userProfileUsecase      usecase.UserProfileUsecase
+	transactionManager      *postgres.TransactionManager
+	eventPublisher          event.EventPublisher
+	photoStorage            mediastorage.PhotoStorage
+	config                  *config.Config
+}
+
+func NewModerationUsecase(
+	reportRepository repository.ReportRepository,
+	profileChangeRepository repository.ProfileChangeRepository,
+	userProfileRepository repository.UserProfileRepository,
+	userImageRepository repository.UserImageRepository,
+	userProfileUsecase usecase.UserProfileUsecase,
+	transactionManager *postgres.TransactionManager,
+	eventPublisher event.EventPublisher,
+	photoStorage mediastorage.PhotoStorage,
+	config *config.Config,
+) usecase.ModerationUsecase {
+	return &moderationUsecase{
+		reportRepository:        reportRepository,
+		profileChangeRepository: profileChangeRepository,
+		userProfileRepository:   userProfileRepository,
+		userImageRepository:     userImageRepository,
+		userProfileUsecase:      userProfileUsecase,
+		transactionManager:      transactionManager,
+		eventPublisher:          eventPublisher,
+		photoStorage:            photoStorage,
+		config:                  config,
+	}
+}
@@ -0,0 +1,82 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func (u *moderationUsecase) ReportProfile(
+	ctx context.Context,
+	reporterID uuid.UUID,
+	req entity.CreateReportRequest) (int64, error) {
+
+	if !validation.IsValidReportCategory(req.Category) {
+		return 0, apperrors.ErrInvalidReportCategory
+	}
+	description := strings.TrimSpace(req.Description)
+	if len(description) > constants.MaxReportDescriptionLength {
+		return 0, apperrors.ErrReportDescriptionTooLong
+	}
+
+	targetProfile, err := u.userProfileRepository.GetUserProfileByID(ctx, req.TargetProfileID)
+	if err != nil {
+		return 0, fmt.Errorf("error retrieving target profile: %w", err)
+	}
+	if targetProfile == nil {
+		return 0, apperrors.ErrInvalidTargetProfileID
+	}
+	if targetProfile.UserID == reporterID {
+		return 0, apperrors.ErrCannotReportSelf
+	}
+
+	report := &entity.Report{
+		ReporterID:      reporterID,
+		TargetUserID:    targetProfile.UserID,
+		TargetProfileID: targetProfile.ID,
+		TargetType:      req.TargetType,
+		Category:        req.Category,
+		Description:     description,
+		Status:          constants.ReportStatusPending,
+	}
+
+	switch req.TargetType {
+	case constants.ReportTargetProfile:
+		// nothing more to capture
+	case constants.ReportTargetPhoto:
+		if req.PhotoDisplayOrder == nil {
+			return 0, apperrors.ErrInvalidReportTarget
+		}
+		displayOrder := *req.PhotoDisplayOrder
+		if displayOrder == constants.ReportProfilePhotoDisplayOrder {
+			if targetProfile.ProfileImageKey == "" {
+				return 0, apperrors.ErrProfilePhotoNotFound
+			}
+			report.PhotoObjectKey = &targetProfile.ProfileImageKey
+		} else {
+			userImage, err := u.userImageRepository.GetUserImage(ctx, targetProfile.UserID, int32(displayOrder))
+			if err != nil {
+				return 0, fmt.Errorf("error retrieving reported photo: %w", err)
+			}
+			if userImage == nil {
+				return 0, apperrors.ErrDisplayOrderNotOccupied
+			}
+			report.PhotoObjectKey = &userImage.ObjectKey
+		}
+		report.PhotoDisplayOrder = &displayOrder
+	default:
+		return 0, apperrors.ErrInvalidReportTarget
+	}
+
+	if err := u.reportRepository.CreateReport(ctx, report); err != nil {
+		return 0, fmt.Errorf("failed to create report: %w", err)
+	}
+
+	return report.ID, nil
+}
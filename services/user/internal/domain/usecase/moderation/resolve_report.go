@@ -0,0 +1,168 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	userevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/user"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+func (u *moderationUsecase) ResolveReport(
+	ctx context.Context,
+	adminID uuid.UUID,
+	reportID int64,
+	action, adminNote string) (*entity.Report, error) {
+
+	if !validation.IsValidModerationAction(action) {
+		return nil, apperrors.ErrInvalidModerationAction
+	}
+
+	report, err := u.reportRepository.GetReportByID(ctx, reportID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving report: %w", err)
+	}
+	if report == nil {
+		return nil, apperrors.ErrReportNotFound
+	}
+	if report.Status != constants.ReportStatusPending {
+		return nil, apperrors.ErrReportAlreadyResolved
+	}
+
+	if action == constants.ModerationActionRemovePhoto &&
+		(report.TargetType != constants.ReportTargetPhoto || report.PhotoDisplayOrder == nil) {
+		return nil, apperrors.ErrInvalidModerationAction
+	}
+
+	// The photo is looked up before the report is claimed, so a photo the user
+	// has already replaced or removed leaves the report pending.
+	var photo *reportedPhoto
+	if action == constants.ModerationActionRemovePhoto {
+		photo, err = u.findReportedPhoto(ctx, report)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status := constants.ReportStatusActioned
+	if action == constants.ModerationActionDismiss {
+		status = constants.ReportStatusDismissed
+	}
+
+	now := time.Now().UTC()
+	report.Status = status
+	report.Action = &action
+	if note := strings.TrimSpace(adminNote); note != "" {
+		report.AdminNote = &note
+	}
+	report.ResolvedBy = &adminID
+	report.ResolvedAt = &now
+	report.UpdatedAt = now
+
+	// Only the claim runs in the transaction, an admin resolving the report at
+	// the same time waits on the row and then finds it resolved. The action is
+	// applied after commit, so events and storage deletes are not left behind
+	// by a rolled back claim.
+	err = u.transactionManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		claimed, err := u.reportRepository.ResolveReportTx(ctx, tx, report)
+		if err != nil {
+			return fmt.Errorf("failed to resolve report: %w", err)
+		}
+		if !claimed {
+			return apperrors.ErrReportAlreadyResolved
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case constants.ModerationActionWarn:
+		err = u.warnReportedUser(ctx, report)
+	case constants.ModerationActionRemovePhoto:
+		err = u.removeReportedPhoto(ctx, report.TargetUserID, photo)
+	case constants.ModerationActionSuspend:
+		// The account itself is suspended by the auth service, the gateway calls
+		// BlockOrUnblockUser before the report is resolved here.
+	}
+	if err != nil {
+		return nil, fmt.Errorf("report resolved, failed to apply %s: %w", action, err)
+	}
+
+	return report, nil
+}
+
+func (u *moderationUsecase) warnReportedUser(ctx context.Context, report *entity.Report) error {
+	targetProfile, err := u.userProfileRepository.GetProfileByUserID(ctx, report.TargetUserID)
+	if err != nil {
+		return fmt.Errorf("error retrieving reported profile: %w", err)
+	}
+	if targetProfile == nil {
+		return apperrors.ErrUserProfileNotFound
+	}
+	if err := u.eventPublisher.PublishUserWarned(ctx, userevents.UserWarnedEvent{
+		UserID:   targetProfile.UserID,
+		Email:    targetProfile.Email,
+		FullName: targetProfile.FullName,
+		Category: report.Category,
+		Locale:   targetProfile.Locale(),
+	}); err != nil {
+		// no need to return error here, just log it in event publisher side.
+	}
+	return nil
+}
+
+// reportedPhoto is where the photo the reporter saw is now, either the
+// profile photo or an additional photo slot.
+type reportedPhoto struct {
+	isProfilePhoto bool
+	displayOrder   int32
+}
+
+// findReportedPhoto locates the photo the reporter saw. Photos move between
+// slots, so it is looked up by object key and ErrReportedPhotoChanged is
+// returned once the user has replaced or removed it.
+func (u *moderationUsecase) findReportedPhoto(ctx context.Context, report *entity.Report) (*reportedPhoto, error) {
+	if report.PhotoObjectKey == nil {
+		return nil, apperrors.ErrReportedPhotoChanged
+	}
+	objectKey := *report.PhotoObjectKey
+
+	targetProfile, err := u.userProfileRepository.GetProfileByUserID(ctx, report.TargetUserID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving reported profile: %w", err)
+	}
+	if targetProfile == nil {
+		return nil, apperrors.ErrUserProfileNotFound
+	}
+	if targetProfile.ProfileImageKey == objectKey {
+		return &reportedPhoto{isProfilePhoto: true}, nil
+	}
+
+	images, err := u.userImageRepository.ListUserImages(ctx, report.TargetUserID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving reported user images: %w", err)
+	}
+	for _, image := range images {
+		if image.ObjectKey == objectKey {
+			return &reportedPhoto{displayOrder: int32(image.DisplayOrder)}, nil
+		}
+	}
+
+	return nil, apperrors.ErrReportedPhotoChanged
+}
+
+func (u *moderationUsecase) removeReportedPhoto(ctx context.Context, userID uuid.UUID, photo *reportedPhoto) error {
+	if photo.isProfilePhoto {
+		return u.userProfileUsecase.DeleteProfilePhoto(ctx, userID)
+	}
+	return u.userProfileUsecase.DeleteAdditionalPhoto(ctx, userID, photo.displayOrder)
+}
@@ -0,0 +1,174 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	userevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/user"
+)
+
+func (u *moderationUsecase) ReviewPhoto(
+	ctx context.Context,
+	targetProfileID int64,
+	displayOrder int32,
+	objectKey string,
+	approve bool,
+	reason string) error {
+
+	reason = strings.TrimSpace(reason)
+	if !approve {
+		if reason == "" {
+			return apperrors.ErrPhotoRejectionReasonRequired
+		}
+		if len(reason) > constants.MaxPhotoRejectionReasonLength {
+			return apperrors.ErrPhotoRejectionReasonTooLong
+		}
+	}
+
+	targetProfile, err := u.userProfileRepository.GetUserProfileByID(ctx, targetProfileID)
+	if err != nil {
+		return fmt.Errorf("error retrieving target profile: %w", err)
+	}
+	if targetProfile == nil {
+		return apperrors.ErrUserProfileNotFound
+	}
+
+	if displayOrder == constants.ReportProfilePhotoDisplayOrder {
+		err = u.reviewProfilePhoto(ctx, targetProfile.UserID, objectKey, approve)
+	} else {
+		err = u.reviewAdditionalPhoto(ctx, targetProfile.UserID, displayOrder, objectKey, approve, reason)
+	}
+	if err != nil {
+		return err
+	}
+
+	// A rejected photo is deleted, which already announces the change. An
+	// approved one becomes visible to others, so recommendations showing the
+	// profile are ranked again.
+	if approve {
+		if err := u.eventPublisher.PublishUserProfileUpdated(ctx, userevents.UserProfileUpdatedEvent{
+			UserID:        targetProfile.UserID,
+			UserProfileID: targetProfile.ID,
+			Email:         targetProfile.Email,
+			Phone:         targetProfile.Phone,
+			FullName:      targetProfile.FullName,
+			CreatedAt:     targetProfile.CreatedAt,
+			UpdatedAt:     targetProfile.UpdatedAt,
+		}); err != nil {
+			// no need to return error here, just log it in event publisher side.
+		}
+		return nil
+	}
+
+	if err := u.eventPublisher.PublishUserPhotoRejected(ctx, userevents.UserPhotoRejectedEvent{
+		UserID:       targetProfile.UserID,
+		Email:        targetProfile.Email,
+		FullName:     targetProfile.FullName,
+		DisplayOrder: displayOrder,
+		Reason:       reason,
+		Locale:       targetProfile.Locale(),
+	}); err != nil {
+		// no need to return error here, just log it in event publisher side.
+	}
+
+	return nil
+}
+
+// The decision applies to the photo the admin looked at. If the user replaced
+// or moved it since the queue was loaded, the review is refused with
+// ErrReviewedPhotoChanged and the new photo waits for its own review.
+func (u *moderationUsecase) reviewProfilePhoto(
+	ctx context.Context,
+	userID uuid.UUID,
+	objectKey string,
+	approve bool) error {
+
+	profile, err := u.userProfileRepository.GetProfileByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error retrieving target profile: %w", err)
+	}
+	if profile == nil {
+		return apperrors.ErrUserProfileNotFound
+	}
+	if profile.ProfileImageKey == "" {
+		return apperrors.ErrProfilePhotoNotFound
+	}
+	if profile.ProfileImageKey != objectKey {
+		return apperrors.ErrReviewedPhotoChanged
+	}
+	if profile.ProfileImageStatus != constants.PhotoStatusPendingReview {
+		return apperrors.ErrPhotoNotPendingReview
+	}
+
+	status := constants.PhotoStatusRejected
+	var significantlyUpdatedAt *time.Time
+	if approve {
+		status = constants.PhotoStatusApproved
+		// A new photo is worth a second look from users who passed
+		now := time.Now().UTC()
+		significantlyUpdatedAt = &now
+	}
+	updated, err := u.userProfileRepository.UpdateProfileImageReview(ctx, userID, objectKey, status, significantlyUpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to review profile photo: %w", err)
+	}
+	if !updated {
+		return apperrors.ErrReviewedPhotoChanged
+	}
+	if approve {
+		return nil
+	}
+
+	// Rejected photo is removed from storage, the user can upload a new one
+	return u.userProfileUsecase.DeleteProfilePhoto(ctx, userID)
+}
+
+func (u *moderationUsecase) reviewAdditionalPhoto(
+	ctx context.Context,
+	userID uuid.UUID,
+	displayOrder int32,
+	objectKey string,
+	approve bool,
+	reason string) error {
+
+	image, err := u.userImageRepository.GetUserImage(ctx, userID, displayOrder)
+	if err != nil {
+		return fmt.Errorf("error retrieving photo: %w", err)
+	}
+	if image == nil {
+		return apperrors.ErrDisplayOrderNotOccupied
+	}
+	if image.ObjectKey != objectKey {
+		return apperrors.ErrReviewedPhotoChanged
+	}
+	if image.Status != constants.PhotoStatusPendingReview {
+		return apperrors.ErrPhotoNotPendingReview
+	}
+
+	status := constants.PhotoStatusApproved
+	var rejectionReason *string
+	if !approve {
+		status = constants.PhotoStatusRejected
+		rejectionReason = &reason
+	}
+	updated, err := u.userImageRepository.UpdateUserImageReview(ctx, userID, displayOrder, objectKey, status, rejectionReason)
+	if err != nil {
+		return fmt.Errorf("failed to review photo: %w", err)
+	}
+	if !updated {
+		return apperrors.ErrReviewedPhotoChanged
+	}
+	if approve {
+		return nil
+	}
+
+	// The rejection is recorded before the row is soft deleted, so it is kept
+	// for the record only. The photo itself is removed and the slot freed for
+	// a new upload.
+	return u.userProfileUsecase.DeleteAdditionalPhoto(ctx, userID, displayOrder)
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pagination"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+type ModerationUsecase interface {
+	// USER REPORTS
+	ReportProfile(ctx context.Context,
+		reporterID uuid.UUID,
+		req entity.CreateReportRequest) (int64, error)
+	CreateMessageReport(ctx context.Context,
+		reporterID, senderID uuid.UUID,
+		messageID, messageContent, category, description string) error
+
+	// ADMIN MODERATION QUEUE
+	GetReports(ctx context.Context,
+		status string,
+		limit, offset int) ([]*entity.Report, *pagination.PaginationData, error)
+	GetReport(ctx context.Context,
+		reportID int64) (*entity.Report, error)
+	ResolveReport(ctx context.Context,
+		adminID uuid.UUID,
+		reportID int64,
+		action, adminNote string) (*entity.Report, error)
+
+	// ADMIN PHOTO REVIEW
+	GetPendingPhotos(ctx context.Context,
+		limit, offset int) ([]*entity.PendingPhotoResponse, *pagination.PaginationData, error)
+	ReviewPhoto(ctx context.Context,
+		targetProfileID int64,
+		displayOrder int32,
+		objectKey string,
+		approve bool,
+		reason string) error
+
+	// ADMIN PROFILE HISTORY
+	GetProfileHistory(ctx context.Context,
+		req entity.GetProfileHistoryRequest) ([]*entity.ProfileChange, *pagination.PaginationData, error)
+}
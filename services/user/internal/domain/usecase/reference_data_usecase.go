@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+type ReferenceDataUsecase interface {
+	// PUBLIC OPTION LISTS
+	GetReferenceOptions(ctx context.Context,
+		taxonomy string,
+		includeInactive bool) ([]*entity.ReferenceOption, error)
+
+	// ADMIN MANAGEMENT
+	CreateReferenceOption(ctx context.Context,
+		req entity.CreateReferenceOptionRequest) (*entity.ReferenceOption, error)
+	UpdateReferenceOption(ctx context.Context,
+		optionID int64,
+		req entity.UpdateReferenceOptionRequest) (*entity.ReferenceOption, error)
+	DeleteReferenceOption(ctx context.Context, optionID int64) error
+
+	// VALIDATION CACHE
+	RefreshValidationCache(ctx context.Context) error
+}
@@ -0,0 +1,56 @@
+package referencedata
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func (u *referenceDataUsecase) CreateReferenceOption(
+	ctx context.Context,
+	req entity.CreateReferenceOptionRequest) (*entity.ReferenceOption, error) {
+
+	if !validation.IsValidTaxonomy(req.Taxonomy) {
+		return nil, apperrors.ErrInvalidTaxonomy
+	}
+	value := strings.TrimSpace(req.Value)
+	if !validation.IsValidTaxonomyValue(value) {
+		return nil, apperrors.ErrInvalidReferenceOptionValue
+	}
+	if !validation.IsValidTaxonomyLabel(req.Label) {
+		return nil, apperrors.ErrInvalidReferenceOptionLabel
+	}
+
+	existing, err := u.referenceOptionRepository.GetReferenceOption(ctx, req.Taxonomy, value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reference option: %w", err)
+	}
+	if existing != nil {
+		return nil, apperrors.ErrReferenceOptionAlreadyExists
+	}
+
+	now := time.Now().UTC()
+	option := &entity.ReferenceOption{
+		Taxonomy:     req.Taxonomy,
+		Value:        value,
+		Label:        strings.TrimSpace(req.Label),
+		DisplayOrder: req.DisplayOrder,
+		IsActive:     true,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := u.referenceOptionRepository.CreateReferenceOption(ctx, option); err != nil {
+		return nil, fmt.Errorf("failed to create reference option: %w", err)
+	}
+
+	if err := u.RefreshValidationCache(ctx); err != nil {
+		return nil, err
+	}
+
+	return option, nil
+}
@@ -0,0 +1,26 @@
+package referencedata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+)
+
+// DeleteReferenceOption removes an option. Profiles that already use the
+// value keep it, it is only rejected on new input.
+func (u *referenceDataUsecase) DeleteReferenceOption(ctx context.Context, optionID int64) error {
+	option, err := u.referenceOptionRepository.GetReferenceOptionByID(ctx, optionID)
+	if err != nil {
+		return fmt.Errorf("failed to get reference option: %w", err)
+	}
+	if option == nil {
+		return apperrors.ErrReferenceOptionNotFound
+	}
+
+	if err := u.referenceOptionRepository.DeleteReferenceOption(ctx, optionID); err != nil {
+		return fmt.Errorf("failed to delete reference option: %w", err)
+	}
+
+	return u.RefreshValidationCache(ctx)
+}
@@ -0,0 +1,23 @@
+package referencedata
+
+import (
+	"context"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+// GetReferenceOptions lists the options of a taxonomy, or of every taxonomy
+// when taxonomy is empty. Inactive options are only listed for admins.
+func (u *referenceDataUsecase) GetReferenceOptions(
+	ctx context.Context,
+	taxonomy string,
+	includeInactive bool) ([]*entity.ReferenceOption, error) {
+
+	if taxonomy != "" && !validation.IsValidTaxonomy(taxonomy) {
+		return nil, apperrors.ErrInvalidTaxonomy
+	}
+
+	return u.referenceOptionRepository.ListReferenceOptions(ctx, taxonomy, includeInactive)
+}
@@ -0,0 +1,18 @@
+package referencedata
+
+import (
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/usecase"
+)
+
+type referenceDataUsecase struct {
+	referenceOptionRepository repository.ReferenceOptionRepository
+}
+
+func NewReferenceDataUsecase(
+	referenceOptionRepository repository.ReferenceOptionRepository,
+) usecase.ReferenceDataUsecase {
+	return &referenceDataUsecase{
+		referenceOptionRepository: referenceOptionRepository,
+	}
+}
@@ -0,0 +1,29 @@
+package referencedata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+)
+
+// RefreshValidationCache loads the stored options into the validation
+// package, so new values are accepted and removed ones rejected without a
+// release.
+func (u *referenceDataUsecase) RefreshValidationCache(ctx context.Context) error {
+	options, err := u.referenceOptionRepository.ListReferenceOptions(ctx, "", true)
+	if err != nil {
+		return fmt.Errorf("failed to list reference options: %w", err)
+	}
+
+	taxonomyOptions := make([]validation.TaxonomyOption, len(options))
+	for i, option := range options {
+		taxonomyOptions[i] = validation.TaxonomyOption{
+			Taxonomy: validation.Taxonomy(option.Taxonomy),
+			Value:    option.Value,
+			IsActive: option.IsActive,
+		}
+	}
+	validation.LoadTaxonomyOptions(taxonomyOptions)
+	return nil
+}
@@ -0,0 +1,52 @@
+package referencedata
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+// UpdateReferenceOption changes the label, display order or active flag of
+// an option. The value is never changed, profiles and preferences store it.
+func (u *referenceDataUsecase) UpdateReferenceOption(
+	ctx context.Context,
+	optionID int64,
+	req entity.UpdateReferenceOptionRequest) (*entity.ReferenceOption, error) {
+
+	option, err := u.referenceOptionRepository.GetReferenceOptionByID(ctx, optionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reference option: %w", err)
+	}
+	if option == nil {
+		return nil, apperrors.ErrReferenceOptionNotFound
+	}
+
+	if req.Label != nil {
+		if !validation.IsValidTaxonomyLabel(*req.Label) {
+			return nil, apperrors.ErrInvalidReferenceOptionLabel
+		}
+		option.Label = strings.TrimSpace(*req.Label)
+	}
+	if req.DisplayOrder != nil {
+		option.DisplayOrder = *req.DisplayOrder
+	}
+	if req.IsActive != nil {
+		option.IsActive = *req.IsActive
+	}
+	option.UpdatedAt = time.Now().UTC()
+
+	if err := u.referenceOptionRepository.UpdateReferenceOption(ctx, option); err != nil {
+		return nil, fmt.Errorf("failed to update reference option: %w", err)
+	}
+
+	if err := u.RefreshValidationCache(ctx); err != nil {
+		return nil, err
+	}
+
+	return option, nil
+}
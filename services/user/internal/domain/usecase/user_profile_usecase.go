@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pagination"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
 	mediastorage "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/mediastorage"
 )
@@ -20,6 +22,8 @@ type UserProfileUsecase interface {
 		req entity.UpdateUserProfileRequest) error
 	GetUserProfile(ctx context.Context,
 		 userID uuid.UUID) (*entity.UserProfileResponse, error)
+	GetProfileViewStats(ctx context.Context,
+		userID uuid.UUID) (*entity.ProfileViewStats, error)
 	GetProfilePhotoUploadURL(ctx context.Context,
 		userID uuid.UUID,
 		contentType string) (*mediastorage.PhotoUploadURLResponse, error)
@@ -39,20 +43,36 @@ type UserProfileUsecase interface {
 	// ADDITIONAL PHOTOS MANAGEMENT
 	GetAdditionalPhotoUploadURL(ctx context.Context,
 		userID uuid.UUID,
+		role string,
 		displayOrder int32,
 		contentType string) (*mediastorage.PhotoUploadURLResponse, error)
 	ConfirmAdditionalPhotoUpload(ctx context.Context,
 		userID uuid.UUID,
+		role string,
 		objectKey string) (string, error)
 	DeleteAdditionalPhoto(ctx context.Context,
 		userID uuid.UUID,
 		displayOrder int32) error
 	GetAdditionalPhotos(ctx context.Context, 
 		userID uuid.UUID) ([]string, error)
+	ReorderAdditionalPhotos(ctx context.Context,
+		userID uuid.UUID,
+		role string,
+		displayOrders []int32) error
+	SetPrimaryPhoto(ctx context.Context,
+		userID uuid.UUID,
+		displayOrder int32) error
+	CleanupOrphanedPhotos(ctx context.Context,
+		olderThan time.Duration) (int, error)
 
 	// USER DETAILS MANAGEMENT
 	GetUserDetailsByProfileID(ctx context.Context,
 		requesterUserID uuid.UUID,
 		targetProfileID int64,
 		requestedByAdmin bool) (*entity.UserProfileResponse, *entity.PartnerPreference, []string, error)
+
+	// PROFILE VIEWS
+	GetProfileVisitors(ctx context.Context,
+		userID uuid.UUID,
+		limit, offset int) ([]*entity.ProfileVisitorResponse, *pagination.PaginationData, error)
 }
@@ -0,0 +1,97 @@
+package userprofile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/gcsutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/mediastorage"
+)
+
+// orphanedPhotoBatchSize is how many listed uploads are checked against the
+// database per query, well below the Postgres bind parameter limit.
+const orphanedPhotoBatchSize = 1000
+
+// CleanupOrphanedPhotos deletes uploads that were never confirmed. A client
+// can request an upload URL and upload without calling confirm, leaving an
+// object no profile refers to. Recent uploads are kept since the confirm call
+// may still be on its way.
+func (u *userProfileUsecase) CleanupOrphanedPhotos(
+	ctx context.Context,
+	olderThan time.Duration) (int, error) {
+
+	cutoff := time.Now().Add(-olderThan)
+
+	deleted := 0
+	for _, prefix := range []string{
+		constants.ProfilePhotoStorageDirectory + "/",
+		constants.AdditionalPhotoStorageDirectory + "/",
+	} {
+		err := u.photoStorage.ListPhotos(ctx, prefix, orphanedPhotoBatchSize, func(photos []mediastorage.PhotoMetadata) error {
+			n, err := u.deleteOrphanedPhotos(ctx, uploadedPhotoKeys(photos, cutoff))
+			deleted += n
+			return err
+		})
+		if err != nil {
+			return deleted, fmt.Errorf("failed to clean up photos under %s: %w", prefix, err)
+		}
+	}
+
+	return deleted, nil
+}
+
+// deleteOrphanedPhotos deletes the uploads in keys that no profile refers to.
+func (u *userProfileUsecase) deleteOrphanedPhotos(
+	ctx context.Context,
+	keys []string) (int, error) {
+
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	// Making an additional photo primary moves its key to the profile and the
+	// other way round, so a key is in use if either table refers to it.
+	referencedProfileKeys, err := u.userProfileRepository.GetReferencedProfileImageKeys(ctx, keys)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get referenced profile photo keys: %w", err)
+	}
+	referencedAdditionalKeys, err := u.userImageRepository.GetReferencedObjectKeys(ctx, keys)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get referenced additional photo keys: %w", err)
+	}
+
+	referenced := make(map[string]bool, len(referencedProfileKeys)+len(referencedAdditionalKeys))
+	for _, key := range referencedProfileKeys {
+		referenced[key] = true
+	}
+	for _, key := range referencedAdditionalKeys {
+		referenced[key] = true
+	}
+
+	deleted := 0
+	for _, key := range keys {
+		if referenced[key] {
+			continue
+		}
+		if err := u.deletePhotoObjects(ctx, key); err != nil {
+			return deleted, fmt.Errorf("failed to delete orphaned photo %s: %w", key, err)
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// uploadedPhotoKeys returns keys of uploads created before cutoff. Derived
+// sizes are skipped, they are deleted along with their upload.
+func uploadedPhotoKeys(photos []mediastorage.PhotoMetadata, cutoff time.Time) []string {
+	keys := make([]string, 0, len(photos))
+	for _, photo := range photos {
+		if gcsutil.IsSizedObjectKey(photo.ObjectKey) || photo.CreatedAt.After(cutoff) {
+			continue
+		}
+		keys = append(keys, photo.ObjectKey)
+	}
+	return keys
+}
@@ -6,12 +6,16 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/gcsutil"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 func (u *userProfileUsecase) ConfirmAdditionalPhotoUpload(ctx context.Context,
 	userID uuid.UUID,
+	role string,
 	objectKey string,
 	) (string, error) {
 
@@ -25,18 +29,59 @@ func (u *userProfileUsecase) ConfirmAdditionalPhotoUpload(ctx context.Context,
 
 	displayOrder, err := gcsutil.ExtractDisplayOrder(objectKey)
 	if err != nil {
-		return "", fmt.Errorf("failed to extract display order: %w", err)
+		return "", apperrors.ErrPhotoNotOwned
+	}
+	if displayOrder < 1 || displayOrder > constants.MaxAdditionalPhotoSlots {
+		return "", apperrors.ErrInvalidDisplayOrder
+	}
+	// The upload URL is checked against the plan too, the plan can change
+	// before the upload is confirmed
+	if displayOrder > u.photoSlotsForRole(role) {
+		return "", apperrors.ErrPhotoSlotNotAvailable
+	}
+
+	if err := u.validateUploadedPhoto(ctx, objectKey, gcsutil.AdditionalPhotoKeyPrefix(userID.String(), displayOrder)); err != nil {
+		return "", err
+	}
+	if err := u.processUploadedPhoto(ctx, objectKey); err != nil {
+		return "", err
+	}
+
+	existingImage, err := u.userImageRepository.GetUserImage(ctx, userID, displayOrder)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user image: %w", err)
 	}
 
-	err = u.userImageRepository.CreateUserImage(ctx, &entity.UserImage{
+	userImage := &entity.UserImage{
 		UserID:       userID,
 		ObjectKey:    objectKey,
 		DisplayOrder: int16(displayOrder),
-	})
-	if err != nil {
-		return "", fmt.Errorf("failed to create user image: %w", err)
+		Processed:    true,
+		Status:       constants.PhotoStatusPendingReview,
 	}
 
+	if existingImage == nil {
+		if err := u.userImageRepository.CreateUserImage(ctx, userImage); err != nil {
+			return "", fmt.Errorf("failed to create user image: %w", err)
+		}
+	} else {
+		// Uploading into an occupied slot replaces the photo in it
+		if err := u.transactionManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+			return u.userImageRepository.ReplaceUserImageTx(ctx, tx, existingImage.ID, userImage)
+		}); err != nil {
+			return "", fmt.Errorf("failed to replace user image: %w", err)
+		}
+		if existingImage.ObjectKey != objectKey {
+			if err := u.deletePhotoObjects(ctx, existingImage.ObjectKey); err != nil {
+				u.logger.Warn("failed to delete replaced photo",
+					zap.String("object_key", existingImage.ObjectKey),
+					zap.Error(err))
+			}
+		}
+	}
+
+	u.publishProfileUpdated(ctx, profile)
+
 	downloadURL, err := u.photoStorage.GetDownloadURL(ctx, objectKey, u.config.MediaStorage.URLExpiry)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate download URL: %w", err)
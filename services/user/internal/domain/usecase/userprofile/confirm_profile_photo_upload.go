@@ -3,9 +3,13 @@ package userprofile
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/gcsutil"
+	"go.uber.org/zap"
 )
 
 func (u *userProfileUsecase) ConfirmProfilePhotoUpload(
@@ -22,12 +26,37 @@ func (u *userProfileUsecase) ConfirmProfilePhotoUpload(
 		return "", apperrors.ErrUserNotFound
 	}
 
+	if err := u.validateUploadedPhoto(ctx, objectKey, gcsutil.ProfilePhotoKeyPrefix(userID.String())); err != nil {
+		return "", err
+	}
+	if err := u.processUploadedPhoto(ctx, objectKey); err != nil {
+		return "", err
+	}
+
+	previousImageKey := profile.ProfileImageKey
+
+	// Owner sees the new photo right away, others only after admin approval
 	profile.ProfileImageKey = objectKey
+	profile.ProfileImageStatus = constants.PhotoStatusPendingReview
+	profile.ProfileImageProcessed = true
+	uploadedAt := time.Now().UTC()
+	profile.ProfileImageUploadedAt = &uploadedAt
 	if err := u.userProfileRepository.UpdateUserProfile(ctx, profile); err != nil {
 		return "", fmt.Errorf("failed to update profile picture URL: %w", err)
 	}
 
-	downloadURL, err := u.photoStorage.GetDownloadURL(ctx, objectKey, u.config.MediaStorage.URLExpiry)
+	// Uploads have unique keys, so the replaced photo is still in storage
+	if previousImageKey != "" && previousImageKey != objectKey {
+		if err := u.deletePhotoObjects(ctx, previousImageKey); err != nil {
+			u.logger.Warn("failed to delete replaced profile photo",
+				zap.String("object_key", previousImageKey),
+				zap.Error(err))
+		}
+	}
+
+	u.publishProfileUpdated(ctx, profile)
+
+	downloadURL, err := u.photoStorage.GetDownloadURL(ctx, profile.ProfileImageKeyForSize(constants.ImageSizeMedium), u.config.MediaStorage.URLExpiry)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate download URL: %w", err)
 	}
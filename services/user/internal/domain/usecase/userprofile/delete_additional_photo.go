@@ -20,7 +20,7 @@ func (u *userProfileUsecase) DeleteAdditionalPhoto(
 		return apperrors.ErrDisplayOrderNotOccupied
 	}
 
-	err = u.photoStorage.DeletePhoto(ctx, userImage.ObjectKey)
+	err = u.deletePhotoObjects(ctx, userImage.ObjectKey)
 	if err != nil {
 		return err
 	}
@@ -30,5 +30,7 @@ func (u *userProfileUsecase) DeleteAdditionalPhoto(
 		return err
 	}
 
+	u.publishPhotosUpdated(ctx, userID)
+
 	return nil
 }
@@ -23,14 +23,18 @@ func (u *userProfileUsecase) DeleteProfilePhoto(
 		return apperrors.ErrProfilePhotoNotFound
 	}
 
-	if err := u.photoStorage.DeletePhoto(ctx, profile.ProfileImageKey); err != nil {
+	if err := u.deletePhotoObjects(ctx, profile.ProfileImageKey); err != nil {
 		return err
 	}
 
 	profile.ProfileImageKey = ""
+	profile.ProfileImageProcessed = false
+	profile.ProfileImageUploadedAt = nil
 	if err := u.userProfileRepository.UpdateUserProfile(ctx, profile); err != nil {
 		return err
 	}
 
+	u.publishProfileUpdated(ctx, profile)
+
 	return nil
 }
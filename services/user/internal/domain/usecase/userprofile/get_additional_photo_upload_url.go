@@ -5,14 +5,22 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	mediastorage "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/mediastorage"
 )
 
 func (u *userProfileUsecase) GetAdditionalPhotoUploadURL(ctx context.Context,
 	userID uuid.UUID,
+	role string,
 	displayOrder int32,
 	contentType string) (*mediastorage.PhotoUploadURLResponse, error) {
 
+	if displayOrder < 1 || displayOrder > constants.MaxAdditionalPhotoSlots {
+		return nil, apperrors.ErrInvalidDisplayOrder
+	}
+	if displayOrder > u.photoSlotsForRole(role) {
+		return nil, apperrors.ErrPhotoSlotNotAvailable
+	}
 
 	exists, err := u.userProfileRepository.ProfileExists(ctx, userID)
 	if err != nil {
@@ -22,14 +30,7 @@ func (u *userProfileUsecase) GetAdditionalPhotoUploadURL(ctx context.Context,
 		return nil, apperrors.ErrUserNotFound
 	}
 
-	occupied, err := u.userImageRepository.DisplayOrderOccupied(ctx, userID, displayOrder)
-	if err != nil {
-		return nil, err
-	}
-	if occupied {
-		return nil, apperrors.ErrImageDisplayOrderOccupied
-	}
-
+	// An occupied slot is not an error, confirming the upload replaces the photo
 	response, err := u.photoStorage.GetAdditionalPhotoUploadURL(ctx,
 		userID.String(),
 		displayOrder,
@@ -40,3 +41,15 @@ func (u *userProfileUsecase) GetAdditionalPhotoUploadURL(ctx context.Context,
 	}
 	return response, nil
 }
+
+// photoSlotsForRole returns how many additional photo slots the plan allows.
+func (u *userProfileUsecase) photoSlotsForRole(role string) int32 {
+	slots := u.config.PhotoSlots.Free
+	if role == constants.RolePremiumUser {
+		slots = u.config.PhotoSlots.Premium
+	}
+	if slots > constants.MaxAdditionalPhotoSlots {
+		slots = constants.MaxAdditionalPhotoSlots
+	}
+	return int32(slots)
+}
@@ -5,13 +5,24 @@ import (
     "fmt"
 
     "github.com/google/uuid"
+    "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 )
 
+// GetAdditionalPhotos is used by the owner, so photos pending review are included.
 func (u *userProfileUsecase) GetAdditionalPhotos(
     ctx context.Context,
     userID uuid.UUID,
 ) ([]string, error) {
 
+    return u.listAdditionalPhotoURLs(ctx, userID, false)
+}
+
+func (u *userProfileUsecase) listAdditionalPhotoURLs(
+    ctx context.Context,
+    userID uuid.UUID,
+    approvedOnly bool,
+) ([]string, error) {
+
     images, err := u.userImageRepository.ListUserImages(ctx, userID)
     if err != nil {
         return nil, fmt.Errorf("failed to list user images: %w", err)
@@ -19,11 +30,14 @@ func (u *userProfileUsecase) GetAdditionalPhotos(
 
     urls := make([]string, 0, len(images))
     for _, img := range images {
-        url, err := u.photoStorage.GetDownloadURL(ctx, img.ObjectKey, u.config.MediaStorage.URLExpiry)
+        if approvedOnly && !img.IsApproved() {
+            continue
+        }
+        url, err := u.photoStorage.GetDownloadURL(ctx, img.KeyForSize(constants.ImageSizeFull), u.config.MediaStorage.URLExpiry)
         if err != nil {
             return nil, fmt.Errorf("failed to generate download URL: %w", err)
         }
         urls = append(urls, url)
     }
     return urls, nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,33 @@
+package userprofile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func (u *userProfileUsecase) GetProfileViewStats(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*entity.ProfileViewStats, error) {
+
+	now := time.Now().UTC()
+
+	last7Days, err := u.profileViewRepository.CountProfileViewsSince(ctx, userID, now.AddDate(0, 0, -6))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count profile views for last 7 days: %w", err)
+	}
+
+	last30Days, err := u.profileViewRepository.CountProfileViewsSince(ctx, userID, now.AddDate(0, 0, -29))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count profile views for last 30 days: %w", err)
+	}
+
+	return &entity.ProfileViewStats{
+		ViewsLast7Days:  last7Days,
+		ViewsLast30Days: last30Days,
+	}, nil
+}
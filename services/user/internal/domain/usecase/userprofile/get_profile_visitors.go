@@ -0,0 +1,84 @@
+package userprofile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/ageutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pagination"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func (u *userProfileUsecase) GetProfileVisitors(
+	ctx context.Context,
+	userID uuid.UUID,
+	limit, offset int,
+) ([]*entity.ProfileVisitorResponse, *pagination.PaginationData, error) {
+
+	if limit <= 0 {
+		limit = constants.DefaultPaginationLimit
+	}
+	if limit > constants.MaxPaginationLimit {
+		limit = constants.MaxPaginationLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Visitors on either side of a block are not shown
+	blockedIDs, err := u.userBlockRepository.GetBlockedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get blocked user IDs: %w", err)
+	}
+
+	visitors, totalCount, err := u.profileViewRepository.GetProfileVisitors(ctx, userID, blockedIDs, limit, offset)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get profile visitors: %w", err)
+	}
+
+	responses := make([]*entity.ProfileVisitorResponse, 0, len(visitors))
+	for _, visitor := range visitors {
+		profile, err := u.userProfileRepository.GetProfileByUserID(ctx, visitor.ViewerID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get visitor profile: %w", err)
+		}
+		if profile == nil {
+			continue // visitor has since deleted their account
+		}
+
+		var pictureURL *string
+		if imageKey := profile.PublicProfileImageKey(constants.ImageSizeThumbnail); imageKey != "" {
+			url, err := u.photoStorage.GetDownloadURL(ctx, imageKey, u.config.MediaStorage.URLExpiry)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get profile image URL: %w", err)
+			}
+			pictureURL = &url
+		}
+
+		responses = append(responses, &entity.ProfileVisitorResponse{
+			Profile: &entity.UserProfileResponse{
+				ID:                profile.ID,
+				FullName:          profile.FullName,
+				ProfilePictureURL: pictureURL,
+				Age:               int32(ageutil.CalculateAge(profile.DateOfBirth)),
+				HeightCm:          int32(profile.HeightCm),
+				MaritalStatus:     string(profile.MaritalStatus),
+				Profession:        string(profile.Profession),
+				HomeDistrict:      string(profile.HomeDistrict),
+				NativePlace:       profile.NativePlaceOrNil(),
+				Residence:         profile.ResidenceOrNil(),
+				IsNRI:             profile.IsNRI(),
+			},
+			LastViewedAt: visitor.LastViewedAt,
+		})
+	}
+
+	return responses, &pagination.PaginationData{
+		TotalCount: totalCount,
+		Limit:      limit,
+		Offset:     offset,
+		HasMore:    int64(offset+limit) < totalCount,
+	}, nil
+}
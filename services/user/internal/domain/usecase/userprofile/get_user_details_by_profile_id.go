@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/ageutil"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
 )
 
 func (u *userProfileUsecase) GetUserDetailsByProfileID(
@@ -25,8 +29,9 @@ func (u *userProfileUsecase) GetUserDetailsByProfileID(
 		return nil, nil, nil, apperrors.ErrInvalidTargetProfileID
 	}
 
+	var requesterProfile *entity.UserProfile
 	if !requestedByAdmin {
-		requesterProfile, err := u.userProfileRepository.GetProfileByUserID(ctx, requesterUserID)
+		requesterProfile, err = u.userProfileRepository.GetProfileByUserID(ctx, requesterUserID)
 		if err != nil {
 			return nil, nil, nil, fmt.Errorf("failed to load requester profile: %w", err)
 		}
@@ -36,12 +41,33 @@ func (u *userProfileUsecase) GetUserDetailsByProfileID(
 		if requesterProfile.IsBride == targetProfile.IsBride {
 			return nil, nil, nil, apperrors.ErrSameGenderProfileAccessDenied
 		}
+
+		isBlocked, err := u.userBlockRepository.IsBlockedEitherWay(ctx, requesterUserID, targetProfile.UserID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to check user block: %w", err)
+		}
+		if isBlocked {
+			return nil, nil, nil, apperrors.ErrProfileBlocked
+		}
+
+		// Users who hide their profile can browse without showing up as visitors
+		if !requesterProfile.IsHidden {
+			if err := u.profileViewRepository.RecordProfileView(ctx, requesterUserID, targetProfile.UserID, time.Now().UTC()); err != nil {
+				// Viewing a profile should not fail because the visit could not be recorded
+				u.logger.Warn("failed to record profile view", zap.Error(err))
+			}
+		}
 	}
 
 	age := ageutil.CalculateAge(targetProfile.DateOfBirth)
 	var pictureURL *string
-	if targetProfile.ProfileImageKey != "" {
-		url, err := u.photoStorage.GetDownloadURL(ctx, targetProfile.ProfileImageKey, u.config.MediaStorage.URLExpiry)
+	// Admins review photos, so they also see the ones still pending
+	imageKey := targetProfile.PublicProfileImageKey(constants.ImageSizeMedium)
+	if requestedByAdmin {
+		imageKey = targetProfile.ProfileImageKeyForSize(constants.ImageSizeMedium)
+	}
+	if imageKey != "" {
+		url, err := u.photoStorage.GetDownloadURL(ctx, imageKey, u.config.MediaStorage.URLExpiry)
 		if err != nil {
 			return nil, nil, nil, fmt.Errorf("failed to get profile image URL: %w", err)
 		}
@@ -56,6 +82,13 @@ func (u *userProfileUsecase) GetUserDetailsByProfileID(
 		MaritalStatus:     string(targetProfile.MaritalStatus),
 		Profession:        string(targetProfile.Profession),
 		HomeDistrict:      string(targetProfile.HomeDistrict),
+		NativePlace:       targetProfile.NativePlaceOrNil(),
+		Residence:         targetProfile.ResidenceOrNil(),
+		IsNRI:             targetProfile.IsNRI(),
+		Horoscope:         targetProfile.PublicHoroscope(),
+	}
+	if requesterProfile != nil {
+		profileResp.Porutham = entity.NewPoruthamCompatibility(requesterProfile, targetProfile)
 	}
 
 	prefs, err := u.partnerPreferencesRepository.GetPartnerPreferencesByUserProfileID(ctx, targetProfile.ID)
@@ -65,7 +98,7 @@ func (u *userProfileUsecase) GetUserDetailsByProfileID(
 	log.Println("prefs", prefs)
 	// Note: prefs may be nil; this is allowed and not an error.
 
-	photos, err := u.GetAdditionalPhotos(ctx, targetProfile.UserID)
+	photos, err := u.listAdditionalPhotoURLs(ctx, targetProfile.UserID, !requestedByAdmin)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("failed to get additional photos: %w", err)
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/ageutil"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
 )
@@ -27,7 +28,7 @@ func (u *userProfileUsecase) GetUserProfile(
 	// Generate picture URL only if an image exists
 	var pictureURL *string
 	if profile.ProfileImageKey != "" {
-		url, err := u.photoStorage.GetDownloadURL(ctx, profile.ProfileImageKey, u.config.MediaStorage.URLExpiry)
+		url, err := u.photoStorage.GetDownloadURL(ctx, profile.ProfileImageKeyForSize(constants.ImageSizeMedium), u.config.MediaStorage.URLExpiry)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get profile image URL for user %s: %w", userID, err)
 		}
@@ -43,5 +44,11 @@ func (u *userProfileUsecase) GetUserProfile(
 		MaritalStatus:     string(profile.MaritalStatus),
 		Profession:        string(profile.Profession),
 		HomeDistrict:      string(profile.HomeDistrict),
+		NativePlace:       profile.NativePlaceOrNil(),
+		Residence:         profile.ResidenceOrNil(),
+		IsNRI:             profile.IsNRI(),
+		Horoscope:         profile.OwnHoroscope(),
+		PreferredLanguage: profile.Locale(),
+		DigestFrequency:   profile.DigestFrequency,
 	}, nil
 }
\ No newline at end of file
@@ -0,0 +1,81 @@
+package userprofile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/gcsutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/imageutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+)
+
+// processedPhotoSizes lists the sizes generated for every uploaded photo.
+// Full is last because it overwrites the original upload, so a failure on the
+// way leaves the original in place.
+var processedPhotoSizes = []struct {
+	name         string
+	maxDimension int
+}{
+	{constants.ImageSizeThumbnail, constants.ImageThumbnailMaxDimension},
+	{constants.ImageSizeMedium, constants.ImageMediumMaxDimension},
+	{constants.ImageSizeFull, constants.ImageFullMaxDimension},
+}
+
+// processUploadedPhoto fetches a photo uploaded directly by the client and
+// re-encodes it in the standard sizes. Re-encoding drops EXIF metadata such as
+// GPS coordinates, so the original upload is replaced by the full size.
+func (u *userProfileUsecase) processUploadedPhoto(ctx context.Context, objectKey string) error {
+	data, err := u.photoStorage.DownloadPhoto(ctx, objectKey)
+	if err != nil {
+		return fmt.Errorf("failed to download uploaded photo: %w", err)
+	}
+
+	// The stored content type is set by the client, check the actual bytes too
+	if !validation.IsValidImageType(http.DetectContentType(data)) {
+		return apperrors.ErrInvalidImageType
+	}
+
+	img, err := imageutil.Decode(data, constants.ImageMaxPixels)
+	if err != nil {
+		if errors.Is(err, imageutil.ErrTooManyPixels) {
+			return apperrors.ErrImageDimensionsTooLarge
+		}
+		return apperrors.ErrInvalidImageType
+	}
+
+	// Each size is scaled down from the next larger one, so the original is
+	// resampled only once
+	encoded := make(map[string][]byte, len(processedPhotoSizes))
+	resized := img
+	for i := len(processedPhotoSizes) - 1; i >= 0; i-- {
+		size := processedPhotoSizes[i]
+		resized = imageutil.Resize(resized, size.maxDimension)
+		encoded[size.name], err = imageutil.EncodeJPEG(resized, constants.ImageJPEGQuality)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s photo: %w", size.name, err)
+		}
+	}
+
+	for _, size := range processedPhotoSizes {
+		sizedKey := gcsutil.SizedObjectKey(objectKey, size.name)
+		if err := u.photoStorage.UploadPhoto(ctx, sizedKey, constants.ProcessedImageContentType, encoded[size.name]); err != nil {
+			return fmt.Errorf("failed to upload %s photo: %w", size.name, err)
+		}
+	}
+
+	return nil
+}
+
+// deletePhotoObjects removes the photo together with its derived sizes.
+func (u *userProfileUsecase) deletePhotoObjects(ctx context.Context, objectKey string) error {
+	for _, size := range processedPhotoSizes {
+		if err := u.photoStorage.DeletePhoto(ctx, gcsutil.SizedObjectKey(objectKey, size.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
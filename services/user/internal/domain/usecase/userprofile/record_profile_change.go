@@ -0,0 +1,105 @@
+package userprofile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+// Fields that rarely change honestly, repeated edits are flagged for admins
+var churnWatchedFields = map[string][]string{
+	constants.ProfileChangeSectionProfile: {"date_of_birth", "marital_status", "is_bride"},
+}
+
+// newProfileChange builds the history entry for an edit of a profile section,
+// it returns nil when nothing changed. The entry is flagged when a watched
+// field has been edited too often within the configured window.
+func (u *userProfileUsecase) newProfileChange(
+	ctx context.Context,
+	profile *entity.UserProfile,
+	section string,
+	changes []entity.FieldChange,
+	actorRole string) (*entity.ProfileChange, error) {
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	if actorRole == "" {
+		actorRole = constants.RoleUser
+	}
+
+	change := &entity.ProfileChange{
+		UserID:        profile.UserID,
+		UserProfileID: profile.ID,
+		Section:       section,
+		Changes:       changes,
+		ActorID:       profile.UserID,
+		ActorRole:     actorRole,
+		FlagReasons:   pq.StringArray{},
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	reasons, err := u.churnFlagReasons(ctx, change)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check profile change churn: %w", err)
+	}
+	if len(reasons) > 0 {
+		change.IsFlagged = true
+		change.FlagReasons = pq.StringArray(reasons)
+	}
+
+	return change, nil
+}
+
+func (u *userProfileUsecase) churnFlagReasons(ctx context.Context, change *entity.ProfileChange) ([]string, error) {
+	window := u.config.ProfileHistory.ChurnWindow
+	threshold := u.config.ProfileHistory.ChurnThreshold
+	if window <= 0 || threshold <= 0 {
+		return nil, nil
+	}
+
+	var touched []string
+	for _, field := range churnWatchedFields[change.Section] {
+		if change.Touches(field) {
+			touched = append(touched, field)
+		}
+	}
+	if len(touched) == 0 {
+		return nil, nil
+	}
+
+	recent, err := u.profileChangeRepository.GetProfileChangesSince(ctx,
+		change.UserProfileID, change.Section, change.CreatedAt.Add(-window))
+	if err != nil {
+		return nil, err
+	}
+
+	var reasons []string
+	for _, field := range touched {
+		count := 1 // this change
+		for _, previous := range recent {
+			if previous.Touches(field) {
+				count++
+			}
+		}
+		if count >= threshold {
+			reasons = append(reasons, fmt.Sprintf("%s changed %d times in %s", field, count, formatChurnWindow(window)))
+		}
+	}
+
+	return reasons, nil
+}
+
+func formatChurnWindow(window time.Duration) string {
+	if days := int(window.Hours() / 24); days > 0 && window%(24*time.Hour) == 0 {
+		if days == 1 {
+			return "1 day"
+		}
+		return fmt.Sprintf("%d days", days)
+	}
+	return window.String()
+}
@@ -0,0 +1,72 @@
+package userprofile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"gorm.io/gorm"
+)
+
+// ReorderAdditionalPhotos rearranges the additional photos. displayOrders
+// lists the current slots in their new order, so it must contain every
+// occupied slot exactly once. Photos end up in slots 1..n with no gaps, and
+// a photo can only be moved into a slot the plan allows.
+func (u *userProfileUsecase) ReorderAdditionalPhotos(
+	ctx context.Context,
+	userID uuid.UUID,
+	role string,
+	displayOrders []int32) error {
+
+	images, err := u.userImageRepository.ListUserImages(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to list user images: %w", err)
+	}
+	if len(images) == 0 {
+		return apperrors.ErrDisplayOrderNotOccupied
+	}
+	if len(displayOrders) != len(images) {
+		return apperrors.ErrInvalidPhotoOrdering
+	}
+
+	imageIDBySlot := make(map[int32]int64, len(images))
+	for _, image := range images {
+		imageIDBySlot[int32(image.DisplayOrder)] = image.ID
+	}
+
+	newDisplayOrders := make(map[int64]int16, len(images))
+	for i, displayOrder := range displayOrders {
+		imageID, ok := imageIDBySlot[displayOrder]
+		if !ok {
+			return apperrors.ErrInvalidPhotoOrdering
+		}
+		if _, seen := newDisplayOrders[imageID]; seen {
+			return apperrors.ErrInvalidPhotoOrdering
+		}
+		newDisplayOrders[imageID] = int16(i + 1)
+	}
+
+	// Only rows that actually move are touched. Photos kept in their slot
+	// after a downgrade stay where they are.
+	slots := u.photoSlotsForRole(role)
+	for _, image := range images {
+		if newDisplayOrders[image.ID] == image.DisplayOrder {
+			delete(newDisplayOrders, image.ID)
+			continue
+		}
+		if int32(newDisplayOrders[image.ID]) > slots {
+			return apperrors.ErrPhotoSlotNotAvailable
+		}
+	}
+
+	if err := u.transactionManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		return u.userImageRepository.ReorderUserImagesTx(ctx, tx, userID, newDisplayOrders)
+	}); err != nil {
+		return fmt.Errorf("failed to reorder user images: %w", err)
+	}
+
+	u.publishPhotosUpdated(ctx, userID)
+
+	return nil
+}
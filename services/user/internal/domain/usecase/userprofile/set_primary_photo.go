@@ -0,0 +1,68 @@
+package userprofile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"gorm.io/gorm"
+)
+
+// SetPrimaryPhoto makes an additional photo the profile photo. The current
+// profile photo takes its slot, or the slot is freed when there is none.
+// Review status moves with the photo, an approved photo stays approved.
+func (u *userProfileUsecase) SetPrimaryPhoto(
+	ctx context.Context,
+	userID uuid.UUID,
+	displayOrder int32) error {
+
+	profile, err := u.userProfileRepository.GetProfileByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user profile: %w", err)
+	}
+	if profile == nil {
+		return apperrors.ErrUserNotFound
+	}
+
+	userImage, err := u.userImageRepository.GetUserImage(ctx, userID, displayOrder)
+	if err != nil {
+		return fmt.Errorf("failed to get user image: %w", err)
+	}
+	if userImage == nil {
+		return apperrors.ErrDisplayOrderNotOccupied
+	}
+
+	if err := u.transactionManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		if err := u.userProfileRepository.UpdateProfileImageTx(ctx, tx,
+			userID,
+			userImage.ObjectKey,
+			userImage.Status,
+			userImage.Processed,
+			userImage.CreatedAt); err != nil {
+			return fmt.Errorf("failed to update profile photo: %w", err)
+		}
+
+		if profile.ProfileImageKey == "" {
+			if err := u.userImageRepository.DeleteUserImageByIDTx(ctx, tx, userImage.ID); err != nil {
+				return fmt.Errorf("failed to delete user image: %w", err)
+			}
+			return nil
+		}
+
+		if err := u.userImageRepository.UpdateUserImagePhotoTx(ctx, tx,
+			userImage.ID,
+			profile.ProfileImageKey,
+			profile.ProfileImageStatus,
+			profile.ProfileImageProcessed); err != nil {
+			return fmt.Errorf("failed to update user image: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	u.publishProfileUpdated(ctx, profile)
+
+	return nil
+}
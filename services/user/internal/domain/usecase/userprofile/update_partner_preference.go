@@ -8,9 +8,11 @@ import (
 	"github.com/google/uuid"
 	appError "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/porutham"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
 	"github.com/lib/pq"
+	"gorm.io/gorm"
 )
 
 func (u *userProfileUsecase) UpdateUserPartnerPreferences(
@@ -37,24 +39,32 @@ func (u *userProfileUsecase) UpdateUserPartnerPreferences(
 		if existingPartnerPreferences != nil {
 			return appError.ErrPartnerPreferencesAlreadyExists
 		}
-		return u.createPartnerPreferences(ctx, existingProfile.ID, req)
+		err = u.createPartnerPreferences(ctx, existingProfile, req)
+	} else {
+		// operation type update, but no record exists
+		if existingPartnerPreferences == nil {
+			return appError.ErrPartnerPreferencesNotFound
+		}
+		err = u.patchPartnerPreferences(ctx, existingProfile, existingPartnerPreferences, req)
 	}
-
-	// operation type update, but no record exists
-	if existingPartnerPreferences == nil {
-		return appError.ErrPartnerPreferencesNotFound
+	if err != nil {
+		return err
 	}
 
-	return u.patchPartnerPreferences(ctx, existingProfile.ID, existingPartnerPreferences, req)
+	// Preferences decide both the user's recommendations and whom the user is
+	// recommended to, they are handled the same as a profile update
+	u.publishProfileUpdated(ctx, existingProfile)
+
+	return nil
 }
 
 func (u *userProfileUsecase) createPartnerPreferences(
 	ctx context.Context,
-	userProfileID int64,
+	profile *entity.UserProfile,
 	req entity.UpdateUserPartnerPreferencesRequest) error {
 
 	preferences := &entity.PartnerPreference{
-		UserProfileID: userProfileID,
+		UserProfileID: profile.ID,
 	}
 
 	if req.AcceptPhysicallyChallenged != nil {
@@ -156,16 +166,71 @@ func (u *userProfileUsecase) createPartnerPreferences(
 	}
 	preferences.PreferredHomeDistricts = pq.StringArray(validation.HomeDistrictsToStrings(homeDistrictsTyped))
 
+	// Countries
+	countriesIn := []string{validation.CountryAny}
+	if req.PreferredCountries != nil && len(*req.PreferredCountries) > 0 {
+		countriesIn = *req.PreferredCountries
+	}
+	countries, err := validation.ParsePreferredCountries(countriesIn)
+	if err != nil {
+		return appError.ErrInvalidCountry
+	}
+	preferences.PreferredCountries = pq.StringArray(countries)
+
+	residenceCountriesIn := []string{validation.CountryAny}
+	if req.PreferredResidenceCountries != nil && len(*req.PreferredResidenceCountries) > 0 {
+		residenceCountriesIn = *req.PreferredResidenceCountries
+	}
+	residenceCountries, err := validation.ParsePreferredCountries(residenceCountriesIn)
+	if err != nil {
+		return appError.ErrInvalidCountry
+	}
+	preferences.PreferredResidenceCountries = pq.StringArray(residenceCountries)
+
+	// Horoscope
+	if req.MinPoruthamScore != nil {
+		if !isValidMinPoruthamScore(*req.MinPoruthamScore) {
+			return appError.ErrInvalidMinPoruthamScore
+		}
+		preferences.MinPoruthamScore = *req.MinPoruthamScore
+	}
+	preferences.AcceptDosham = true
+	if req.AcceptDosham != nil {
+		preferences.AcceptDosham = *req.AcceptDosham
+	}
+
+	// Every dimension is nice-to-have unless marked otherwise
+	preferences.MustHaveCriteria = pq.StringArray{}
+	if req.MustHaveCriteria != nil {
+		criteria, err := parseMustHaveCriteria(*req.MustHaveCriteria)
+		if err != nil {
+			return err
+		}
+		preferences.MustHaveCriteria = criteria
+	}
+
 	now := time.Now().UTC()
 	preferences.CreatedAt = now
 	preferences.UpdatedAt = now
 
-	return u.partnerPreferencesRepository.CreatePartnerPreferences(ctx, preferences)
+	// The first version records every preference as newly set
+	change, err := u.newProfileChange(ctx, profile, constants.ProfileChangeSectionPartnerPreferences,
+		entity.DiffSnapshots(map[string]string{}, preferences.HistorySnapshot()), req.ActorRole)
+	if err != nil {
+		return err
+	}
+
+	return u.transactionManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		if err := u.partnerPreferencesRepository.CreatePartnerPreferencesTx(ctx, tx, preferences); err != nil {
+			return err
+		}
+		return u.profileChangeRepository.CreateProfileChangeTx(ctx, tx, change)
+	})
 }
 
 func (u *userProfileUsecase) patchPartnerPreferences(
 	ctx context.Context,
-	userProfileID int64,
+	profile *entity.UserProfile,
 	existingPartnerPreferences *entity.PartnerPreference,
 	req entity.UpdateUserPartnerPreferencesRequest) error {
 
@@ -268,9 +333,88 @@ func (u *userProfileUsecase) patchPartnerPreferences(
     patch["preferred_home_districts"] = pq.StringArray(validation.HomeDistrictsToStrings(districtsTyped))
 	}
 
+	if req.PreferredCountries != nil && len(*req.PreferredCountries) > 0 {
+		countries, err := validation.ParsePreferredCountries(*req.PreferredCountries)
+		if err != nil {
+			return appError.ErrInvalidCountry
+		}
+		patch["preferred_countries"] = pq.StringArray(countries)
+	}
+
+	if req.PreferredResidenceCountries != nil && len(*req.PreferredResidenceCountries) > 0 {
+		residenceCountries, err := validation.ParsePreferredCountries(*req.PreferredResidenceCountries)
+		if err != nil {
+			return appError.ErrInvalidCountry
+		}
+		patch["preferred_residence_countries"] = pq.StringArray(residenceCountries)
+	}
+
+	if req.MinPoruthamScore != nil {
+		if !isValidMinPoruthamScore(*req.MinPoruthamScore) {
+			return appError.ErrInvalidMinPoruthamScore
+		}
+		patch["min_porutham_score"] = *req.MinPoruthamScore
+	}
+
+	if req.AcceptDosham != nil {
+		patch["accept_dosham"] = *req.AcceptDosham
+	}
+
+	// An empty list turns every dimension back into a nice-to-have
+	if req.MustHaveCriteria != nil {
+		criteria, err := parseMustHaveCriteria(*req.MustHaveCriteria)
+		if err != nil {
+			return err
+		}
+		patch["must_have_criteria"] = criteria
+	}
+
 	if len(patch) > 0 {
 		patch["updated_at"] = time.Now().UTC()
 	}
 
-	return u.partnerPreferencesRepository.PatchPartnerPreferences(ctx, userProfileID, patch)
+	before := existingPartnerPreferences.HistorySnapshot()
+	after := existingPartnerPreferences.HistorySnapshot()
+	for column, value := range patch {
+		if _, tracked := after[column]; tracked {
+			after[column] = entity.HistoryValue(value)
+		}
+	}
+	change, err := u.newProfileChange(ctx, profile, constants.ProfileChangeSectionPartnerPreferences,
+		entity.DiffSnapshots(before, after), req.ActorRole)
+	if err != nil {
+		return err
+	}
+
+	return u.transactionManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		if err := u.partnerPreferencesRepository.PatchPartnerPreferencesTx(ctx, tx, profile.ID, patch); err != nil {
+			return err
+		}
+		if change == nil {
+			return nil
+		}
+		return u.profileChangeRepository.CreateProfileChangeTx(ctx, tx, change)
+	})
+}
+
+func isValidMinPoruthamScore(score int16) bool {
+	return score >= 0 && score <= porutham.MaxScore
+}
+
+// parseMustHaveCriteria validates the criteria and drops duplicates, keeping
+// the order they were given in.
+func parseMustHaveCriteria(in []string) (pq.StringArray, error) {
+	criteria := make(pq.StringArray, 0, len(in))
+	seen := make(map[string]struct{}, len(in))
+	for _, criterion := range in {
+		if !validation.IsValidMatchCriterion(criterion) {
+			return nil, appError.ErrInvalidMatchCriterion
+		}
+		if _, ok := seen[criterion]; ok {
+			continue
+		}
+		seen[criterion] = struct{}{}
+		criteria = append(criteria, criterion)
+	}
+	return criteria, nil
 }
@@ -3,14 +3,19 @@ package userprofile
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	appError "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	userevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/user"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/i18n"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/dateutil"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 func (u *userProfileUsecase) UpdateUserProfile(
@@ -26,6 +31,7 @@ func (u *userProfileUsecase) UpdateUserProfile(
 	if existingProfile == nil {
 		return appError.ErrUserProfileNotFound
 	}
+	before := existingProfile.HistorySnapshot()
 
 	if req.IsBride != nil {
 		existingProfile.IsBride = *req.IsBride
@@ -47,36 +53,38 @@ func (u *userProfileUsecase) UpdateUserProfile(
 		existingProfile.PhysicallyChallenged = *req.PhysicallyChallenged
 	}
 
+	// A deactivated option stays valid for the profiles that already hold it,
+	// so resending the current value does not fail the update
 	if req.Community != nil {
-		if !validation.IsValidCommunity(*req.Community) {
+		if *req.Community != string(existingProfile.Community) && !validation.IsValidCommunity(*req.Community) {
 			return appError.ErrInvalidCommunity
 		}
 		existingProfile.Community = validation.Community(*req.Community)
 	}
 
 	if req.MaritalStatus != nil {
-		if !validation.IsValidMaritalStatus(*req.MaritalStatus) {
+		if *req.MaritalStatus != string(existingProfile.MaritalStatus) && !validation.IsValidMaritalStatus(*req.MaritalStatus) {
 			return appError.ErrInvalidMaritalStatus
 		}
 		existingProfile.MaritalStatus = validation.MaritalStatus(*req.MaritalStatus)
 	}
 
 	if req.Profession != nil {
-		if !validation.IsValidProfession(*req.Profession) {
+		if *req.Profession != string(existingProfile.Profession) && !validation.IsValidProfession(*req.Profession) {
 			return appError.ErrInvalidProfession
 		}
 		existingProfile.Profession = validation.Profession(*req.Profession)
 	}
 
 	if req.ProfessionType != nil {
-		if !validation.IsValidProfessionType(*req.ProfessionType) {
+		if *req.ProfessionType != string(existingProfile.ProfessionType) && !validation.IsValidProfessionType(*req.ProfessionType) {
 			return appError.ErrInvalidProfessionType
 		}
 		existingProfile.ProfessionType = validation.ProfessionType(*req.ProfessionType)
 	}
 
 	if req.HighestEducationLevel != nil {
-		if !validation.IsValidEducationLevel(*req.HighestEducationLevel) {
+		if *req.HighestEducationLevel != string(existingProfile.HighestEducationLevel) && !validation.IsValidEducationLevel(*req.HighestEducationLevel) {
 			return appError.ErrInvalidEducationLevel
 		}
 		existingProfile.HighestEducationLevel = validation.EducationLevel(*req.HighestEducationLevel)
@@ -86,33 +94,206 @@ func (u *userProfileUsecase) UpdateUserProfile(
 		if !validation.IsValidHomeDistrict(*req.HomeDistrict) {
 			return appError.ErrInvalidHomeDistrict
 		}
-		existingProfile.HomeDistrict = validation.HomeDistrict(*req.HomeDistrict)
+		existingProfile.SetHomeDistrict(validation.HomeDistrict(*req.HomeDistrict))
 	}
 
+	if err := applyLocationUpdate(existingProfile, req); err != nil {
+		return err
+	}
+
+	if req.IsHidden != nil {
+		existingProfile.IsHidden = *req.IsHidden
+	}
+
+	if err := applyLanguageUpdate(existingProfile, req); err != nil {
+		return err
+	}
+
+	if req.DigestFrequency != nil {
+		if !validation.IsValidDigestFrequency(*req.DigestFrequency) {
+			return appError.ErrInvalidDigestFrequency
+		}
+		existingProfile.DigestFrequency = *req.DigestFrequency
+	}
+
+	if err := applyHoroscopeUpdate(existingProfile, req); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	if !existingProfile.ProfileCompleted {
+		existingProfile.CompletedAt = &now
+	}
 	existingProfile.ProfileCompleted = true // when the first update happens , the user have self verified the profile
 	// because when the first user profile is created it's done based on login event, so user haven't self verified the profile
 
-	now := time.Now().UTC()
 	existingProfile.UpdatedAt = now
 	existingProfile.LastLogin = now
 
-	if err := u.userProfileRepository.UpdateUserProfile(ctx, existingProfile); err != nil {
+	change, err := u.newProfileChange(ctx, existingProfile, constants.ProfileChangeSectionProfile,
+		entity.DiffSnapshots(before, existingProfile.HistorySnapshot()), req.ActorRole)
+	if err != nil {
+		return err
+	}
+	if change != nil && entity.IsSignificantChange(change.Changes) {
+		existingProfile.SignificantlyUpdatedAt = &now
+	}
+
+	if err := u.transactionManager.WithTransaction(ctx, func(tx *gorm.DB) error {
+		if err := u.userProfileRepository.UpdateUserProfileTx(ctx, tx, existingProfile); err != nil {
+			return err
+		}
+		if change == nil {
+			return nil
+		}
+		return u.profileChangeRepository.CreateProfileChangeTx(ctx, tx, change)
+	}); err != nil {
 		return fmt.Errorf("failed to update user profile: %w", err)
 	}
 
+	u.publishProfileUpdated(ctx, existingProfile)
+
+	return nil
+}
+
+// publishProfileUpdated drops the user's cached recommendations at once, so
+// the next request reflects the change, and announces the update. Listeners
+// of the event rank the recommendations again, the user's own and those the
+// profile appears in.
+func (u *userProfileUsecase) publishProfileUpdated(ctx context.Context, profile *entity.UserProfile) {
+	if err := u.recommendationCache.DeleteRecommendations(ctx, profile.UserID); err != nil {
+		// No need to fail, the event listener ranks the recommendations again.
+		u.logger.Warn("failed to drop cached recommendations",
+			zap.String("user_id", profile.UserID.String()),
+			zap.Error(err))
+	}
+
 	userProfileUpdatedEvent := userevents.UserProfileUpdatedEvent{
-		UserID:        userID,
-		UserProfileID: existingProfile.ID,
-		Email:         existingProfile.Email,
-		Phone:         existingProfile.Phone,
-		FullName:      existingProfile.FullName,
-		CreatedAt:     existingProfile.CreatedAt,
-		UpdatedAt:     existingProfile.UpdatedAt,
+		UserID:        profile.UserID,
+		UserProfileID: profile.ID,
+		Email:         profile.Email,
+		Phone:         profile.Phone,
+		FullName:      profile.FullName,
+		CreatedAt:     profile.CreatedAt,
+		UpdatedAt:     profile.UpdatedAt,
 	}
 
 	if err := u.eventPublisher.PublishUserProfileUpdated(ctx, userProfileUpdatedEvent); err != nil {
 		// No need to fail, logging is done in event publishing code.
 	}
+}
+
+// publishPhotosUpdated announces a change of the user's photos. Cached
+// recommendations carry signed photo URLs, a removed or replaced photo must
+// not be served from them until they expire.
+func (u *userProfileUsecase) publishPhotosUpdated(ctx context.Context, userID uuid.UUID) {
+	profile, err := u.userProfileRepository.GetProfileByUserID(ctx, userID)
+	if err != nil || profile == nil {
+		if err := u.recommendationCache.DeleteRecommendations(ctx, userID); err != nil {
+			u.logger.Warn("failed to drop cached recommendations",
+				zap.String("user_id", userID.String()),
+				zap.Error(err))
+		}
+		return
+	}
+	u.publishProfileUpdated(ctx, profile)
+}
+
+// applyLanguageUpdate sets the preferred language. Until the user picks one,
+// the first update adopts the language the app asked for.
+func applyLanguageUpdate(profile *entity.UserProfile, req entity.UpdateUserProfileRequest) error {
+	if req.PreferredLanguage != nil {
+		locale, ok := i18n.ParseLocale(*req.PreferredLanguage)
+		if !ok {
+			return appError.ErrInvalidLocale
+		}
+		profile.PreferredLanguage = string(locale)
+		return nil
+	}
+
+	if !profile.ProfileCompleted {
+		if locale, ok := i18n.ParseLocale(req.RequestLocale); ok {
+			profile.PreferredLanguage = string(locale)
+		}
+	}
+	return nil
+}
+
+// applyLocationUpdate sets the native place and residence present in the
+// request. A native place given together with a home district wins.
+func applyLocationUpdate(profile *entity.UserProfile, req entity.UpdateUserProfileRequest) error {
+	if req.NativePlace != nil {
+		nativePlace, err := parseLocation(*req.NativePlace)
+		if err != nil {
+			return err
+		}
+		profile.SetNativePlace(nativePlace)
+	}
+
+	if req.Residence != nil {
+		residence, err := parseLocation(*req.Residence)
+		if err != nil {
+			return err
+		}
+		profile.Residence = residence
+	}
+
+	return nil
+}
+
+func parseLocation(l entity.Location) (entity.Location, error) {
+	l = l.Normalize()
+	if l.Country != "" && !validation.IsValidCountryCode(l.Country) {
+		return entity.Location{}, appError.ErrInvalidCountry
+	}
+	if !l.IsValid() {
+		return entity.Location{}, appError.ErrInvalidLocation
+	}
+	return l, nil
+}
+
+// applyHoroscopeUpdate sets the horoscope fields present in the request. An
+// empty string clears a field, since horoscope details are optional.
+func applyHoroscopeUpdate(profile *entity.UserProfile, req entity.UpdateUserProfileRequest) error {
+	if req.BirthTime != nil {
+		if *req.BirthTime != "" && !validation.IsValidBirthTime(*req.BirthTime) {
+			return appError.ErrInvalidBirthTime
+		}
+		profile.BirthTime = *req.BirthTime
+	}
+
+	if req.BirthPlace != nil {
+		if !validation.IsValidBirthPlace(*req.BirthPlace) {
+			return appError.ErrInvalidBirthPlace
+		}
+		profile.BirthPlace = strings.TrimSpace(*req.BirthPlace)
+	}
+
+	if req.Nakshatra != nil {
+		if *req.Nakshatra != "" && !validation.IsValidNakshatra(*req.Nakshatra) {
+			return appError.ErrInvalidNakshatra
+		}
+		profile.Nakshatra = validation.Nakshatra(*req.Nakshatra)
+	}
+
+	if req.Rasi != nil {
+		if *req.Rasi != "" && !validation.IsValidRasi(*req.Rasi) {
+			return appError.ErrInvalidRasi
+		}
+		profile.Rasi = validation.Rasi(*req.Rasi)
+	}
+
+	// Checked on the resulting profile, either value may come from an earlier update
+	if profile.HasHoroscope() && !validation.IsNakshatraInRasi(profile.Nakshatra, profile.Rasi) {
+		return appError.ErrNakshatraRasiMismatch
+	}
+
+	if req.ChovvaDosham != nil {
+		profile.ChovvaDosham = req.ChovvaDosham
+	}
+	if req.PapaDosham != nil {
+		profile.PapaDosham = req.PapaDosham
+	}
 
 	return nil
 }
@@ -1,36 +1,56 @@
 package userprofile
 
 import (
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/config"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/event"
 	mediastorage "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/mediastorage"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/repository"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/usecase"
+	"go.uber.org/zap"
 )
 
 type userProfileUsecase struct {
 	userProfileRepository        repository.UserProfileRepository
 	userImageRepository          repository.UserImageRepository
 	partnerPreferencesRepository repository.PartnerPreferencesRepository
+	userBlockRepository          repository.UserBlockRepository
+	profileViewRepository        repository.ProfileViewRepository
+	profileChangeRepository      repository.ProfileChangeRepository
+	recommendationCache          repository.RecommendationCacheRepository
+	transactionManager           *postgres.TransactionManager
 	eventPublisher               event.EventPublisher
 	photoStorage                 mediastorage.PhotoStorage
 	config                       *config.Config
+	logger                       *zap.Logger
 }
 
 func NewUserProfileUsecase(
 	userProfileRepository repository.UserProfileRepository,
 	userImageRepository repository.UserImageRepository,
 	partnerPreferencesRepository repository.PartnerPreferencesRepository,
+	userBlockRepository repository.UserBlockRepository,
+	profileViewRepository repository.ProfileViewRepository,
+	profileChangeRepository repository.ProfileChangeRepository,
+	recommendationCache repository.RecommendationCacheRepository,
+	transactionManager *postgres.TransactionManager,
 	eventPublisher event.EventPublisher,
 	photoStorage mediastorage.PhotoStorage,
 	config *config.Config,
+	logger *zap.Logger,
 ) usecase.UserProfileUsecase {
 	return &userProfileUsecase{
 		userProfileRepository:        userProfileRepository,
 		userImageRepository:          userImageRepository,
 		partnerPreferencesRepository: partnerPreferencesRepository,
+		userBlockRepository:          userBlockRepository,
+		profileViewRepository:        profileViewRepository,
+		profileChangeRepository:      profileChangeRepository,
+		recommendationCache:          recommendationCache,
+		transactionManager:           transactionManager,
 		eventPublisher:               eventPublisher,
 		photoStorage:                 photoStorage,
 		config:                       config,
+		logger:                       logger,
 	}
 }
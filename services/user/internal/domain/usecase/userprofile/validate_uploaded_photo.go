@@ -0,0 +1,41 @@
+package userprofile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/gcsutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/validation"
+)
+
+// validateUploadedPhoto checks the object a client claims to have uploaded
+// before it is attached to a profile. The client uploads straight to storage
+// with a signed URL, so the key, existence, type and size are all untrusted.
+func (u *userProfileUsecase) validateUploadedPhoto(
+	ctx context.Context,
+	objectKey string,
+	keyPrefix string) error {
+
+	// Keys are derived from the user ID, anything else belongs to someone else
+	if !gcsutil.HasUploadKeyPrefix(objectKey, keyPrefix) {
+		return apperrors.ErrPhotoNotOwned
+	}
+
+	metadata, err := u.photoStorage.GetPhotoMetadata(ctx, objectKey)
+	if err != nil {
+		return fmt.Errorf("failed to get uploaded photo metadata: %w", err)
+	}
+	if metadata == nil {
+		return apperrors.ErrUploadedPhotoNotFound
+	}
+
+	if !validation.IsValidImageFileSize(metadata.Size) {
+		return apperrors.ErrInvalidImageFileSize
+	}
+	if !validation.IsValidImageType(metadata.ContentType) {
+		return apperrors.ErrInvalidImageType
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	chatevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/chat"
+	messageBroker "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/messagebroker"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/usecase"
+	"go.uber.org/zap"
+)
+
+type ChatEventHandler struct {
+	messagingClient   messageBroker.Client
+	moderationUsecase usecase.ModerationUsecase
+	logger            *zap.Logger
+}
+
+func NewChatEventHandler(
+	messagingClient messageBroker.Client,
+	moderationUsecase usecase.ModerationUsecase,
+	logger *zap.Logger) *ChatEventHandler {
+
+	return &ChatEventHandler{
+		messagingClient:   messagingClient,
+		moderationUsecase: moderationUsecase,
+		logger:            logger,
+	}
+}
+
+func (h *ChatEventHandler) StartListening(ctx context.Context) error {
+	subscriptions := []struct {
+		topic   string
+		handler messageBroker.MessageHandler
+	}{
+		{
+			topic:   constants.EventChatMessageReported,
+			handler: h.createMessageReportedHandler(ctx),
+		},
+	}
+
+	for _, sub := range subscriptions {
+		if err := h.messagingClient.Subscribe(sub.topic, sub.handler); err != nil {
+			h.logger.Error("Error subscribing to topic",
+				zap.String("topic", sub.topic),
+				zap.Error(err))
+			return err
+		}
+		h.logger.Info("Successfully subscribed to topic", zap.String("topic", sub.topic))
+	}
+
+	return nil
+}
+
+func (h *ChatEventHandler) createMessageReportedHandler(ctx context.Context) messageBroker.MessageHandler {
+	return func(body []byte) error {
+		var event chatevents.MessageReportedEvent
+
+		if err := json.Unmarshal(body, &event); err != nil {
+			h.logger.Error("Error unmarshalling message reported event", zap.Error(err))
+			return err
+		}
+
+		if err := h.moderationUsecase.CreateMessageReport(ctx,
+			event.ReporterUserID,
+			event.SenderUserID,
+			event.MessageID,
+			event.MessageContent,
+			event.Category,
+			event.Description); err != nil {
+			h.logger.Error("failed to create message report",
+				zap.String(constants.ContextKeyUserID, event.ReporterUserID.String()),
+				zap.String("message_id", event.MessageID),
+				zap.Error(err))
+			return err
+		}
+
+		h.logger.Info("message report created successfully",
+			zap.String(constants.ContextKeyUserID, event.ReporterUserID.String()),
+			zap.String("message_id", event.MessageID))
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	userevents "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/events/user"
+	messageBroker "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/messagebroker"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/usecase"
+	"go.uber.org/zap"
+)
+
+// UserEventHandler listens to the user service's own events, work that does
+// not have to finish within the request is done here.
+type UserEventHandler struct {
+	messagingClient    messageBroker.Client
+	matchMakingUsecase usecase.MatchMakingUsecase
+	logger             *zap.Logger
+}
+
+func NewUserEventHandler(
+	messagingClient messageBroker.Client,
+	matchMakingUsecase usecase.MatchMakingUsecase,
+	logger *zap.Logger) *UserEventHandler {
+
+	return &UserEventHandler{
+		messagingClient:    messagingClient,
+		matchMakingUsecase: matchMakingUsecase,
+		logger:             logger,
+	}
+}
+
+func (h *UserEventHandler) StartListening(ctx context.Context) error {
+	subscriptions := []struct {
+		topic   string
+		handler messageBroker.MessageHandler
+	}{
+		{
+			topic:   constants.EventUserProfileUpdated,
+			handler: h.createUserProfileUpdatedHandler(ctx),
+		},
+	}
+
+	for _, sub := range subscriptions {
+		if err := h.messagingClient.Subscribe(sub.topic, sub.handler); err != nil {
+			h.logger.Error("Error subscribing to topic",
+				zap.String("topic", sub.topic),
+				zap.Error(err))
+			return err
+		}
+		h.logger.Info("Successfully subscribed to topic", zap.String("topic", sub.topic))
+	}
+
+	return nil
+}
+
+func (h *UserEventHandler) createUserProfileUpdatedHandler(ctx context.Context) messageBroker.MessageHandler {
+	return func(body []byte) error {
+		var event userevents.UserProfileUpdatedEvent
+
+		if err := json.Unmarshal(body, &event); err != nil {
+			h.logger.Error("Error unmarshalling user profile updated event", zap.Error(err))
+			return err
+		}
+
+		if err := h.matchMakingUsecase.RefreshRecommendations(ctx, event.UserID); err != nil {
+			h.logger.Error("failed to refresh recommendations",
+				zap.String(constants.ContextKeyUserID, event.UserID.String()),
+				zap.Error(err))
+			return err
+		}
+
+		h.logger.Info("recommendations refreshed",
+			zap.String(constants.ContextKeyUserID, event.UserID.String()))
+		return nil
+	}
+}
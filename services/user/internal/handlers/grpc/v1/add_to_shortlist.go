@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) AddToShortlist(
+	ctx context.Context,
+	req *userpbv1.AddToShortlistRequest,
+) (*userpbv1.AddToShortlistResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	if req.TargetProfileId <= 0 {
+		return nil, apperrors.ErrInvalidTargetProfileID
+	}
+
+	if err := h.matchMakingUsecase.AddToShortlist(ctx, userUUID, req.TargetProfileId, req.Note); err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to add profile to shortlist", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully added profile to shortlist", zap.Int64("target_profile_id", req.TargetProfileId))
+	return &userpbv1.AddToShortlistResponse{Success: true}, nil
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) BlockOrUnblockProfile(
+	ctx context.Context,
+	req *userpbv1.BlockOrUnblockProfileRequest,
+) (*userpbv1.BlockOrUnblockProfileResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	if req.TargetProfileId <= 0 {
+		return nil, apperrors.ErrInvalidTargetProfileID
+	}
+
+	success, err := h.matchMakingUsecase.BlockOrUnblockProfile(ctx, userUUID, req.TargetProfileId, req.ShouldBlock)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to block or unblock profile", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully processed block request",
+		zap.Int64("target_profile_id", req.TargetProfileId),
+		zap.Bool("should_block", req.ShouldBlock))
+	return &userpbv1.BlockOrUnblockProfileResponse{Success: success}, nil
+}
@@ -35,6 +35,7 @@ func (h *UserHandler) ConfirmAdditionalPhotoUpload(ctx context.Context,
 	additionalPhotoURL, err := h.userProfileUsecase.ConfirmAdditionalPhotoUpload(
 		ctx,
 		userIDUUID,
+		contextData.Role,
 		req.ObjectKey.Value,
 	)
 	if err != nil {
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) CreateReferenceOption(
+	ctx context.Context,
+	req *userpbv1.CreateReferenceOptionRequest,
+) (*userpbv1.CreateReferenceOptionResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+		zap.String("taxonomy", req.Taxonomy),
+		zap.String("value", req.Value),
+	)
+
+	option, err := h.referenceDataUsecase.CreateReferenceOption(ctx, entity.CreateReferenceOptionRequest{
+		Taxonomy:     req.Taxonomy,
+		Value:        req.Value,
+		Label:        req.Label,
+		DisplayOrder: req.DisplayOrder,
+	})
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to create reference option", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully created reference option", zap.Int64("option_id", option.ID))
+	return &userpbv1.CreateReferenceOptionResponse{
+		Option: toProtoReferenceOption(option),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) CreateSavedSearch(
+	ctx context.Context,
+	req *userpbv1.CreateSavedSearchRequest,
+) (*userpbv1.CreateSavedSearchResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	savedSearch, err := h.matchMakingUsecase.CreateSavedSearch(ctx,
+		userUUID, req.Name, toEntitySearchFilters(req.Filters), req.AlertsEnabled)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to create saved search", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully created saved search", zap.Int64("saved_search_id", savedSearch.ID))
+	return &userpbv1.CreateSavedSearchResponse{
+		SavedSearch: toProtoSavedSearch(savedSearch),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"context"
+
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) DeleteReferenceOption(
+	ctx context.Context,
+	req *userpbv1.DeleteReferenceOptionRequest,
+) (*userpbv1.DeleteReferenceOptionResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+		zap.Int64("option_id", req.OptionId),
+	)
+
+	if err := h.referenceDataUsecase.DeleteReferenceOption(ctx, req.OptionId); err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to delete reference option", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully deleted reference option")
+	return &userpbv1.DeleteReferenceOptionResponse{
+		Success: true,
+	}, nil
+}
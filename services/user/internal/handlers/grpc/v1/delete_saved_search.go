@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) DeleteSavedSearch(
+	ctx context.Context,
+	req *userpbv1.DeleteSavedSearchRequest,
+) (*userpbv1.DeleteSavedSearchResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	if req.SavedSearchId <= 0 {
+		return nil, apperrors.ErrSavedSearchNotFound
+	}
+
+	if err := h.matchMakingUsecase.DeleteSavedSearch(ctx, userUUID, req.SavedSearchId); err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to delete saved search", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully deleted saved search", zap.Int64("saved_search_id", req.SavedSearchId))
+	return &userpbv1.DeleteSavedSearchResponse{Success: true}, nil
+}
@@ -32,7 +32,7 @@ func (h *UserHandler) GetAdditionalPhotoUploadURL(ctx context.Context,
 		return nil, err
 	}
 
-	response, err := h.userProfileUsecase.GetAdditionalPhotoUploadURL(ctx, userIDUUID, int32(req.DisplayOrder.Value), req.ContentType.Value)
+	response, err := h.userProfileUsecase.GetAdditionalPhotoUploadURL(ctx, userIDUUID, contextData.Role, int32(req.DisplayOrder.Value), req.ContentType.Value)
 	if err != nil {
 		if !apperrors.IsAppError(err) {
 			log.Error("Failed to get additional photo upload URL", zap.Error(err))
@@ -38,7 +38,7 @@ func (h *UserHandler) GetMatchRecommendations(
 	limit := int(req.Limit)
 	offset := int(req.Offset)
 
-	profiles, pagination, err := h.matchMakingUsecase.RecommendUserProfiles(ctx, userIDUUID, limit, offset)
+	profiles, pagination, err := h.matchMakingUsecase.RecommendUserProfiles(ctx, userIDUUID, limit, offset, req.Cursor)
 	if err != nil {
 		if !apperrors.IsAppError(err) {
 			log.Error("Failed to get match recommendations", zap.Error(err))
@@ -57,6 +57,18 @@ func (h *UserHandler) GetMatchRecommendations(
 			MaritalStatus:     profile.MaritalStatus,
 			Profession:        profile.Profession,
 			HomeDistrict:      profile.HomeDistrict,
+			NativePlace:       toProtoLocation(profile.NativePlace),
+			Residence:         toProtoLocation(profile.Residence),
+			IsNri:             profile.IsNRI,
+			IsShortlisted:     profile.IsShortlisted,
+
+			CompatibilityScore: profile.CompatibilityScore,
+			MatchedCriteria:    profile.MatchedCriteria,
+			UnmatchedCriteria:  profile.UnmatchedCriteria,
+
+			MatchesTheirPreferences: profile.MatchesTheirPreferences,
+			PreviouslyPassed:        profile.PreviouslyPassed,
+			ActivityStatus:          profile.ActivityStatus,
 		}
 	}
 
@@ -65,6 +77,7 @@ func (h *UserHandler) GetMatchRecommendations(
 		Limit:      int32(pagination.Limit),
 		Offset:     int32(pagination.Offset),
 		HasMore:    pagination.HasMore,
+		NextCursor: pagination.NextCursor,
 	}
 
 	log.Info("Successfully fetched match recommendations")
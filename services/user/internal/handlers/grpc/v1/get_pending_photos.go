@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"context"
+
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *UserHandler) GetPendingPhotos(
+	ctx context.Context,
+	req *userpbv1.GetPendingPhotosRequest,
+) (*userpbv1.GetPendingPhotosResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	photos, pagination, err := h.moderationUsecase.GetPendingPhotos(ctx, int(req.Limit), int(req.Offset))
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to get pending photos", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	protoPhotos := make([]*userpbv1.PendingPhoto, len(photos))
+	for i, photo := range photos {
+		protoPhotos[i] = &userpbv1.PendingPhoto{
+			TargetProfileId: photo.UserProfileID,
+			TargetUserId:    photo.UserID.String(),
+			DisplayOrder:    int32(photo.DisplayOrder),
+			PhotoUrl:        photo.PhotoURL,
+			UploadedAt:      timestamppb.New(photo.UploadedAt),
+			ObjectKey:       photo.ObjectKey,
+		}
+	}
+
+	log.Info("Successfully fetched pending photos")
+	return &userpbv1.GetPendingPhotosResponse{
+		Photos: protoPhotos,
+		Pagination: &userpbv1.PaginationInfo{
+			TotalCount: pagination.TotalCount,
+			Limit:      int32(pagination.Limit),
+			Offset:     int32(pagination.Offset),
+			HasMore:    pagination.HasMore,
+		},
+	}, nil
+}
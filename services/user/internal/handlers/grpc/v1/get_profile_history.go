@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *UserHandler) GetProfileHistory(
+	ctx context.Context,
+	req *userpbv1.GetProfileHistoryRequest,
+) (*userpbv1.GetProfileHistoryResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	changes, pagination, err := h.moderationUsecase.GetProfileHistory(ctx, entity.GetProfileHistoryRequest{
+		UserProfileID: req.ProfileId,
+		Section:       req.Section,
+		FlaggedOnly:   req.FlaggedOnly,
+		Limit:         int(req.Limit),
+		Offset:        int(req.Offset),
+	})
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to get profile history", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	protoChanges := make([]*userpbv1.ProfileChangeInfo, len(changes))
+	for i, change := range changes {
+		protoChanges[i] = mapProfileChangeToProto(change)
+	}
+
+	log.Info("Successfully fetched profile history", zap.Int64("profile_id", req.ProfileId))
+	return &userpbv1.GetProfileHistoryResponse{
+		Changes: protoChanges,
+		Pagination: &userpbv1.PaginationInfo{
+			TotalCount: pagination.TotalCount,
+			Limit:      int32(pagination.Limit),
+			Offset:     int32(pagination.Offset),
+			HasMore:    pagination.HasMore,
+		},
+	}, nil
+}
+
+func mapProfileChangeToProto(change *entity.ProfileChange) *userpbv1.ProfileChangeInfo {
+	fieldChanges := make([]*userpbv1.ProfileFieldChange, len(change.Changes))
+	for i, fc := range change.Changes {
+		fieldChanges[i] = &userpbv1.ProfileFieldChange{
+			Field:    fc.Field,
+			OldValue: fc.OldValue,
+			NewValue: fc.NewValue,
+		}
+	}
+
+	return &userpbv1.ProfileChangeInfo{
+		Id:          change.ID,
+		ProfileId:   change.UserProfileID,
+		UserId:      change.UserID.String(),
+		Section:     change.Section,
+		Version:     change.Version,
+		Changes:     fieldChanges,
+		ActorId:     change.ActorID.String(),
+		ActorRole:   change.ActorRole,
+		IsFlagged:   change.IsFlagged,
+		FlagReasons: change.FlagReasons,
+		CreatedAt:   timestamppb.New(change.CreatedAt),
+	}
+}
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pointerutil"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *UserHandler) GetProfileVisitors(
+	ctx context.Context,
+	req *userpbv1.GetProfileVisitorsRequest,
+) (*userpbv1.GetProfileVisitorsResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userIDUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	visitors, pagination, err := h.userProfileUsecase.GetProfileVisitors(ctx, userIDUUID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to get profile visitors", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	protoVisitors := make([]*userpbv1.ProfileVisitor, len(visitors))
+	for i, visitor := range visitors {
+		protoVisitors[i] = &userpbv1.ProfileVisitor{
+			Profile: &userpbv1.UserProfileRecommendation{
+				Id:                visitor.Profile.ID,
+				FullName:          visitor.Profile.FullName,
+				ProfilePictureUrl: pointerutil.GetStringValue(visitor.Profile.ProfilePictureURL),
+				Age:               visitor.Profile.Age,
+				HeightCm:          visitor.Profile.HeightCm,
+				MaritalStatus:     visitor.Profile.MaritalStatus,
+				Profession:        visitor.Profile.Profession,
+				HomeDistrict:      visitor.Profile.HomeDistrict,
+				NativePlace:       toProtoLocation(visitor.Profile.NativePlace),
+				Residence:         toProtoLocation(visitor.Profile.Residence),
+				IsNri:             visitor.Profile.IsNRI,
+			},
+			LastViewedAt: timestamppb.New(visitor.LastViewedAt),
+		}
+	}
+
+	log.Info("Successfully fetched profile visitors")
+	return &userpbv1.GetProfileVisitorsResponse{
+		Visitors: protoVisitors,
+		Pagination: &userpbv1.PaginationInfo{
+			TotalCount: pagination.TotalCount,
+			Limit:      int32(pagination.Limit),
+			Offset:     int32(pagination.Offset),
+			HasMore:    pagination.HasMore,
+		},
+	}, nil
+}
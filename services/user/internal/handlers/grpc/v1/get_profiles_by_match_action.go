@@ -55,6 +55,9 @@ func (h *UserHandler) GetProfilesByMatchAction(
 			MaritalStatus:     profile.MaritalStatus,
 			Profession:        profile.Profession,
 			HomeDistrict:      profile.HomeDistrict,
+			NativePlace:       toProtoLocation(profile.NativePlace),
+			Residence:         toProtoLocation(profile.Residence),
+			IsNri:             profile.IsNRI,
 		}
 	}
 
@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) GetReceivedInterestCounts(
+	ctx context.Context,
+	req *userpbv1.GetReceivedInterestCountsRequest,
+) (*userpbv1.GetReceivedInterestCountsResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userIDUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	counts, err := h.matchMakingUsecase.GetReceivedInterestCounts(ctx, userIDUUID)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to get received interest counts", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully fetched received interest counts")
+	return &userpbv1.GetReceivedInterestCountsResponse{
+		PendingCount: counts.Pending,
+		UnreadCount:  counts.Unread,
+	}, nil
+}
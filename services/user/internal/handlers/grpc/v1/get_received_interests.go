@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pointerutil"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *UserHandler) GetReceivedInterests(
+	ctx context.Context,
+	req *userpbv1.GetReceivedInterestsRequest,
+) (*userpbv1.GetReceivedInterestsResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userIDUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	interests, pagination, err := h.matchMakingUsecase.GetReceivedInterests(ctx, userIDUUID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to get received interests", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	protoInterests := make([]*userpbv1.ReceivedInterest, len(interests))
+	for i, interest := range interests {
+		protoInterests[i] = &userpbv1.ReceivedInterest{
+			Profile: &userpbv1.UserProfileRecommendation{
+				Id:                interest.Profile.ID,
+				FullName:          interest.Profile.FullName,
+				ProfilePictureUrl: pointerutil.GetStringValue(interest.Profile.ProfilePictureURL),
+				Age:               interest.Profile.Age,
+				HeightCm:          interest.Profile.HeightCm,
+				MaritalStatus:     interest.Profile.MaritalStatus,
+				Profession:        interest.Profile.Profession,
+				HomeDistrict:      interest.Profile.HomeDistrict,
+				NativePlace:       toProtoLocation(interest.Profile.NativePlace),
+				Residence:         toProtoLocation(interest.Profile.Residence),
+				IsNri:             interest.Profile.IsNRI,
+				ActivityStatus:    interest.Profile.ActivityStatus,
+			},
+			ReceivedAt: timestamppb.New(interest.ReceivedAt),
+			IsNew:      interest.IsNew,
+		}
+	}
+
+	log.Info("Successfully fetched received interests")
+	return &userpbv1.GetReceivedInterestsResponse{
+		Interests: protoInterests,
+		Pagination: &userpbv1.PaginationInfo{
+			TotalCount: pagination.TotalCount,
+			Limit:      int32(pagination.Limit),
+			Offset:     int32(pagination.Offset),
+			HasMore:    pagination.HasMore,
+		},
+	}, nil
+}
@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+// GetReferenceOptions is public, so only the request ID is expected in the
+// context.
+func (h *UserHandler) GetReferenceOptions(
+	ctx context.Context,
+	req *userpbv1.GetReferenceOptionsRequest,
+) (*userpbv1.GetReferenceOptionsResponse, error) {
+
+	reqCtx, err := contextutils.ExtractRequestIDFromGrpcContext(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract request ID", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, reqCtx.RequestID),
+		zap.String("taxonomy", req.Taxonomy),
+	)
+
+	options, err := h.referenceDataUsecase.GetReferenceOptions(ctx, req.Taxonomy, req.IncludeInactive)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to get reference options", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	protoOptions := make([]*userpbv1.ReferenceOption, len(options))
+	for i, option := range options {
+		protoOptions[i] = toProtoReferenceOption(option)
+	}
+
+	log.Info("Successfully fetched reference options", zap.Int("count", len(options)))
+	return &userpbv1.GetReferenceOptionsResponse{
+		Options: protoOptions,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"context"
+
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) GetReport(
+	ctx context.Context,
+	req *userpbv1.GetReportRequest,
+) (*userpbv1.GetReportResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	report, err := h.moderationUsecase.GetReport(ctx, req.ReportId)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to get report", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully fetched report", zap.Int64("report_id", report.ID))
+	return &userpbv1.GetReportResponse{Report: mapReportToProto(report)}, nil
+}
@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"context"
+
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pointerutil"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func (h *UserHandler) GetReports(
+	ctx context.Context,
+	req *userpbv1.GetReportsRequest,
+) (*userpbv1.GetReportsResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	reports, pagination, err := h.moderationUsecase.GetReports(ctx, req.Status, int(req.Limit), int(req.Offset))
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to get reports", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	protoReports := make([]*userpbv1.ReportInfo, len(reports))
+	for i, report := range reports {
+		protoReports[i] = mapReportToProto(report)
+	}
+
+	log.Info("Successfully fetched reports")
+	return &userpbv1.GetReportsResponse{
+		Reports: protoReports,
+		Pagination: &userpbv1.PaginationInfo{
+			TotalCount: pagination.TotalCount,
+			Limit:      int32(pagination.Limit),
+			Offset:     int32(pagination.Offset),
+			HasMore:    pagination.HasMore,
+		},
+	}, nil
+}
+
+func mapReportToProto(report *entity.Report) *userpbv1.ReportInfo {
+	out := &userpbv1.ReportInfo{
+		Id:              report.ID,
+		ReporterUserId:  report.ReporterID.String(),
+		TargetUserId:    report.TargetUserID.String(),
+		TargetProfileId: report.TargetProfileID,
+		TargetType:      report.TargetType,
+		MessageId:       pointerutil.GetStringValue(report.MessageID),
+		MessageContent:  pointerutil.GetStringValue(report.MessageContent),
+		Category:        report.Category,
+		Description:     report.Description,
+		Status:          report.Status,
+		Action:          pointerutil.GetStringValue(report.Action),
+		AdminNote:       pointerutil.GetStringValue(report.AdminNote),
+		CreatedAt:       timestamppb.New(report.CreatedAt),
+	}
+	if report.PhotoDisplayOrder != nil {
+		out.PhotoDisplayOrder = wrapperspb.Int32(int32(*report.PhotoDisplayOrder))
+	}
+	if report.ResolvedAt != nil {
+		out.ResolvedAt = timestamppb.New(*report.ResolvedAt)
+	}
+	return out
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) GetSavedSearches(
+	ctx context.Context,
+	req *userpbv1.GetSavedSearchesRequest,
+) (*userpbv1.GetSavedSearchesResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	savedSearches, err := h.matchMakingUsecase.GetSavedSearches(ctx, userUUID)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to get saved searches", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	protoSavedSearches := make([]*userpbv1.SavedSearch, len(savedSearches))
+	for i, savedSearch := range savedSearches {
+		protoSavedSearches[i] = toProtoSavedSearch(savedSearch)
+	}
+
+	log.Info("Successfully fetched saved searches")
+	return &userpbv1.GetSavedSearchesResponse{
+		SavedSearches: protoSavedSearches,
+	}, nil
+}
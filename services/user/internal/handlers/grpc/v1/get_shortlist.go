@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pointerutil"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *UserHandler) GetShortlist(
+	ctx context.Context,
+	req *userpbv1.GetShortlistRequest,
+) (*userpbv1.GetShortlistResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	entries, pagination, err := h.matchMakingUsecase.GetShortlist(ctx, userUUID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to get shortlist", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	protoProfiles := make([]*userpbv1.ShortlistedProfile, len(entries))
+	for i, entry := range entries {
+		protoProfiles[i] = &userpbv1.ShortlistedProfile{
+			Profile: &userpbv1.UserProfileRecommendation{
+				Id:                entry.Profile.ID,
+				FullName:          entry.Profile.FullName,
+				ProfilePictureUrl: pointerutil.GetStringValue(entry.Profile.ProfilePictureURL),
+				Age:               entry.Profile.Age,
+				HeightCm:          entry.Profile.HeightCm,
+				MaritalStatus:     entry.Profile.MaritalStatus,
+				Profession:        entry.Profile.Profession,
+				HomeDistrict:      entry.Profile.HomeDistrict,
+				NativePlace:       toProtoLocation(entry.Profile.NativePlace),
+				Residence:         toProtoLocation(entry.Profile.Residence),
+				IsNri:             entry.Profile.IsNRI,
+				IsShortlisted:     entry.Profile.IsShortlisted,
+			},
+			Note:          entry.Note,
+			ShortlistedAt: timestamppb.New(entry.ShortlistedAt),
+		}
+	}
+
+	log.Info("Successfully fetched shortlist")
+	return &userpbv1.GetShortlistResponse{
+		Profiles: protoProfiles,
+		Pagination: &userpbv1.PaginationInfo{
+			TotalCount: pagination.TotalCount,
+			Limit:      int32(pagination.Limit),
+			Offset:     int32(pagination.Offset),
+			HasMore:    pagination.HasMore,
+		},
+	}, nil
+}
@@ -80,6 +80,12 @@ func (h *UserHandler) GetUserDetailsByProfileID(
 			PreferredProfessionTypes:   preferredProfessionTypes,
 			PreferredEducationLevels:   preferredEducationLevels,
 			PreferredHomeDistricts:     preferredHomeDistricts,
+			MinPoruthamScore:           int32(prefs.MinPoruthamScore),
+			AcceptDosham:               prefs.AcceptDosham,
+
+			PreferredCountries:          prefs.PreferredCountries,
+			PreferredResidenceCountries: prefs.PreferredResidenceCountries,
+			MustHaveCriteria:            prefs.MustHaveCriteria,
 		}
 	} else {
 		protoPrefs = &userpbv1.PartnerPreference{}
@@ -95,9 +101,14 @@ func (h *UserHandler) GetUserDetailsByProfileID(
 			MaritalStatus:     profile.MaritalStatus,
 			Profession:        profile.Profession,
 			HomeDistrict:      profile.HomeDistrict,
+			NativePlace:       toProtoLocation(profile.NativePlace),
+			Residence:         toProtoLocation(profile.Residence),
+			IsNri:             profile.IsNRI,
 		},
 		PartnerPreferences: protoPrefs,
 		AdditionalPhotoUrls: photos, // may be empty
+		Horoscope:           toProtoHoroscope(profile.Horoscope),
+		Porutham:            toProtoPoruthamCompatibility(profile.Porutham),
 	}
 
 	log.Info("Successfully fetched user details by profile id")
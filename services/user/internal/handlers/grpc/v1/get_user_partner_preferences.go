@@ -78,6 +78,12 @@ func (h *UserHandler) GetUserPartnerPreferences(
 			PreferredProfessionTypes:   preferredProfessionTypes,
 			PreferredEducationLevels:   preferredEducationLevels,
 			PreferredHomeDistricts:     preferredHomeDistricts,
+			MinPoruthamScore:           int32(prefs.MinPoruthamScore),
+			AcceptDosham:               prefs.AcceptDosham,
+
+			PreferredCountries:          prefs.PreferredCountries,
+			PreferredResidenceCountries: prefs.PreferredResidenceCountries,
+			MustHaveCriteria:            prefs.MustHaveCriteria,
 		},
 	}
 
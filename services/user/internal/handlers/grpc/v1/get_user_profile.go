@@ -41,6 +41,14 @@ func (h *UserHandler) GetUserProfile(
 		return nil, err
 	}
 
+	viewStats, err := h.userProfileUsecase.GetProfileViewStats(ctx, userIDUUID)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to get profile view stats", zap.Error(err))
+		}
+		return nil, err
+	}
+
 	resp := &userpbv1.GetUserProfileResponse{
 		Profile: &userpbv1.UserProfileRecommendation{
 			Id:                profile.ID,
@@ -51,7 +59,17 @@ func (h *UserHandler) GetUserProfile(
 			MaritalStatus:     profile.MaritalStatus,
 			Profession:        profile.Profession,
 			HomeDistrict:      profile.HomeDistrict,
+			NativePlace:       toProtoLocation(profile.NativePlace),
+			Residence:         toProtoLocation(profile.Residence),
+			IsNri:             profile.IsNRI,
+		},
+		ViewStats: &userpbv1.ProfileViewStats{
+			ViewsLast_7Days:  viewStats.ViewsLast7Days,
+			ViewsLast_30Days: viewStats.ViewsLast30Days,
 		},
+		Horoscope:         toProtoHoroscope(profile.Horoscope),
+		PreferredLanguage: profile.PreferredLanguage,
+		DigestFrequency:   profile.DigestFrequency,
 	}
 
 	log.Info("Successfully fetched user profile")
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func toProtoHoroscope(horoscope *entity.Horoscope) *userpbv1.Horoscope {
+	if horoscope == nil {
+		return nil
+	}
+	protoHoroscope := &userpbv1.Horoscope{
+		BirthTime:  horoscope.BirthTime,
+		BirthPlace: horoscope.BirthPlace,
+		Nakshatra:  horoscope.Nakshatra,
+		Rasi:       horoscope.Rasi,
+	}
+	if horoscope.ChovvaDosham != nil {
+		protoHoroscope.ChovvaDosham = wrapperspb.Bool(*horoscope.ChovvaDosham)
+	}
+	if horoscope.PapaDosham != nil {
+		protoHoroscope.PapaDosham = wrapperspb.Bool(*horoscope.PapaDosham)
+	}
+	return protoHoroscope
+}
+
+func toProtoPoruthamCompatibility(compatibility *entity.PoruthamCompatibility) *userpbv1.PoruthamCompatibility {
+	if compatibility == nil {
+		return nil
+	}
+	protoCompatibility := &userpbv1.PoruthamCompatibility{
+		Score:              compatibility.Score,
+		MaxScore:           compatibility.MaxScore,
+		MatchedPoruthams:   compatibility.Matched,
+		UnmatchedPoruthams: compatibility.Unmatched,
+	}
+	if compatibility.DoshamCompatible != nil {
+		protoCompatibility.DoshamCompatible = wrapperspb.Bool(*compatibility.DoshamCompatible)
+	}
+	return protoCompatibility
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func toProtoLocation(location *entity.Location) *userpbv1.Location {
+	if location == nil {
+		return nil
+	}
+	return &userpbv1.Location{
+		Country:  location.Country,
+		State:    location.State,
+		District: location.District,
+		City:     location.City,
+	}
+}
+
+func fromProtoLocation(location *userpbv1.Location) *entity.Location {
+	if location == nil {
+		return nil
+	}
+	return &entity.Location{
+		Country:  location.Country,
+		State:    location.State,
+		District: location.District,
+		City:     location.City,
+	}
+}
@@ -0,0 +1,17 @@
+package v1
+
+import (
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+)
+
+func toProtoReferenceOption(option *entity.ReferenceOption) *userpbv1.ReferenceOption {
+	return &userpbv1.ReferenceOption{
+		Id:           option.ID,
+		Taxonomy:     option.Taxonomy,
+		Value:        option.Value,
+		Label:        option.Label,
+		DisplayOrder: option.DisplayOrder,
+		IsActive:     option.IsActive,
+	}
+}
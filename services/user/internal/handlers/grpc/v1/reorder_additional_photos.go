@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) ReorderAdditionalPhotos(ctx context.Context,
+	req *userpbv1.ReorderAdditionalPhotosRequest) (*userpbv1.ReorderAdditionalPhotosResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userIDUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	err = h.userProfileUsecase.ReorderAdditionalPhotos(ctx, userIDUUID, contextData.Role, req.DisplayOrders)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to reorder additional photos", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Additional photos reordered successfully")
+	return &userpbv1.ReorderAdditionalPhotosResponse{Success: &wrapperspb.BoolValue{Value: true}}, nil
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) ReportProfile(
+	ctx context.Context,
+	req *userpbv1.ReportProfileRequest,
+) (*userpbv1.ReportProfileResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	reporterUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	createReq := entity.CreateReportRequest{
+		TargetProfileID: req.TargetProfileId,
+		TargetType:      req.TargetType,
+		Category:        req.Category,
+		Description:     req.Description,
+	}
+	if req.PhotoDisplayOrder != nil {
+		displayOrder := int16(req.PhotoDisplayOrder.Value)
+		createReq.PhotoDisplayOrder = &displayOrder
+	}
+
+	reportID, err := h.moderationUsecase.ReportProfile(ctx, reporterUUID, createReq)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to report profile", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully reported profile", zap.Int64("report_id", reportID))
+	return &userpbv1.ReportProfileResponse{ReportId: reportID}, nil
+}
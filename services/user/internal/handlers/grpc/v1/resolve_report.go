@@ -0,0 +1,47 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) ResolveReport(
+	ctx context.Context,
+	req *userpbv1.ResolveReportRequest,
+) (*userpbv1.ResolveReportResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	adminUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse admin ID", zap.Error(err))
+		return nil, err
+	}
+
+	report, err := h.moderationUsecase.ResolveReport(ctx, adminUUID, req.ReportId, req.Action, req.AdminNote)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to resolve report", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully resolved report",
+		zap.Int64("report_id", report.ID),
+		zap.String("action", req.Action))
+	return &userpbv1.ResolveReportResponse{Report: mapReportToProto(report)}, nil
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) RespondToInterest(
+	ctx context.Context,
+	req *userpbv1.RespondToInterestRequest,
+) (*userpbv1.RespondToInterestResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	if req.SenderProfileId <= 0 {
+		return nil, apperrors.ErrInvalidTargetProfileID
+	}
+
+	if err := h.matchMakingUsecase.RespondToInterest(ctx, userUUID, req.SenderProfileId, req.Accept); err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to respond to interest", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully responded to interest",
+		zap.Int64("sender_profile_id", req.SenderProfileId),
+		zap.Bool("accept", req.Accept))
+	return &userpbv1.RespondToInterestResponse{Success: true}, nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) ReviewPhoto(
+	ctx context.Context,
+	req *userpbv1.ReviewPhotoRequest,
+) (*userpbv1.ReviewPhotoResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	err = h.moderationUsecase.ReviewPhoto(ctx, req.TargetProfileId, req.DisplayOrder, req.ObjectKey, req.Approve, req.Reason)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to review photo", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully reviewed photo",
+		zap.Int64("target_profile_id", req.TargetProfileId),
+		zap.Int32("display_order", req.DisplayOrder),
+		zap.Bool("approved", req.Approve))
+	return &userpbv1.ReviewPhotoResponse{Success: true}, nil
+}
@@ -0,0 +1,87 @@
+package v1
+
+import (
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func toEntitySearchFilters(f *userpbv1.SearchFilters) entity.SearchFilters {
+	if f == nil {
+		return entity.SearchFilters{}
+	}
+
+	filters := entity.SearchFilters{
+		Communities:        f.Communities,
+		MaritalStatuses:    f.MaritalStatuses,
+		Professions:        f.Professions,
+		ProfessionTypes:    f.ProfessionTypes,
+		EducationLevels:    f.EducationLevels,
+		HomeDistricts:      f.HomeDistricts,
+		NativeCountries:    f.NativeCountries,
+		ResidenceCountries: f.ResidenceCountries,
+		HasPhoto:           f.HasPhoto,
+		VerifiedOnly:       f.VerifiedOnly,
+		ActiveWithinDays:   int(f.ActiveWithinDays),
+		Sort:               f.Sort,
+	}
+	filters.MinAgeYears = int16Value(f.MinAgeYears)
+	filters.MaxAgeYears = int16Value(f.MaxAgeYears)
+	filters.MinHeightCm = int16Value(f.MinHeightCm)
+	filters.MaxHeightCm = int16Value(f.MaxHeightCm)
+	if f.PhysicallyChallenged != nil {
+		filters.PhysicallyChallenged = &f.PhysicallyChallenged.Value
+	}
+	return filters
+}
+
+func toProtoSearchFilters(f entity.SearchFilters) *userpbv1.SearchFilters {
+	filters := &userpbv1.SearchFilters{
+		Communities:        f.Communities,
+		MaritalStatuses:    f.MaritalStatuses,
+		Professions:        f.Professions,
+		ProfessionTypes:    f.ProfessionTypes,
+		EducationLevels:    f.EducationLevels,
+		HomeDistricts:      f.HomeDistricts,
+		NativeCountries:    f.NativeCountries,
+		ResidenceCountries: f.ResidenceCountries,
+		HasPhoto:           f.HasPhoto,
+		VerifiedOnly:       f.VerifiedOnly,
+		ActiveWithinDays:   int32(f.ActiveWithinDays),
+		Sort:               f.Sort,
+	}
+	filters.MinAgeYears = int32Wrapper(f.MinAgeYears)
+	filters.MaxAgeYears = int32Wrapper(f.MaxAgeYears)
+	filters.MinHeightCm = int32Wrapper(f.MinHeightCm)
+	filters.MaxHeightCm = int32Wrapper(f.MaxHeightCm)
+	if f.PhysicallyChallenged != nil {
+		filters.PhysicallyChallenged = wrapperspb.Bool(*f.PhysicallyChallenged)
+	}
+	return filters
+}
+
+func toProtoSavedSearch(s *entity.SavedSearch) *userpbv1.SavedSearch {
+	return &userpbv1.SavedSearch{
+		Id:            s.ID,
+		Name:          s.Name,
+		Filters:       toProtoSearchFilters(s.Filters),
+		AlertsEnabled: s.AlertsEnabled,
+		CreatedAt:     timestamppb.New(s.CreatedAt),
+	}
+}
+
+func int16Value(v *wrapperspb.Int32Value) *int16 {
+	if v == nil {
+		return nil
+	}
+	value := int16(v.Value)
+	return &value
+}
+
+func int32Wrapper(v *int16) *wrapperspb.Int32Value {
+	if v == nil {
+		return nil
+	}
+	return wrapperspb.Int32(int32(*v))
+}
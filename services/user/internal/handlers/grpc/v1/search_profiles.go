@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/pointerutil"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) SearchProfiles(
+	ctx context.Context,
+	req *userpbv1.SearchProfilesRequest,
+) (*userpbv1.SearchProfilesResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	profiles, pagination, err := h.matchMakingUsecase.SearchProfiles(ctx,
+		userUUID, toEntitySearchFilters(req.Filters), int(req.Limit), int(req.Offset))
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to search profiles", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	protoProfiles := make([]*userpbv1.UserProfileRecommendation, len(profiles))
+	for i, profile := range profiles {
+		protoProfiles[i] = &userpbv1.UserProfileRecommendation{
+			Id:                profile.ID,
+			FullName:          profile.FullName,
+			ProfilePictureUrl: pointerutil.GetStringValue(profile.ProfilePictureURL),
+			Age:               profile.Age,
+			HeightCm:          profile.HeightCm,
+			MaritalStatus:     profile.MaritalStatus,
+			Profession:        profile.Profession,
+			HomeDistrict:      profile.HomeDistrict,
+			NativePlace:       toProtoLocation(profile.NativePlace),
+			Residence:         toProtoLocation(profile.Residence),
+			IsNri:             profile.IsNRI,
+			IsShortlisted:     profile.IsShortlisted,
+			ActivityStatus:    profile.ActivityStatus,
+		}
+	}
+
+	log.Info("Successfully searched profiles", zap.Int("result_count", len(protoProfiles)))
+	return &userpbv1.SearchProfilesResponse{
+		Profiles: protoProfiles,
+		Pagination: &userpbv1.PaginationInfo{
+			TotalCount: pagination.TotalCount,
+			Limit:      int32(pagination.Limit),
+			Offset:     int32(pagination.Offset),
+			HasMore:    pagination.HasMore,
+		},
+	}, nil
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) UndoLastMatchAction(
+	ctx context.Context,
+	req *userpbv1.UndoLastMatchActionRequest,
+) (*userpbv1.UndoLastMatchActionResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+	)
+
+	userUUID, err := uuid.Parse(contextData.UserID)
+	if err != nil {
+		log.Error("Failed to parse user ID", zap.Error(err))
+		return nil, err
+	}
+
+	targetProfileID, action, err := h.matchMakingUsecase.UndoLastMatchAction(ctx, userUUID)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to undo last match action", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully undid last match action",
+		zap.Int64("target_profile_id", targetProfileID),
+		zap.String("action", action))
+	return &userpbv1.UndoLastMatchActionResponse{
+		TargetProfileId: targetProfileID,
+		Action:          action,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+
+	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/apperrors"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/utils/contextutils"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/entity"
+	"go.uber.org/zap"
+)
+
+func (h *UserHandler) UpdateReferenceOption(
+	ctx context.Context,
+	req *userpbv1.UpdateReferenceOptionRequest,
+) (*userpbv1.UpdateReferenceOptionResponse, error) {
+
+	contextData, err := contextutils.ExtractGrpcContextData(ctx)
+	if err != nil {
+		h.logger.Error("Failed to extract context data", zap.Error(err))
+		return nil, err
+	}
+	log := h.logger.With(
+		zap.String(constants.ContextKeyRequestID, contextData.RequestID),
+		zap.String(constants.ContextKeyUserID, contextData.UserID),
+		zap.Int64("option_id", req.OptionId),
+	)
+
+	var entityReq entity.UpdateReferenceOptionRequest
+	if req.Label != nil {
+		entityReq.Label = &req.Label.Value
+	}
+	if req.DisplayOrder != nil {
+		entityReq.DisplayOrder = &req.DisplayOrder.Value
+	}
+	if req.IsActive != nil {
+		entityReq.IsActive = &req.IsActive.Value
+	}
+
+	option, err := h.referenceDataUsecase.UpdateReferenceOption(ctx, req.OptionId, entityReq)
+	if err != nil {
+		if !apperrors.IsAppError(err) {
+			log.Error("Failed to update reference option", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	log.Info("Successfully updated reference option")
+	return &userpbv1.UpdateReferenceOptionResponse{
+		Option: toProtoReferenceOption(option),
+	}, nil
+}
@@ -39,7 +39,9 @@ func (h *UserHandler) UpdateUserPartnerPreferences(
 		operationType = req.OperationType.Value
 	}
 
-	entityReq := entity.UpdateUserPartnerPreferencesRequest{}
+	entityReq := entity.UpdateUserPartnerPreferencesRequest{
+		ActorRole: contextData.Role,
+	}
 
 	if req.MinAgeYears != nil {
 		minAgeYears := int16(req.MinAgeYears.Value)
@@ -79,6 +81,26 @@ func (h *UserHandler) UpdateUserPartnerPreferences(
 	if len(req.PreferredHomeDistricts) > 0 {
 		entityReq.PreferredHomeDistricts = &req.PreferredHomeDistricts
 	}
+	if len(req.PreferredCountries) > 0 {
+		entityReq.PreferredCountries = &req.PreferredCountries
+	}
+	if len(req.PreferredResidenceCountries) > 0 {
+		entityReq.PreferredResidenceCountries = &req.PreferredResidenceCountries
+	}
+	if req.MinPoruthamScore != nil {
+		minPoruthamScore := int16(req.MinPoruthamScore.Value)
+		entityReq.MinPoruthamScore = &minPoruthamScore
+	}
+	if req.AcceptDosham != nil {
+		entityReq.AcceptDosham = &req.AcceptDosham.Value
+	}
+	if len(req.MustHaveCriteria) > 0 || req.ClearMustHaveCriteria.GetValue() {
+		mustHaveCriteria := req.MustHaveCriteria
+		if mustHaveCriteria == nil {
+			mustHaveCriteria = []string{}
+		}
+		entityReq.MustHaveCriteria = &mustHaveCriteria
+	}
 
 	err = h.userProfileUsecase.UpdateUserPartnerPreferences(ctx, userIDUUID, operationType, entityReq)
 	if err != nil {
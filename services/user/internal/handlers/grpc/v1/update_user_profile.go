@@ -62,6 +62,41 @@ func (h *UserHandler) UpdateUserProfile(
 	if req.HomeDistrict != nil {
 		entityReq.HomeDistrict = &req.HomeDistrict.Value
 	}
+	if req.IsHidden != nil {
+		entityReq.IsHidden = &req.IsHidden.Value
+	}
+	if req.NativePlace != nil {
+		entityReq.NativePlace = fromProtoLocation(req.NativePlace)
+	}
+	if req.Residence != nil {
+		entityReq.Residence = fromProtoLocation(req.Residence)
+	}
+	if req.PreferredLanguage != nil {
+		entityReq.PreferredLanguage = &req.PreferredLanguage.Value
+	}
+	if req.DigestFrequency != nil {
+		entityReq.DigestFrequency = &req.DigestFrequency.Value
+	}
+	entityReq.RequestLocale = contextData.Locale
+	entityReq.ActorRole = contextData.Role
+	if req.BirthTime != nil {
+		entityReq.BirthTime = &req.BirthTime.Value
+	}
+	if req.BirthPlace != nil {
+		entityReq.BirthPlace = &req.BirthPlace.Value
+	}
+	if req.Nakshatra != nil {
+		entityReq.Nakshatra = &req.Nakshatra.Value
+	}
+	if req.Rasi != nil {
+		entityReq.Rasi = &req.Rasi.Value
+	}
+	if req.ChovvaDosham != nil {
+		entityReq.ChovvaDosham = &req.ChovvaDosham.Value
+	}
+	if req.PapaDosham != nil {
+		entityReq.PapaDosham = &req.PapaDosham.Value
+	}
 
 	userIDUUID, err := uuid.Parse(contextData.UserID)
 	if err != nil {
@@ -9,20 +9,26 @@ import (
 
 type UserHandler struct {
 	userpbv1.UnimplementedUserServiceServer
-	userProfileUsecase usecase.UserProfileUsecase
-	matchMakingUsecase usecase.MatchMakingUsecase
-	logger             *zap.Logger
+	userProfileUsecase   usecase.UserProfileUsecase
+	matchMakingUsecase   usecase.MatchMakingUsecase
+	moderationUsecase    usecase.ModerationUsecase
+	referenceDataUsecase usecase.ReferenceDataUsecase
+	logger               *zap.Logger
 }
 
 func NewUserHandler(
 	userProfileUsecase usecase.UserProfileUsecase,
 	matchMakingUsecase usecase.MatchMakingUsecase,
+	moderationUsecase usecase.ModerationUsecase,
+	referenceDataUsecase usecase.ReferenceDataUsecase,
 	logger *zap.Logger,
 ) *UserHandler {
 
 	return &UserHandler{
-		userProfileUsecase: userProfileUsecase,
-		matchMakingUsecase: matchMakingUsecase,
-		logger:             logger,
+		userProfileUsecase:   userProfileUsecase,
+		matchMakingUsecase:   matchMakingUsecase,
+		moderationUsecase:    moderationUsecase,
+		referenceDataUsecase: referenceDataUsecase,
+		logger:               logger,
 	}
 }
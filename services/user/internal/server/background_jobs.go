@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/usecase"
+	"go.uber.org/zap"
+)
+
+// runPeriodic calls fn every interval until ctx is cancelled. A non-positive
+// interval disables the job. fn logs its own outcome.
+func runPeriodic(
+	ctx context.Context,
+	name string,
+	interval time.Duration,
+	logger *zap.Logger,
+	fn func(ctx context.Context)) {
+
+	if interval <= 0 {
+		logger.Info(name + " disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			fn(ctx)
+		}
+	}
+}
+
+// runOrphanedPhotoCleanup periodically removes photo uploads that were never
+// confirmed. It stops when ctx is cancelled.
+func runOrphanedPhotoCleanup(
+	ctx context.Context,
+	userProfileUC usecase.UserProfileUsecase,
+	interval time.Duration,
+	maxAge time.Duration,
+	logger *zap.Logger) {
+
+	runPeriodic(ctx, "orphaned photo cleanup", interval, logger, func(ctx context.Context) {
+		deleted, err := userProfileUC.CleanupOrphanedPhotos(ctx, maxAge)
+		if err != nil {
+			logger.Error("failed to clean up orphaned photos",
+				zap.Int("deleted", deleted),
+				zap.Error(err))
+			return
+		}
+		if deleted > 0 {
+			logger.Info("cleaned up orphaned photos", zap.Int("deleted", deleted))
+		}
+	})
+}
+
+// runReferenceDataRefresh reloads the admin-managed profile options so that
+// changes made through another instance reach this one's validation cache.
+func runReferenceDataRefresh(
+	ctx context.Context,
+	referenceDataUC usecase.ReferenceDataUsecase,
+	interval time.Duration,
+	logger *zap.Logger) {
+
+	runPeriodic(ctx, "reference data refresh", interval, logger, func(ctx context.Context) {
+		if err := referenceDataUC.RefreshValidationCache(ctx); err != nil {
+			logger.Error("failed to refresh reference data", zap.Error(err))
+		}
+	})
+}
+
+// runSavedSearchAlerts periodically emails users about new profiles that
+// match their saved searches. It stops when ctx is cancelled.
+func runSavedSearchAlerts(
+	ctx context.Context,
+	matchMakingUC usecase.MatchMakingUsecase,
+	interval time.Duration,
+	logger *zap.Logger) {
+
+	runPeriodic(ctx, "saved search alerts", interval, logger, func(ctx context.Context) {
+		sent, err := matchMakingUC.SendSavedSearchAlerts(ctx)
+		if err != nil {
+			logger.Error("failed to send saved search alerts",
+				zap.Int("sent", sent),
+				zap.Error(err))
+			return
+		}
+		if sent > 0 {
+			logger.Info("sent saved search alerts", zap.Int("sent", sent))
+		}
+	})
+}
+
+// runMatchDigests periodically emails users the top new recommendations since
+// their last daily or weekly digest. It stops when ctx is cancelled.
+func runMatchDigests(
+	ctx context.Context,
+	matchMakingUC usecase.MatchMakingUsecase,
+	interval time.Duration,
+	logger *zap.Logger) {
+
+	runPeriodic(ctx, "match digests", interval, logger, func(ctx context.Context) {
+		sent, err := matchMakingUC.SendMatchDigests(ctx)
+		if err != nil {
+			logger.Error("failed to send match digests",
+				zap.Int("sent", sent),
+				zap.Error(err))
+			return
+		}
+		if sent > 0 {
+			logger.Info("sent match digests", zap.Int("sent", sent))
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
+	objectstore "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/mediastorage"
+	gcsstore "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/mediastorage/gcs"
+	localstore "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/mediastorage/local"
+	s3store "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/mediastorage/s3"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/config"
+)
+
+// newObjectStore creates the object store selected by media_storage.provider.
+// The local store is served over HTTP by the user service, so it also returns
+// the server that has to be started alongside gRPC; it is nil otherwise.
+func newObjectStore(ctx context.Context, cfg config.MediaStorageConfig) (objectstore.ObjectStore, *http.Server, error) {
+	switch cfg.Provider {
+	case constants.MediaStorageProviderGCS, "":
+		store, err := gcsstore.NewGCSStore(ctx, gcsstore.MediaStorageConfig{
+			Bucket:      cfg.Bucket,
+			URLExpiry:   cfg.URLExpiry,
+			SignerEmail: cfg.SignerEmail,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return store, nil, nil
+
+	case constants.MediaStorageProviderLocal:
+		store, err := localstore.NewLocalStore(localstore.LocalStoreConfig{
+			BaseDir:       cfg.Local.BaseDir,
+			PublicBaseURL: cfg.Local.PublicBaseURL,
+			SigningSecret: cfg.Local.SigningSecret,
+			URLExpiry:     cfg.URLExpiry,
+			MaxUploadSize: constants.ImageFileMaxSize,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		mux := http.NewServeMux()
+		mux.Handle("/media/", http.StripPrefix("/media", store.Handler()))
+		return store, &http.Server{Addr: cfg.Local.ListenAddr, Handler: mux}, nil
+
+	case constants.MediaStorageProviderS3:
+		store, err := s3store.NewS3Store(ctx, s3store.S3StoreConfig{
+			Endpoint:        cfg.S3.Endpoint,
+			Region:          cfg.S3.Region,
+			Bucket:          cfg.Bucket,
+			AccessKeyID:     cfg.S3.AccessKeyID,
+			SecretAccessKey: cfg.S3.SecretAccessKey,
+			UseSSL:          cfg.S3.UseSSL,
+			URLExpiry:       cfg.URLExpiry,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return store, nil, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unknown media storage provider %q", cfg.Provider)
+	}
+}
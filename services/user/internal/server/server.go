@@ -2,22 +2,28 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 
 	userpbv1 "github.com/mohamedfawas/quboolkallyanam.xyz/api/proto/user/v1"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/constants"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/postgres"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/database/redis"
 	interceptors "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/grpc/interceptors"
-	gcsstore "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/mediastorage/gcs"
+	objectstore "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/mediastorage"
 	messageBroker "github.com/mohamedfawas/quboolkallyanam.xyz/pkg/messagebroker"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/messagebroker/pubsub"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/pkg/messagebroker/rabbitmq"
-	gcs "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/adapters/gcs"
 	messageBrokerAdapter "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/adapters/messageBroker"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/adapters/objectstorage"
 	postgresAdapters "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/adapters/postgres"
+	redisAdapters "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/adapters/redis"
 	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/config"
 	matchmaking "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/usecase/match_making"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/usecase/moderation"
+	"github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/usecase/referencedata"
 	userProfileUsecaseImpl "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/domain/usecase/userprofile"
 	eventHandlers "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/handlers/event"
 	grpcHandlerv1 "github.com/mohamedfawas/quboolkallyanam.xyz/services/user/internal/handlers/grpc/v1"
@@ -32,8 +38,10 @@ type Server struct {
 	grpcServer      *grpc.Server
 	healthSrv       *health.Server
 	pgClient        *postgres.Client
+	redisClient     *redis.Client
 	messagingClient messageBroker.Client
-	gcsStore        *gcsstore.GCSStore
+	objectStore     objectstore.ObjectStore
+	mediaHTTPServer *http.Server
 	logger          *zap.Logger
 	ctx             context.Context
 	cancel          context.CancelFunc
@@ -63,6 +71,24 @@ func NewServer(ctx context.Context, config *config.Config, rootLogger *zap.Logge
 		zap.String("time_zone", config.Postgres.TimeZone),
 	)
 
+	///////////////////////// REDIS INITIALIZATION /////////////////////////
+	redisClient, err := redis.NewClient(redis.Config{
+		RedisURL: config.Redis.RedisURL,
+		Host:     config.Redis.Host,
+		Port:     config.Redis.Port,
+		Password: config.Redis.Password,
+		DB:       config.Redis.DB,
+	})
+	if err != nil {
+		// Clean up existing connections before returning error
+		pgClient.Close()
+		return nil, fmt.Errorf("failed to create redis client: %w", err)
+	}
+	rootLogger.Info("Connected to Redis ",
+		zap.Int("port", config.Redis.Port),
+		zap.Int("db", config.Redis.DB),
+	)
+
 	///////////////////////// MESSAGING CLIENT INITIALIZATION /////////////////////////
 	var messagingClient messageBroker.Client
 	if config.Environment == constants.EnvProduction {
@@ -70,6 +96,7 @@ func NewServer(ctx context.Context, config *config.Config, rootLogger *zap.Logge
 		if err != nil {
 			// Clean up existing connections before returning error
 			pgClient.Close()
+			redisClient.Close()
 			return nil, fmt.Errorf("failed to create pubsub client: %w", err)
 		}
 		rootLogger.Info("Connected to PubSub ")
@@ -81,26 +108,22 @@ func NewServer(ctx context.Context, config *config.Config, rootLogger *zap.Logge
 		if err != nil {
 			// Clean up existing connections before returning error
 			pgClient.Close()
+			redisClient.Close()
 			return nil, fmt.Errorf("failed to create rabbitmq client: %w", err)
 		}
 		rootLogger.Info("Connected to RabbitMQ ")
 	}
-	///////////////////////// GCS STORE INITIALIZATION /////////////////////////
-	gcsConfig := gcsstore.MediaStorageConfig{
-		Bucket:      config.MediaStorage.Bucket,
-		URLExpiry:   config.MediaStorage.URLExpiry,
-		SignerEmail: config.MediaStorage.SignerEmail, 
-	}
-
-	gcsStore, err := gcsstore.NewGCSStore(ctx, gcsConfig)
+	///////////////////////// OBJECT STORE INITIALIZATION /////////////////////////
+	objectStore, mediaHTTPServer, err := newObjectStore(ctx, config.MediaStorage)
 	if err != nil {
 		pgClient.Close()
+		redisClient.Close()
 		if messagingClient != nil {
 			messagingClient.Close()
 		}
-		return nil, fmt.Errorf("failed to create GCS store: %w", err)
+		return nil, fmt.Errorf("failed to create %s object store: %w", config.MediaStorage.Provider, err)
 	}
-	rootLogger.Info("Connected to GCS")
+	rootLogger.Info("Connected to object store", zap.String("provider", config.MediaStorage.Provider))
 	///////////////////////// GRPC SERVER INITIALIZATION /////////////////////////
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(interceptors.UnaryErrorInterceptor()),
@@ -118,23 +141,44 @@ func NewServer(ctx context.Context, config *config.Config, rootLogger *zap.Logge
 	partnerPreferencesRepo := postgresAdapters.NewPartnerPreferencesRepository(pgClient)
 	profileMatchRepo := postgresAdapters.NewProfileMatchRepository(pgClient)
 	mutualMatchRepo := postgresAdapters.NewMutualMatchRepository(pgClient)
+	userBlockRepo := postgresAdapters.NewUserBlockRepository(pgClient)
+	profileViewRepo := postgresAdapters.NewProfileViewRepository(pgClient)
+	shortlistRepo := postgresAdapters.NewShortlistRepository(pgClient)
+	reportRepo := postgresAdapters.NewReportRepository(pgClient)
+	referenceOptionRepo := postgresAdapters.NewReferenceOptionRepository(pgClient)
+	profileChangeRepo := postgresAdapters.NewProfileChangeRepository(pgClient)
+	savedSearchRepo := postgresAdapters.NewSavedSearchRepository(pgClient)
+	recommendationCacheRepo := redisAdapters.NewRecommendationCacheRepository(redisClient)
 	transactionManager := postgres.NewTransactionManager(pgClient)
 
 	///////////////////////// EVENT PUBLISHER INITIALIZATION /////////////////////////
 	eventPublisher := messageBrokerAdapter.NewEventPublisher(messagingClient, rootLogger)
 
 	///////////////////////// MEDIA STORAGE INITIALIZATION /////////////////////////
-	photoStorage := gcs.NewPhotoStorageAdapter(gcsStore, config.MediaStorage.Bucket)
+	photoStorage := objectstorage.NewCachedPhotoStorage(
+		objectstorage.NewPhotoStorageAdapter(objectStore),
+		redisClient,
+		config.MediaStorage.URLCacheMargin)
 
 	///////////////////////// USE CASES INITIALIZATION /////////////////////////
-	userProfileUC := userProfileUsecaseImpl.NewUserProfileUsecase(userProfileRepo, userImageRepo, partnerPreferencesRepo, eventPublisher, photoStorage, config)
-	matchMakingUC := matchmaking.NewMatchMakingUsecase(userProfileRepo, partnerPreferencesRepo, profileMatchRepo, mutualMatchRepo, transactionManager, photoStorage, config, eventPublisher)
+	userProfileUC := userProfileUsecaseImpl.NewUserProfileUsecase(userProfileRepo, userImageRepo, partnerPreferencesRepo, userBlockRepo, profileViewRepo, profileChangeRepo, recommendationCacheRepo, transactionManager, eventPublisher, photoStorage, config, rootLogger)
+	matchMakingUC := matchmaking.NewMatchMakingUsecase(userProfileRepo, partnerPreferencesRepo, profileMatchRepo, mutualMatchRepo, userBlockRepo, shortlistRepo, savedSearchRepo, recommendationCacheRepo, transactionManager, photoStorage, config, eventPublisher, rootLogger)
+	moderationUC := moderation.NewModerationUsecase(reportRepo, profileChangeRepo, userProfileRepo, userImageRepo, userProfileUC, transactionManager, eventPublisher, photoStorage, config)
+	referenceDataUC := referencedata.NewReferenceDataUsecase(referenceOptionRepo)
+
+	///////////////////////// REFERENCE DATA CACHE INITIALIZATION /////////////////////////
+	// Not fatal, validation falls back to the built-in values until the next refresh
+	if err := referenceDataUC.RefreshValidationCache(serverCtx); err != nil {
+		rootLogger.Error("failed to load reference data into validation cache", zap.Error(err))
+	}
 
 	///////////////////////// EVENT HANDLER INITIALIZATION /////////////////////////
 	authEventHandler := eventHandlers.NewAuthEventHandler(messagingClient, userProfileUC, rootLogger)
+	chatEventHandler := eventHandlers.NewChatEventHandler(messagingClient, moderationUC, rootLogger)
+	userEventHandler := eventHandlers.NewUserEventHandler(messagingClient, matchMakingUC, rootLogger)
 
 	///////////////////////// GRPC HANDLER INITIALIZATION /////////////////////////
-	userHandler := grpcHandlerv1.NewUserHandler(userProfileUC, matchMakingUC, rootLogger)
+	userHandler := grpcHandlerv1.NewUserHandler(userProfileUC, matchMakingUC, moderationUC, referenceDataUC, rootLogger)
 	userpbv1.RegisterUserServiceServer(grpcServer, userHandler)
 
 	///////////////////////// EVENT LISTENER INITIALIZATION /////////////////////////
@@ -143,6 +187,31 @@ func NewServer(ctx context.Context, config *config.Config, rootLogger *zap.Logge
 			rootLogger.Error("failed to start auth event listener", zap.Error(err))
 		}
 	}()
+	go func() {
+		if err := chatEventHandler.StartListening(serverCtx); err != nil {
+			rootLogger.Error("failed to start chat event listener", zap.Error(err))
+		}
+	}()
+	go func() {
+		if err := userEventHandler.StartListening(serverCtx); err != nil {
+			rootLogger.Error("failed to start user event listener", zap.Error(err))
+		}
+	}()
+
+	///////////////////////// BACKGROUND JOBS /////////////////////////
+	go runOrphanedPhotoCleanup(serverCtx, userProfileUC,
+		config.MediaStorage.OrphanCleanupInterval,
+		config.MediaStorage.OrphanUploadMaxAge,
+		rootLogger)
+	go runReferenceDataRefresh(serverCtx, referenceDataUC,
+		config.ReferenceData.CacheRefreshInterval,
+		rootLogger)
+	go runSavedSearchAlerts(serverCtx, matchMakingUC,
+		config.SavedSearches.CheckInterval,
+		rootLogger)
+	go runMatchDigests(serverCtx, matchMakingUC,
+		config.MatchDigests.CheckInterval,
+		rootLogger)
 
 	// mark healthy once all deps initialized successfully
 	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
@@ -152,8 +221,10 @@ func NewServer(ctx context.Context, config *config.Config, rootLogger *zap.Logge
 		grpcServer:      grpcServer,
 		healthSrv:       healthSrv,
 		pgClient:        pgClient,
+		redisClient:     redisClient,
 		messagingClient: messagingClient,
-		gcsStore:        gcsStore,
+		objectStore:     objectStore,
+		mediaHTTPServer: mediaHTTPServer,
 		logger:          rootLogger,
 		ctx:             serverCtx,
 		cancel:          cancel,
@@ -167,6 +238,17 @@ func (s *Server) Start() error {
 	if err != nil {
 		return err
 	}
+
+	// Local media storage serves signed upload and download URLs itself
+	if s.mediaHTTPServer != nil {
+		go func() {
+			s.logger.Info("serving local media storage", zap.String("addr", s.mediaHTTPServer.Addr))
+			if err := s.mediaHTTPServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.logger.Error("local media storage server stopped", zap.Error(err))
+			}
+		}()
+	}
+
 	return s.grpcServer.Serve(listener)
 }
 
@@ -179,10 +261,16 @@ func (s *Server) Stop() {
 
 	s.grpcServer.GracefulStop()
 
-	// Close GCS store
-	if s.gcsStore != nil {
-		if err := s.gcsStore.Close(); err != nil {
-			s.logger.Error("failed to close GCS store", zap.Error(err))
+	if s.mediaHTTPServer != nil {
+		if err := s.mediaHTTPServer.Shutdown(context.Background()); err != nil {
+			s.logger.Error("failed to stop local media storage server", zap.Error(err))
+		}
+	}
+
+	// Close object store
+	if s.objectStore != nil {
+		if err := s.objectStore.Close(); err != nil {
+			s.logger.Error("failed to close object store", zap.Error(err))
 		}
 	}
 
@@ -198,4 +286,10 @@ func (s *Server) Stop() {
 			s.logger.Error("failed to close postgres client", zap.Error(err))
 		}
 	}
+
+	if s.redisClient != nil {
+		if err := s.redisClient.Close(); err != nil {
+			s.logger.Error("failed to close redis client", zap.Error(err))
+		}
+	}
 }
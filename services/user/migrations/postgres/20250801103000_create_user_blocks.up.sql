@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS user_blocks (
+  id BIGSERIAL PRIMARY KEY,
+  blocker_id UUID NOT NULL,
+  blocked_id UUID NOT NULL,
+
+  -- Status tracking (unblock is a soft delete)
+  is_deleted BOOLEAN NOT NULL DEFAULT FALSE,
+  deleted_at TIMESTAMPTZ,
+
+  -- Timestamps
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT unique_user_block UNIQUE (blocker_id, blocked_id)
+);
+
+-- User can know whom they have blocked
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocker ON user_blocks (blocker_id) WHERE is_deleted = FALSE;
+-- User can be hidden from whoever has blocked them
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocked ON user_blocks (blocked_id) WHERE is_deleted = FALSE;
+
+-- for clean up
+CREATE INDEX IF NOT EXISTS idx_user_blocks_is_deleted ON user_blocks (is_deleted) WHERE is_deleted = TRUE;
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS reports (
+  id BIGSERIAL PRIMARY KEY,
+  reporter_id UUID NOT NULL,
+  target_user_id UUID NOT NULL,
+  target_profile_id BIGINT NOT NULL,
+  target_type VARCHAR(20) NOT NULL, -- 'profile', 'photo' or 'message'
+
+  -- Only one of these is set, based on target_type
+  photo_display_order SMALLINT, -- 0 for the profile photo, 1..3 for additional photos
+  message_id VARCHAR(64),
+  message_content TEXT, -- snapshot of the reported message
+
+  category VARCHAR(50) NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+
+  -- Moderation
+  status VARCHAR(20) NOT NULL DEFAULT 'pending', -- 'pending', 'dismissed' or 'actioned'
+  action VARCHAR(20), -- 'dismiss', 'warn', 'remove_photo' or 'suspend'
+  admin_note TEXT,
+  resolved_by UUID,
+  resolved_at TIMESTAMPTZ,
+
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Admin moderation queue, oldest first
+CREATE INDEX IF NOT EXISTS idx_reports_status_created_at ON reports (status, created_at);
+-- Reports against a user
+CREATE INDEX IF NOT EXISTS idx_reports_target_user_id ON reports (target_user_id);
@@ -0,0 +1,2 @@
+-- Hidden profiles are left out of recommendations and browse without leaving a trace
+ALTER TABLE user_profiles ADD COLUMN IF NOT EXISTS is_hidden BOOLEAN NOT NULL DEFAULT FALSE;
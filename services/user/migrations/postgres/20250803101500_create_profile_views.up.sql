@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS profile_views (
+  id BIGSERIAL PRIMARY KEY,
+  viewer_id UUID NOT NULL,
+  viewed_id UUID NOT NULL,
+
+  -- One row per viewer per day, repeat visits only move viewed_at forward
+  view_date DATE NOT NULL,
+  viewed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT unique_profile_view_per_day UNIQUE (viewer_id, viewed_id, view_date)
+);
+
+-- Visitors list and view counts are always looked up by the viewed user
+CREATE INDEX IF NOT EXISTS idx_profile_views_viewed ON profile_views (viewed_id, view_date DESC);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS shortlists (
+  id BIGSERIAL PRIMARY KEY,
+  user_id UUID NOT NULL,
+  target_user_id UUID NOT NULL,
+
+  -- Private note, only visible to the user who shortlisted
+  note TEXT NOT NULL DEFAULT '',
+
+  -- Timestamps
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT unique_shortlist_entry UNIQUE (user_id, target_user_id)
+);
+
+-- Shortlist is listed newest first
+CREATE INDEX IF NOT EXISTS idx_shortlists_user_created ON shortlists (user_id, created_at DESC);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_user_profiles_image_pending;
+DROP INDEX IF EXISTS idx_user_images_pending;
+ALTER TABLE user_profiles DROP COLUMN IF EXISTS profile_image_status;
+ALTER TABLE user_images
+  DROP COLUMN IF EXISTS reviewed_at,
+  DROP COLUMN IF EXISTS rejection_reason,
+  DROP COLUMN IF EXISTS status;
@@ -0,0 +1,14 @@
+-- Photos uploaded before review existed are treated as approved
+ALTER TABLE user_images
+  ADD COLUMN IF NOT EXISTS status VARCHAR(20) NOT NULL DEFAULT 'approved',
+  ADD COLUMN IF NOT EXISTS rejection_reason TEXT,
+  ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMPTZ;
+
+ALTER TABLE user_profiles
+  ADD COLUMN IF NOT EXISTS profile_image_status VARCHAR(20) NOT NULL DEFAULT 'approved';
+
+-- Admin review queue
+CREATE INDEX IF NOT EXISTS idx_user_images_pending ON user_images (created_at)
+  WHERE status = 'pending_review' AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_user_profiles_image_pending ON user_profiles (updated_at)
+  WHERE profile_image_status = 'pending_review';
@@ -0,0 +1,7 @@
+-- Processed photos have EXIF stripped and thumbnail/medium sizes stored under derived keys.
+-- Photos uploaded before processing existed keep serving the original object.
+ALTER TABLE user_profiles
+  ADD COLUMN IF NOT EXISTS profile_image_processed BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE user_images
+  ADD COLUMN IF NOT EXISTS processed BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,5 @@
+-- Rows above slot 3 would violate the original check, including soft deleted ones
+DELETE FROM user_images WHERE display_order > 3;
+ALTER TABLE user_images DROP CONSTRAINT IF EXISTS chk_display_order;
+ALTER TABLE user_images
+  ADD CONSTRAINT chk_display_order CHECK (display_order >= 1 AND display_order <= 3);
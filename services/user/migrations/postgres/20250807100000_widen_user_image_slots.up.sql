@@ -0,0 +1,5 @@
+-- Photo slots are configured per plan, the check only enforces the upper bound
+-- across all plans (constants.MaxAdditionalPhotoSlots).
+ALTER TABLE user_images DROP CONSTRAINT IF EXISTS chk_display_order;
+ALTER TABLE user_images
+  ADD CONSTRAINT chk_display_order CHECK (display_order >= 1 AND display_order <= 9);
@@ -0,0 +1,14 @@
+ALTER TABLE partner_preferences DROP CONSTRAINT IF EXISTS chk_min_porutham_score;
+ALTER TABLE partner_preferences
+  DROP COLUMN IF EXISTS accept_dosham,
+  DROP COLUMN IF EXISTS min_porutham_score;
+
+DROP INDEX IF EXISTS idx_user_profiles_nakshatra_rasi;
+
+ALTER TABLE user_profiles
+  DROP COLUMN IF EXISTS papa_dosham,
+  DROP COLUMN IF EXISTS chovva_dosham,
+  DROP COLUMN IF EXISTS rasi,
+  DROP COLUMN IF EXISTS nakshatra,
+  DROP COLUMN IF EXISTS birth_place,
+  DROP COLUMN IF EXISTS birth_time;
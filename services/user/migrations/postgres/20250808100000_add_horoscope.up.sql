@@ -0,0 +1,18 @@
+-- Horoscope details are optional, profiles without them are never filtered
+-- out by porutham score unless the viewer asks for a minimum score.
+ALTER TABLE user_profiles
+  ADD COLUMN IF NOT EXISTS birth_time VARCHAR(5),
+  ADD COLUMN IF NOT EXISTS birth_place VARCHAR(200),
+  ADD COLUMN IF NOT EXISTS nakshatra VARCHAR(50),
+  ADD COLUMN IF NOT EXISTS rasi VARCHAR(50),
+  ADD COLUMN IF NOT EXISTS chovva_dosham BOOLEAN,
+  ADD COLUMN IF NOT EXISTS papa_dosham BOOLEAN;
+
+CREATE INDEX IF NOT EXISTS idx_user_profiles_nakshatra_rasi ON user_profiles (nakshatra, rasi);
+
+ALTER TABLE partner_preferences
+  ADD COLUMN IF NOT EXISTS min_porutham_score SMALLINT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS accept_dosham BOOLEAN NOT NULL DEFAULT TRUE;
+
+ALTER TABLE partner_preferences
+  ADD CONSTRAINT chk_min_porutham_score CHECK (min_porutham_score >= 0 AND min_porutham_score <= 10);
@@ -0,0 +1,16 @@
+ALTER TABLE partner_preferences
+  DROP COLUMN IF EXISTS preferred_residence_countries,
+  DROP COLUMN IF EXISTS preferred_countries;
+
+DROP INDEX IF EXISTS idx_user_profiles_residence_country;
+DROP INDEX IF EXISTS idx_user_profiles_native_country;
+
+ALTER TABLE user_profiles
+  DROP COLUMN IF EXISTS residence_city,
+  DROP COLUMN IF EXISTS residence_district,
+  DROP COLUMN IF EXISTS residence_state,
+  DROP COLUMN IF EXISTS residence_country,
+  DROP COLUMN IF EXISTS native_city,
+  DROP COLUMN IF EXISTS native_district,
+  DROP COLUMN IF EXISTS native_state,
+  DROP COLUMN IF EXISTS native_country;
@@ -0,0 +1,28 @@
+-- Native place and current residence as a country/state/district/city
+-- hierarchy. home_district is kept and stays in step with the native place.
+ALTER TABLE user_profiles
+  ADD COLUMN IF NOT EXISTS native_country VARCHAR(2),
+  ADD COLUMN IF NOT EXISTS native_state VARCHAR(100),
+  ADD COLUMN IF NOT EXISTS native_district VARCHAR(100),
+  ADD COLUMN IF NOT EXISTS native_city VARCHAR(100),
+  ADD COLUMN IF NOT EXISTS residence_country VARCHAR(2),
+  ADD COLUMN IF NOT EXISTS residence_state VARCHAR(100),
+  ADD COLUMN IF NOT EXISTS residence_district VARCHAR(100),
+  ADD COLUMN IF NOT EXISTS residence_city VARCHAR(100);
+
+-- Every existing home district is a district of Kerala
+UPDATE user_profiles
+SET native_country = 'IN',
+    native_state = 'kerala',
+    native_district = home_district
+WHERE home_district IS NOT NULL
+  AND home_district <> ''
+  AND home_district <> 'any'
+  AND native_country IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_profiles_native_country ON user_profiles (native_country);
+CREATE INDEX IF NOT EXISTS idx_user_profiles_residence_country ON user_profiles (residence_country);
+
+ALTER TABLE partner_preferences
+  ADD COLUMN IF NOT EXISTS preferred_countries TEXT[] NOT NULL DEFAULT '{any}',
+  ADD COLUMN IF NOT EXISTS preferred_residence_countries TEXT[] NOT NULL DEFAULT '{any}';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_reference_options_taxonomy_value;
+DROP TABLE IF EXISTS reference_options;
@@ -0,0 +1,46 @@
+-- Admin-managed profile options. Seeded with the values that used to be
+-- hard-coded, so existing profiles and preferences stay valid.
+CREATE TABLE IF NOT EXISTS reference_options (
+    id BIGSERIAL PRIMARY KEY,
+    taxonomy VARCHAR(50) NOT NULL,
+    value VARCHAR(100) NOT NULL,
+    label VARCHAR(200) NOT NULL,
+    display_order INTEGER NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_reference_options_taxonomy CHECK (
+        taxonomy IN ('community', 'marital_status', 'profession', 'profession_type', 'education_level')
+    ),
+    CONSTRAINT chk_reference_options_value_not_any CHECK (value <> 'any')
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_reference_options_taxonomy_value ON reference_options (taxonomy, value);
+
+INSERT INTO reference_options (taxonomy, value, label, display_order) VALUES
+    ('community', 'sunni', 'Sunni', 1),
+    ('community', 'mujahid', 'Mujahid', 2),
+    ('community', 'tabligh', 'Tabligh', 3),
+    ('community', 'jamate_islami', 'Jamaat-e-Islami', 4),
+    ('community', 'shia', 'Shia', 5),
+    ('community', 'muslim', 'Muslim', 6),
+    ('marital_status', 'never_married', 'Never married', 1),
+    ('marital_status', 'divorced', 'Divorced', 2),
+    ('marital_status', 'nikkah_divorce', 'Nikkah divorce', 3),
+    ('marital_status', 'widowed', 'Widowed', 4),
+    ('profession', 'student', 'Student', 1),
+    ('profession', 'doctor', 'Doctor', 2),
+    ('profession', 'engineer', 'Engineer', 3),
+    ('profession', 'farmer', 'Farmer', 4),
+    ('profession', 'teacher', 'Teacher', 5),
+    ('profession_type', 'full_time', 'Full time', 1),
+    ('profession_type', 'part_time', 'Part time', 2),
+    ('profession_type', 'freelance', 'Freelance', 3),
+    ('profession_type', 'self_employed', 'Self employed', 4),
+    ('profession_type', 'not_working', 'Not working', 5),
+    ('education_level', 'less_than_high_school', 'Less than high school', 1),
+    ('education_level', 'high_school', 'High school', 2),
+    ('education_level', 'higher_secondary', 'Higher secondary', 3),
+    ('education_level', 'under_graduation', 'Under graduation', 4),
+    ('education_level', 'post_graduation', 'Post graduation', 5)
+ON CONFLICT (taxonomy, value) DO NOTHING;
@@ -0,0 +1,2 @@
+ALTER TABLE user_profiles DROP CONSTRAINT IF EXISTS chk_user_profiles_preferred_language;
+ALTER TABLE user_profiles DROP COLUMN IF EXISTS preferred_language;
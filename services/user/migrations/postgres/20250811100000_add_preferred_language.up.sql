@@ -0,0 +1,6 @@
+-- Language of emails sent to the user. Existing users keep English.
+ALTER TABLE user_profiles
+    ADD COLUMN IF NOT EXISTS preferred_language VARCHAR(5) NOT NULL DEFAULT 'en';
+
+ALTER TABLE user_profiles
+    ADD CONSTRAINT chk_user_profiles_preferred_language CHECK (preferred_language IN ('en', 'ml'));
@@ -0,0 +1,23 @@
+-- Versioned history of profile and partner preference edits. Each row holds
+-- the changed fields with their old and new values.
+CREATE TABLE IF NOT EXISTS profile_changes (
+    id BIGSERIAL PRIMARY KEY,
+    user_id UUID NOT NULL,
+    user_profile_id BIGINT NOT NULL,
+    section VARCHAR(30) NOT NULL,
+    version INTEGER NOT NULL,
+    changes JSONB NOT NULL,
+    actor_id UUID NOT NULL,
+    actor_role VARCHAR(20) NOT NULL,
+    is_flagged BOOLEAN NOT NULL DEFAULT FALSE,
+    flag_reasons TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_profile_changes_section CHECK (section IN ('profile', 'partner_preferences'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_profile_changes_profile_section_version
+    ON profile_changes (user_profile_id, section, version);
+CREATE INDEX IF NOT EXISTS idx_profile_changes_profile_created_at
+    ON profile_changes (user_profile_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_profile_changes_flagged
+    ON profile_changes (created_at DESC) WHERE is_flagged;
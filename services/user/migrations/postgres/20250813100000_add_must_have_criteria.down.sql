@@ -0,0 +1,2 @@
+ALTER TABLE partner_preferences
+  DROP COLUMN IF EXISTS must_have_criteria;
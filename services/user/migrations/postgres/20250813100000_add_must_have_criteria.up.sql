@@ -0,0 +1,13 @@
+-- Preferences are scored instead of filtered, only the dimensions listed in
+-- must_have_criteria still exclude profiles. Existing horoscope requirements
+-- are kept as must-haves so current results do not suddenly loosen there.
+ALTER TABLE partner_preferences
+  ADD COLUMN IF NOT EXISTS must_have_criteria TEXT[] NOT NULL DEFAULT '{}';
+
+UPDATE partner_preferences
+SET must_have_criteria = array_append(must_have_criteria, 'porutham')
+WHERE min_porutham_score > 0 AND NOT ('porutham' = ANY(must_have_criteria));
+
+UPDATE partner_preferences
+SET must_have_criteria = array_append(must_have_criteria, 'dosham')
+WHERE accept_dosham = FALSE AND NOT ('dosham' = ANY(must_have_criteria));
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS saved_searches;
+
+DROP INDEX IF EXISTS idx_user_profiles_completed_at;
+
+ALTER TABLE user_profiles
+  DROP COLUMN IF EXISTS completed_at;
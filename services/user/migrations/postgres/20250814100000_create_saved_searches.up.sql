@@ -0,0 +1,31 @@
+-- When a profile was first filled in, saved search alerts look for profiles
+-- completed since their last check. Existing profiles count from their last
+-- update, so they are never reported as new.
+ALTER TABLE user_profiles
+  ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ;
+
+UPDATE user_profiles
+SET completed_at = updated_at
+WHERE profile_completed = TRUE AND completed_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_profiles_completed_at ON user_profiles (completed_at);
+
+CREATE TABLE IF NOT EXISTS saved_searches (
+  id BIGSERIAL PRIMARY KEY,
+  user_id UUID NOT NULL,
+  name VARCHAR(100) NOT NULL,
+
+  -- Search filters as sent by the user, see entity.SearchFilters
+  filters JSONB NOT NULL,
+
+  alerts_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  last_checked_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  -- Timestamps
+  created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_searches_user_id ON saved_searches (user_id);
+-- The alert job picks the searches that waited longest
+CREATE INDEX IF NOT EXISTS idx_saved_searches_alert_due ON saved_searches (last_checked_at) WHERE alerts_enabled = TRUE;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_user_profiles_digest_due;
+
+ALTER TABLE user_profiles DROP CONSTRAINT IF EXISTS chk_user_profiles_digest_frequency;
+
+ALTER TABLE user_profiles
+  DROP COLUMN IF EXISTS digest_frequency,
+  DROP COLUMN IF EXISTS last_digest_sent_at;
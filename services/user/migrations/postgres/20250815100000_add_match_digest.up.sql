@@ -0,0 +1,12 @@
+-- How often the user gets the match digest email and when the last one was
+-- sent, the next digest only looks at profiles completed since then.
+ALTER TABLE user_profiles
+  ADD COLUMN IF NOT EXISTS digest_frequency VARCHAR(10) NOT NULL DEFAULT 'off',
+  ADD COLUMN IF NOT EXISTS last_digest_sent_at TIMESTAMPTZ;
+
+ALTER TABLE user_profiles
+  ADD CONSTRAINT chk_user_profiles_digest_frequency CHECK (digest_frequency IN ('daily', 'weekly', 'off'));
+
+-- The digest job picks the users that waited longest
+CREATE INDEX IF NOT EXISTS idx_user_profiles_digest_due ON user_profiles (digest_frequency, last_digest_sent_at)
+  WHERE profile_completed = TRUE AND digest_frequency <> 'off';
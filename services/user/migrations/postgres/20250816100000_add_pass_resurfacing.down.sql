@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_profile_matches_user_updated_at;
+
+ALTER TABLE user_profiles
+  DROP COLUMN IF EXISTS significantly_updated_at;
@@ -0,0 +1,9 @@
+-- Last time a field users decide on (profession, education, marital status,
+-- location or an approved profile photo) changed, profiles passed on before
+-- that come back into the recommendations of the users who passed.
+ALTER TABLE user_profiles
+  ADD COLUMN IF NOT EXISTS significantly_updated_at TIMESTAMPTZ;
+
+-- Undo looks up the user's latest like or pass
+CREATE INDEX IF NOT EXISTS idx_profile_matches_user_updated_at ON profile_matches (user_id, updated_at DESC)
+  WHERE is_deleted = FALSE;
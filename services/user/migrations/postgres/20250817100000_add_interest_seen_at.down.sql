@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_profile_matches_target_liked;
+
+ALTER TABLE profile_matches
+  DROP COLUMN IF EXISTS seen_at;
@@ -0,0 +1,8 @@
+-- When the target first saw a like in their interests received inbox, likes
+-- with no seen_at count towards the unread interests.
+ALTER TABLE profile_matches
+  ADD COLUMN IF NOT EXISTS seen_at TIMESTAMPTZ;
+
+-- The inbox lists the likes a user received, newest first
+CREATE INDEX IF NOT EXISTS idx_profile_matches_target_liked ON profile_matches (target_id, updated_at DESC)
+  WHERE is_liked = TRUE AND is_deleted = FALSE;
@@ -0,0 +1,4 @@
+-- The photo a report points at, slots change when photos are reordered or
+-- replaced so moderation acts on this key and not the slot.
+ALTER TABLE reports
+  ADD COLUMN IF NOT EXISTS photo_object_key VARCHAR(500);
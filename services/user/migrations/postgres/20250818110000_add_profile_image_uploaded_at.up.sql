@@ -0,0 +1,9 @@
+-- When the current profile photo was uploaded, the photo review queue is
+-- ordered by it. Until now the queue used updated_at, which moves on every
+-- profile edit.
+ALTER TABLE user_profiles
+  ADD COLUMN IF NOT EXISTS profile_image_uploaded_at TIMESTAMPTZ;
+
+UPDATE user_profiles
+  SET profile_image_uploaded_at = updated_at
+  WHERE profile_image_key <> '' AND profile_image_uploaded_at IS NULL;